@@ -11,6 +11,8 @@ import (
 	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense/module"
 	group "github.com/Beigelman/nossas-despesas/internal/modules/group/module"
 	income "github.com/Beigelman/nossas-despesas/internal/modules/income/module"
+	settlement "github.com/Beigelman/nossas-despesas/internal/modules/settlement/module"
+	tag "github.com/Beigelman/nossas-despesas/internal/modules/tag/module"
 	user "github.com/Beigelman/nossas-despesas/internal/modules/user/module"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/config"
@@ -52,6 +54,8 @@ func main() {
 		expense.Module,
 		group.Module,
 		income.Module,
+		settlement.Module,
+		tag.Module,
 		user.Module,
 	).Start(); err != nil {
 		log.Fatal("failed to start application: ", err)
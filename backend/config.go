@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path"
+	"time"
 
 	"github.com/golobby/config/v3"
 	"github.com/golobby/config/v3/pkg/feeder"
@@ -31,6 +32,32 @@ type Mail struct {
 	ApiKey    string `env:"MAIL_API_KEY"`
 }
 
+type Calendar struct {
+	Holidays string `env:"CALENDAR_HOLIDAYS"`
+}
+
+type Jobs struct {
+	GenerateScheduledExpenses string `env:"JOBS_GENERATE_SCHEDULED_EXPENSES"`
+	RelayOutbox               string `env:"JOBS_RELAY_OUTBOX"`
+	PurgeTrash                string `env:"JOBS_PURGE_TRASH"`
+}
+
+type Trash struct {
+	RetentionDays int `env:"TRASH_RETENTION_DAYS"`
+}
+
+// Storage chooses where attached files are kept: the local disk, the default, or a bucket of an S3
+// compatible service such as MinIO when Driver is "s3".
+type Storage struct {
+	Driver      string `env:"STORAGE_DRIVER"`
+	LocalPath   string `env:"STORAGE_LOCAL_PATH"`
+	S3Endpoint  string `env:"STORAGE_S3_ENDPOINT"`
+	S3Region    string `env:"STORAGE_S3_REGION"`
+	S3Bucket    string `env:"STORAGE_S3_BUCKET"`
+	S3AccessKey string `env:"STORAGE_S3_ACCESS_KEY"`
+	S3SecretKey string `env:"STORAGE_S3_SECRET_KEY"`
+}
+
 type Config struct {
 	Env         env.Environment
 	ServiceName string `env:"SERVICE_NAME"`
@@ -41,8 +68,18 @@ type Config struct {
 	PredictURL  string `env:"PREDICT_URL"`
 	Mail        Mail
 	Db          Db
+	Calendar    Calendar
+	Jobs        Jobs
+	Trash       Trash
+	Storage     Storage
 }
 
+const (
+	defaultTrashRetentionDays = 30
+	defaultPurgeTrashSchedule = "CRON_TZ=America/Sao_Paulo 0 3 * * *"
+	defaultStorageLocalPath   = "storage"
+)
+
 func NewConfig(environment env.Environment) (Config, error) {
 	cfg := Config{Env: environment}
 
@@ -75,6 +112,31 @@ func (c *Config) DBConnectionString() string {
 	return fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", c.Db.Host, c.Db.Port, c.Db.User, c.Db.Name, c.Db.Password)
 }
 
+// TrashRetention is how long deleted expenses and incomes can be restored before being purged.
+func (c *Config) TrashRetention() time.Duration {
+	days := c.Trash.RetentionDays
+	if days <= 0 {
+		days = defaultTrashRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// PurgeTrashSchedule is the cron expression of the jobs purging the trash of each module.
+func (c *Config) PurgeTrashSchedule() string {
+	if c.Jobs.PurgeTrash != "" {
+		return c.Jobs.PurgeTrash
+	}
+	return defaultPurgeTrashSchedule
+}
+
+// StorageLocalPath is the directory attached files are kept in when stored on the local disk.
+func (c *Config) StorageLocalPath() string {
+	if c.Storage.LocalPath != "" {
+		return c.Storage.LocalPath
+	}
+	return defaultStorageLocalPath
+}
+
 func getDotEnvPath() string {
 	exPath, err := os.Getwd()
 	if err != nil {
@@ -0,0 +1,6 @@
+-- reverse: modify "expenses" split_ratio from payer/receiver percentages to a list of shares
+UPDATE "expenses" SET "split_ratio" = jsonb_build_object(
+  'payer', COALESCE((SELECT (share->>'percent')::int FROM jsonb_array_elements("split_ratio"->'shares') AS share WHERE (share->>'user_id')::int = "payer_id"), 0),
+  'receiver', COALESCE((SELECT (share->>'percent')::int FROM jsonb_array_elements("split_ratio"->'shares') AS share WHERE (share->>'user_id')::int = "receiver_id" AND "receiver_id" <> "payer_id"), 0)
+)
+WHERE "split_ratio" ? 'shares';
@@ -0,0 +1,15 @@
+-- modify "expenses" split_ratio from payer/receiver percentages to a list of shares
+UPDATE "expenses" SET "split_ratio" = CASE
+  WHEN "payer_id" = "receiver_id" THEN jsonb_build_object(
+    'shares', jsonb_build_array(
+      jsonb_build_object('user_id', "payer_id", 'percent', 100)
+    )
+  )
+  ELSE jsonb_build_object(
+    'shares', jsonb_build_array(
+      jsonb_build_object('user_id', "payer_id", 'percent', ("split_ratio"->>'payer')::int),
+      jsonb_build_object('user_id', "receiver_id", 'percent', ("split_ratio"->>'receiver')::int)
+    )
+  )
+END
+WHERE "split_ratio" ? 'payer';
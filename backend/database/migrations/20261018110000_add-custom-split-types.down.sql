@@ -0,0 +1,27 @@
+-- reverse: modify enum type "split_type"
+UPDATE "expenses" SET "split_type" = 'proportional' WHERE "split_type" IN ('exact', 'weighted');
+UPDATE "scheduled_expenses" SET "split_type" = 'proportional' WHERE "split_type" IN ('exact', 'weighted');
+DROP VIEW "expenses_latest";
+ALTER TYPE "split_type" RENAME TO "split_type_old";
+CREATE TYPE "split_type" AS ENUM ('equal', 'proportional', 'transfer');
+ALTER TABLE "expenses" ALTER COLUMN "split_type" TYPE "split_type" USING "split_type"::text::"split_type";
+ALTER TABLE "scheduled_expenses" ALTER COLUMN "split_type" TYPE "split_type" USING "split_type"::text::"split_type";
+DROP TYPE "split_type_old";
+CREATE VIEW "expenses_latest" AS SELECT DISTINCT ON (expenses.id) expenses.id,
+    expenses.name,
+    expenses.amount_cents,
+    expenses.refund_amount_cents,
+    expenses.description,
+    expenses.group_id,
+    expenses.category_id,
+    expenses.split_ratio,
+    expenses.split_type,
+    expenses.payer_id,
+    expenses.receiver_id,
+    expenses.document_search,
+    expenses.created_at,
+    expenses.updated_at,
+    expenses.deleted_at,
+    expenses.version
+   FROM expenses
+  ORDER BY expenses.id DESC, expenses.version DESC;
@@ -0,0 +1,3 @@
+-- modify enum type "split_type"
+ALTER TYPE "split_type" ADD VALUE 'exact';
+ALTER TYPE "split_type" ADD VALUE 'weighted';
@@ -0,0 +1,24 @@
+-- reverse: modify "expenses_latest" view
+DROP VIEW "expenses_latest";
+CREATE VIEW "expenses_latest" AS SELECT DISTINCT ON (expenses.id) expenses.id,
+    expenses.name,
+    expenses.amount_cents,
+    expenses.refund_amount_cents,
+    expenses.description,
+    expenses.group_id,
+    expenses.category_id,
+    expenses.split_ratio,
+    expenses.split_type,
+    expenses.payer_id,
+    expenses.receiver_id,
+    expenses.document_search,
+    expenses.created_at,
+    expenses.updated_at,
+    expenses.deleted_at,
+    expenses.version
+   FROM expenses
+  ORDER BY expenses.id DESC, expenses.version DESC;
+-- reverse: create index "expenses_purchase_id_idx" to table: "expenses"
+DROP INDEX "expenses_purchase_id_idx";
+-- reverse: modify "expenses" table
+ALTER TABLE "expenses" DROP COLUMN "installment_total", DROP COLUMN "installment_number", DROP COLUMN "purchase_id";
@@ -0,0 +1,4 @@
+-- reverse: create index "settlements_group_id_idx" to table: "settlements"
+DROP INDEX "settlements_group_id_idx";
+-- reverse: create "settlements" table
+DROP TABLE "settlements";
@@ -0,0 +1,19 @@
+-- create "settlements" table
+CREATE TABLE "settlements" (
+  "id" bigserial NOT NULL,
+  "group_id" bigint NOT NULL,
+  "payer_id" bigint NOT NULL,
+  "receiver_id" bigint NOT NULL,
+  "amount_cents" bigint NOT NULL,
+  "note" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "deleted_at" timestamptz NULL,
+  "version" integer NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "settlement_group_id_fk" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "settlement_payer_id_fk" FOREIGN KEY ("payer_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "settlement_receiver_id_fk" FOREIGN KEY ("receiver_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- create index "settlements_group_id_idx" to table: "settlements"
+CREATE INDEX "settlements_group_id_idx" ON "settlements" ("group_id", "created_at");
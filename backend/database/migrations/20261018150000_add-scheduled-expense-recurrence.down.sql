@@ -0,0 +1,2 @@
+-- reverse: modify "scheduled_expenses" table
+ALTER TABLE "scheduled_expenses" DROP COLUMN "occurrence_count", DROP COLUMN "recurrence";
@@ -0,0 +1,2 @@
+-- modify "scheduled_expenses" table
+ALTER TABLE "scheduled_expenses" ADD COLUMN "recurrence" jsonb NULL, ADD COLUMN "occurrence_count" integer NOT NULL DEFAULT 0;
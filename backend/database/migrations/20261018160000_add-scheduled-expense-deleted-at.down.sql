@@ -0,0 +1,4 @@
+-- reverse: create index "scheduled_expenses_group_id_idx" to table: "scheduled_expenses"
+DROP INDEX "scheduled_expenses_group_id_idx";
+-- reverse: modify "scheduled_expenses" table
+ALTER TABLE "scheduled_expenses" DROP COLUMN "deleted_at";
@@ -0,0 +1,4 @@
+-- modify "scheduled_expenses" table
+ALTER TABLE "scheduled_expenses" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "scheduled_expenses_group_id_idx" to table: "scheduled_expenses"
+CREATE INDEX "scheduled_expenses_group_id_idx" ON "scheduled_expenses" ("group_id");
@@ -0,0 +1,2 @@
+-- reverse: create "scheduled_expense_occurrences" table
+DROP TABLE "scheduled_expense_occurrences";
@@ -0,0 +1,7 @@
+-- create "scheduled_expense_occurrences" table
+CREATE TABLE "scheduled_expense_occurrences" (
+  "scheduled_expense_id" bigint NOT NULL,
+  "due_date" date NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("scheduled_expense_id", "due_date")
+);
@@ -0,0 +1,9 @@
+-- create "outbox_events" table
+CREATE TABLE "outbox_events" (
+  "id" bigserial NOT NULL,
+  "uuid" uuid NOT NULL,
+  "topic" text NOT NULL,
+  "payload" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
@@ -0,0 +1,46 @@
+-- modify "expenses" table
+ALTER TABLE "expenses" ADD COLUMN "updated_by" bigint NULL;
+-- modify "expenses_latest" view
+CREATE OR REPLACE VIEW "expenses_latest" (
+  "id",
+  "name",
+  "amount_cents",
+  "refund_amount_cents",
+  "description",
+  "group_id",
+  "category_id",
+  "split_ratio",
+  "split_type",
+  "payer_id",
+  "receiver_id",
+  "document_search",
+  "created_at",
+  "updated_at",
+  "deleted_at",
+  "version",
+  "purchase_id",
+  "installment_number",
+  "installment_total",
+  "updated_by"
+) AS SELECT DISTINCT ON (expenses.id) expenses.id,
+    expenses.name,
+    expenses.amount_cents,
+    expenses.refund_amount_cents,
+    expenses.description,
+    expenses.group_id,
+    expenses.category_id,
+    expenses.split_ratio,
+    expenses.split_type,
+    expenses.payer_id,
+    expenses.receiver_id,
+    expenses.document_search,
+    expenses.created_at,
+    expenses.updated_at,
+    expenses.deleted_at,
+    expenses.version,
+    expenses.purchase_id,
+    expenses.installment_number,
+    expenses.installment_total,
+    expenses.updated_by
+   FROM expenses
+  ORDER BY expenses.id DESC, expenses.version DESC;
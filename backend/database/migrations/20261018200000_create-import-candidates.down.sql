@@ -0,0 +1,8 @@
+-- reverse: create index "import_candidates_group_id_status_idx" to table: "import_candidates"
+DROP INDEX "import_candidates_group_id_status_idx";
+-- reverse: create index "import_candidates_external_id_idx" to table: "import_candidates"
+DROP INDEX "import_candidates_external_id_idx";
+-- reverse: create "import_candidates" table
+DROP TABLE "import_candidates";
+-- reverse: create enum type "import_candidate_status"
+DROP TYPE "import_candidate_status";
@@ -0,0 +1,24 @@
+-- create enum type "import_candidate_status"
+CREATE TYPE "import_candidate_status" AS ENUM ('pending', 'confirmed', 'discarded');
+-- create "import_candidates" table
+CREATE TABLE "import_candidates" (
+  "id" bigserial NOT NULL,
+  "group_id" bigint NOT NULL,
+  "source" text NOT NULL,
+  "external_id" text NOT NULL,
+  "name" text NOT NULL,
+  "amount_cents" bigint NOT NULL,
+  "date" date NOT NULL,
+  "category_id" bigint NULL,
+  "status" "import_candidate_status" NOT NULL,
+  "expense_id" bigint NULL,
+  "imported_by" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "version" integer NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "import_candidates_external_id_idx" to table: "import_candidates"
+CREATE UNIQUE INDEX "import_candidates_external_id_idx" ON "import_candidates" ("group_id", "source", "external_id");
+-- create index "import_candidates_group_id_status_idx" to table: "import_candidates"
+CREATE INDEX "import_candidates_group_id_status_idx" ON "import_candidates" ("group_id", "status");
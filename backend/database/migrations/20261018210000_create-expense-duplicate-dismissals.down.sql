@@ -0,0 +1,6 @@
+-- reverse: create index "expenses_group_id_amount_cents_idx" to table: "expenses"
+DROP INDEX "expenses_group_id_amount_cents_idx";
+-- reverse: create index "expense_duplicate_dismissals_group_id_idx" to table: "expense_duplicate_dismissals"
+DROP INDEX "expense_duplicate_dismissals_group_id_idx";
+-- reverse: create "expense_duplicate_dismissals" table
+DROP TABLE "expense_duplicate_dismissals";
@@ -0,0 +1,13 @@
+-- create "expense_duplicate_dismissals" table
+CREATE TABLE "expense_duplicate_dismissals" (
+  "expense_id" bigint NOT NULL,
+  "other_expense_id" bigint NOT NULL,
+  "group_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("expense_id", "other_expense_id"),
+  CONSTRAINT "expense_duplicate_dismissals_order_check" CHECK (expense_id < other_expense_id)
+);
+-- create index "expense_duplicate_dismissals_group_id_idx" to table: "expense_duplicate_dismissals"
+CREATE INDEX "expense_duplicate_dismissals_group_id_idx" ON "expense_duplicate_dismissals" ("group_id");
+-- create index "expenses_group_id_amount_cents_idx" to table: "expenses"
+CREATE INDEX "expenses_group_id_amount_cents_idx" ON "expenses" ("group_id", "amount_cents");
@@ -0,0 +1,4 @@
+-- reverse: create index "expense_attachments_expense_id_idx" to table: "expense_attachments"
+DROP INDEX "expense_attachments_expense_id_idx";
+-- reverse: create "expense_attachments" table
+DROP TABLE "expense_attachments";
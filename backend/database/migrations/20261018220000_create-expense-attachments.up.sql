@@ -0,0 +1,18 @@
+-- create "expense_attachments" table
+CREATE TABLE "expense_attachments" (
+  "id" bigserial NOT NULL,
+  "expense_id" bigint NOT NULL,
+  "group_id" bigint NOT NULL,
+  "file_name" text NOT NULL,
+  "content_type" text NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "storage_key" text NOT NULL,
+  "thumbnail_key" text NULL,
+  "uploaded_by" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "version" integer NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "expense_attachments_expense_id_idx" to table: "expense_attachments"
+CREATE INDEX "expense_attachments_expense_id_idx" ON "expense_attachments" ("expense_id");
@@ -0,0 +1,30 @@
+-- reverse: modify "expenses_latest" view
+DROP VIEW "expenses_latest";
+CREATE VIEW "expenses_latest" AS SELECT DISTINCT ON (expenses.id) expenses.id,
+    expenses.name,
+    expenses.amount_cents,
+    expenses.refund_amount_cents,
+    expenses.description,
+    expenses.group_id,
+    expenses.category_id,
+    expenses.split_ratio,
+    expenses.split_type,
+    expenses.payer_id,
+    expenses.receiver_id,
+    expenses.document_search,
+    expenses.created_at,
+    expenses.updated_at,
+    expenses.deleted_at,
+    expenses.version,
+    expenses.purchase_id,
+    expenses.installment_number,
+    expenses.installment_total,
+    expenses.updated_by
+   FROM expenses
+  ORDER BY expenses.id DESC, expenses.version DESC;
+-- reverse: modify "expenses" table
+ALTER TABLE "expenses" DROP COLUMN "tag_ids";
+-- reverse: create index "tags_group_id_name_idx" to table: "tags"
+DROP INDEX "tags_group_id_name_idx";
+-- reverse: create "tags" table
+DROP TABLE "tags";
@@ -0,0 +1,60 @@
+-- create "tags" table
+CREATE TABLE "tags" (
+  "id" bigserial NOT NULL,
+  "group_id" bigint NOT NULL,
+  "name" character varying(50) NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "version" integer NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "tags_group_id_name_idx" to table: "tags"
+CREATE UNIQUE INDEX "tags_group_id_name_idx" ON "tags" ("group_id", "name");
+-- modify "expenses" table
+ALTER TABLE "expenses" ADD COLUMN "tag_ids" bigint[] NOT NULL DEFAULT ARRAY[]::bigint[];
+-- modify "expenses_latest" view
+CREATE OR REPLACE VIEW "expenses_latest" (
+  "id",
+  "name",
+  "amount_cents",
+  "refund_amount_cents",
+  "description",
+  "group_id",
+  "category_id",
+  "split_ratio",
+  "split_type",
+  "payer_id",
+  "receiver_id",
+  "document_search",
+  "created_at",
+  "updated_at",
+  "deleted_at",
+  "version",
+  "purchase_id",
+  "installment_number",
+  "installment_total",
+  "updated_by",
+  "tag_ids"
+) AS SELECT DISTINCT ON (expenses.id) expenses.id,
+    expenses.name,
+    expenses.amount_cents,
+    expenses.refund_amount_cents,
+    expenses.description,
+    expenses.group_id,
+    expenses.category_id,
+    expenses.split_ratio,
+    expenses.split_type,
+    expenses.payer_id,
+    expenses.receiver_id,
+    expenses.document_search,
+    expenses.created_at,
+    expenses.updated_at,
+    expenses.deleted_at,
+    expenses.version,
+    expenses.purchase_id,
+    expenses.installment_number,
+    expenses.installment_total,
+    expenses.updated_by,
+    expenses.tag_ids
+   FROM expenses
+  ORDER BY expenses.id DESC, expenses.version DESC;
@@ -0,0 +1,2 @@
+-- drop "budgets" table
+DROP TABLE "budgets";
@@ -0,0 +1,18 @@
+-- create "budgets" table
+CREATE TABLE "budgets" (
+  "id" bigserial NOT NULL,
+  "group_id" bigint NOT NULL,
+  "category_id" bigint NULL,
+  "category_group_id" bigint NULL,
+  "amount_cents" integer NOT NULL,
+  "carry_over" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "version" integer NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "budgets_scope_check" CHECK ((category_id IS NULL) <> (category_group_id IS NULL))
+);
+-- create index "budgets_group_id_category_id_idx" to table: "budgets"
+CREATE UNIQUE INDEX "budgets_group_id_category_id_idx" ON "budgets" ("group_id", "category_id") WHERE (category_id IS NOT NULL);
+-- create index "budgets_group_id_category_group_id_idx" to table: "budgets"
+CREATE UNIQUE INDEX "budgets_group_id_category_group_id_idx" ON "budgets" ("group_id", "category_group_id") WHERE (category_group_id IS NOT NULL);
@@ -0,0 +1,2 @@
+-- reverse: create index "expenses_tag_ids_idx" to table: "expenses"
+DROP INDEX "expenses_tag_ids_idx";
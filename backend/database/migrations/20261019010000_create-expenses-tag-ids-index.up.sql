@@ -0,0 +1,2 @@
+-- create index "expenses_tag_ids_idx" to table: "expenses"
+CREATE INDEX "expenses_tag_ids_idx" ON "expenses" USING gin ("tag_ids");
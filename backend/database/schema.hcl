@@ -2,7 +2,7 @@ schema "public" {}
 
 enum "split_type" {
   schema = schema.public
-  values = ["equal", "proportional", "transfer"]
+  values = ["equal", "proportional", "transfer", "exact", "weighted"]
 }
 
 table "expenses" {
@@ -74,6 +74,26 @@ table "expenses" {
     type = int
     null = false
   }
+  column "purchase_id" {
+    type = uuid
+    null = true
+  }
+  column "installment_number" {
+    type = int
+    null = true
+  }
+  column "installment_total" {
+    type = int
+    null = true
+  }
+  column "updated_by" {
+    type = bigint
+    null = true
+  }
+  column "tag_ids" {
+    type    = sql("bigint[]")
+    default = sql("array[]::bigint[]")
+  }
 
   primary_key {
     columns = [column.id, column.version]
@@ -103,11 +123,24 @@ table "expenses" {
     type    = GIN
     columns = [column.document_search]
   }
+
+  index "expenses_purchase_id_idx" {
+    columns = [column.purchase_id]
+  }
+
+  index "expenses_group_id_amount_cents_idx" {
+    columns = [column.group_id, column.amount_cents]
+  }
+
+  index "expenses_tag_ids_idx" {
+    type    = GIN
+    columns = [column.tag_ids]
+  }
 }
 
 view "expenses_latest" {
   schema = schema.public
-  as     = "SELECT DISTINCT ON (id) id, name, amount_cents, refund_amount_cents, description, group_id, category_id, split_ratio, split_type, payer_id, receiver_id, document_search, created_at, updated_at, deleted_at, version FROM expenses ORDER BY id DESC, version DESC"
+  as     = "SELECT DISTINCT ON (id) id, name, amount_cents, refund_amount_cents, description, group_id, category_id, split_ratio, split_type, payer_id, receiver_id, document_search, created_at, updated_at, deleted_at, version, purchase_id, installment_number, installment_total, updated_by, tag_ids FROM expenses ORDER BY id DESC, version DESC"
 }
 
 table "groups" {
@@ -241,6 +274,10 @@ table "users" {
     type = varchar(255)
     null = true
   }
+  column "pix_key" {
+    type = varchar(77)
+    null = true
+  }
   column "group_id" {
     type = bigint
     null = true
@@ -495,6 +532,15 @@ table "scheduled_expenses" {
     type = date
     null = true
   }
+  column "recurrence" {
+    type = jsonb
+    null = true
+  }
+  column "occurrence_count" {
+    type    = int
+    null    = false
+    default = 0
+  }
   column "is_active" {
     type = boolean
     null = false
@@ -507,6 +553,64 @@ table "scheduled_expenses" {
     type = timestamptz
     null = false
   }
+  column "deleted_at" {
+    type = timestamptz
+    null = true
+  }
+  column "version" {
+    type = int
+    null = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  index "scheduled_expenses_group_id_idx" {
+    columns = [column.group_id]
+  }
+}
+
+table "settlements" {
+  schema = schema.public
+
+  column "id" {
+    type = bigserial
+    null = false
+  }
+  column "group_id" {
+    type = bigint
+    null = false
+  }
+  column "payer_id" {
+    type = bigint
+    null = false
+  }
+  column "receiver_id" {
+    type = bigint
+    null = false
+  }
+  column "amount_cents" {
+    type = bigint
+    null = false
+  }
+  column "note" {
+    type    = text
+    null    = false
+    default = ""
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+  column "updated_at" {
+    type = timestamptz
+    null = false
+  }
+  column "deleted_at" {
+    type = timestamptz
+    null = true
+  }
   column "version" {
     type = int
     null = false
@@ -515,4 +619,346 @@ table "scheduled_expenses" {
   primary_key {
     columns = [column.id]
   }
+
+  foreign_key "settlement_group_id_fk" {
+    columns     = [column.group_id]
+    ref_columns = [table.groups.column.id]
+  }
+
+  foreign_key "settlement_payer_id_fk" {
+    columns     = [column.payer_id]
+    ref_columns = [table.users.column.id]
+  }
+
+  foreign_key "settlement_receiver_id_fk" {
+    columns     = [column.receiver_id]
+    ref_columns = [table.users.column.id]
+  }
+
+  index "settlements_group_id_idx" {
+    columns = [column.group_id, column.created_at]
+  }
+}
+
+table "scheduled_expense_occurrences" {
+  schema = schema.public
+
+  column "scheduled_expense_id" {
+    type = bigint
+    null = false
+  }
+  column "due_date" {
+    type = date
+    null = false
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+
+  primary_key {
+    columns = [column.scheduled_expense_id, column.due_date]
+  }
+}
+
+table "outbox_events" {
+  schema = schema.public
+
+  column "id" {
+    type = bigserial
+    null = false
+  }
+  column "uuid" {
+    type = uuid
+    null = false
+  }
+  column "topic" {
+    type = text
+    null = false
+  }
+  column "payload" {
+    type = jsonb
+    null = false
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+}
+
+enum "import_candidate_status" {
+  schema = schema.public
+  values = ["pending", "confirmed", "discarded"]
+}
+
+table "import_candidates" {
+  schema = schema.public
+
+  column "id" {
+    type = bigserial
+    null = false
+  }
+  column "group_id" {
+    type = bigint
+    null = false
+  }
+  column "source" {
+    type = text
+    null = false
+  }
+  column "external_id" {
+    type = text
+    null = false
+  }
+  column "name" {
+    type = text
+    null = false
+  }
+  column "amount_cents" {
+    type = bigint
+    null = false
+  }
+  column "date" {
+    type = date
+    null = false
+  }
+  column "category_id" {
+    type = bigint
+    null = true
+  }
+  column "status" {
+    type = enum.import_candidate_status
+    null = false
+  }
+  column "expense_id" {
+    type = bigint
+    null = true
+  }
+  column "imported_by" {
+    type = bigint
+    null = false
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+  column "updated_at" {
+    type = timestamptz
+    null = false
+  }
+  column "version" {
+    type = int
+    null = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  index "import_candidates_external_id_idx" {
+    columns = [column.group_id, column.source, column.external_id]
+    unique  = true
+  }
+
+  index "import_candidates_group_id_status_idx" {
+    columns = [column.group_id, column.status]
+  }
+}
+
+table "expense_duplicate_dismissals" {
+  schema = schema.public
+
+  column "expense_id" {
+    type = bigint
+    null = false
+  }
+  column "other_expense_id" {
+    type = bigint
+    null = false
+  }
+  column "group_id" {
+    type = bigint
+    null = false
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+
+  primary_key {
+    columns = [column.expense_id, column.other_expense_id]
+  }
+
+  check "expense_duplicate_dismissals_order_check" {
+    expr = "(expense_id < other_expense_id)"
+  }
+
+  index "expense_duplicate_dismissals_group_id_idx" {
+    columns = [column.group_id]
+  }
+}
+
+table "expense_attachments" {
+  schema = schema.public
+
+  column "id" {
+    type = bigserial
+    null = false
+  }
+  column "expense_id" {
+    type = bigint
+    null = false
+  }
+  column "group_id" {
+    type = bigint
+    null = false
+  }
+  column "file_name" {
+    type = text
+    null = false
+  }
+  column "content_type" {
+    type = text
+    null = false
+  }
+  column "size_bytes" {
+    type = bigint
+    null = false
+  }
+  column "storage_key" {
+    type = text
+    null = false
+  }
+  column "thumbnail_key" {
+    type = text
+    null = true
+  }
+  column "uploaded_by" {
+    type = bigint
+    null = false
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+  column "updated_at" {
+    type = timestamptz
+    null = false
+  }
+  column "version" {
+    type = int
+    null = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  index "expense_attachments_expense_id_idx" {
+    columns = [column.expense_id]
+  }
+}
+
+table "tags" {
+  schema = schema.public
+
+  column "id" {
+    type = bigserial
+    null = false
+  }
+  column "group_id" {
+    type = bigint
+    null = false
+  }
+  column "name" {
+    type = varchar(50)
+    null = false
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+  column "updated_at" {
+    type = timestamptz
+    null = false
+  }
+  column "version" {
+    type = int
+    null = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  index "tags_group_id_name_idx" {
+    unique  = true
+    columns = [column.group_id, column.name]
+  }
+}
+
+table "budgets" {
+  schema = schema.public
+
+  column "id" {
+    type = bigserial
+    null = false
+  }
+  column "group_id" {
+    type = bigint
+    null = false
+  }
+  column "category_id" {
+    type = bigint
+    null = true
+  }
+  column "category_group_id" {
+    type = bigint
+    null = true
+  }
+  column "amount_cents" {
+    type = int
+    null = false
+  }
+  column "carry_over" {
+    type    = boolean
+    null    = false
+    default = false
+  }
+  column "created_at" {
+    type = timestamptz
+    null = false
+  }
+  column "updated_at" {
+    type = timestamptz
+    null = false
+  }
+  column "version" {
+    type = int
+    null = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  check "budgets_scope_check" {
+    expr = "((category_id IS NULL) <> (category_group_id IS NULL))"
+  }
+
+  index "budgets_group_id_category_id_idx" {
+    unique  = true
+    columns = [column.group_id, column.category_id]
+    where   = "(category_id IS NOT NULL)"
+  }
+
+  index "budgets_group_id_category_group_id_idx" {
+    unique  = true
+    columns = [column.group_id, column.category_group_id]
+    where   = "(category_group_id IS NOT NULL)"
+  }
 }
@@ -5,26 +5,23 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
-
-	"github.com/jmoiron/sqlx"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/auth"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 )
 
 type AuthRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
 func NewAuthRepository(db *db.Client) auth.Repository {
-	return &AuthRepository{db: db.Conn()}
+	return &AuthRepository{db: db}
 }
 
 func (repo *AuthRepository) GetNextID() auth.ID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT NEXTVAL('authentications_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT NEXTVAL('authentications_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.Select: %w", err))
 	}
 
@@ -34,7 +31,7 @@ func (repo *AuthRepository) GetNextID() auth.ID {
 func (repo *AuthRepository) GetByID(ctx context.Context, id auth.ID) (*auth.Auth, error) {
 	var model AuthModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, email, password, provider_id, type, created_at, updated_at, deleted_at, version
 		FROM authentications WHERE id = $1
 		AND deleted_at IS NULL
@@ -53,7 +50,7 @@ func (repo *AuthRepository) GetByID(ctx context.Context, id auth.ID) (*auth.Auth
 func (repo *AuthRepository) GetByEmail(ctx context.Context, email string, authType auth.Type) (*auth.Auth, error) {
 	var model AuthModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, email, password, provider_id, type, created_at, updated_at, deleted_at, version
 		FROM authentications WHERE email = $1 AND type = $2
 		AND deleted_at IS NULL
@@ -71,32 +68,40 @@ func (repo *AuthRepository) GetByEmail(ctx context.Context, email string, authTy
 
 func (repo *AuthRepository) Store(ctx context.Context, entity *auth.Auth) error {
 	model := toModel(entity)
-	if err := repo.create(ctx, model); err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			if err := repo.update(ctx, model); err != nil {
-				return fmt.Errorf("repo.update: %w", err)
-			}
-			return nil
-		}
+	created, err := repo.create(ctx, model)
+	if err != nil {
 		return fmt.Errorf("repo.create: %w", err)
 	}
 
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func (repo *AuthRepository) create(ctx context.Context, model AuthModel) error {
-	if _, err := repo.db.NamedExecContext(ctx, `
+func (repo *AuthRepository) create(ctx context.Context, model AuthModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		INSERT INTO authentications (id, email, password, provider_id, type, created_at, updated_at, deleted_at, version)
 		VALUES (:id, :email, :password, :provider_id, :type, :created_at, :updated_at, :deleted_at, :version)
-	`, model); err != nil {
-		return fmt.Errorf("db.Insert: %w", err)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
 }
 
 func (repo *AuthRepository) update(ctx context.Context, model AuthModel) error {
-	result, err := repo.db.NamedExecContext(ctx, `
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		UPDATE authentications SET password = :password, updated_at = :updated_at, deleted_at = :deleted_at, version = version + 1
 		WHERE id = :id AND version = :version
 	`, model)
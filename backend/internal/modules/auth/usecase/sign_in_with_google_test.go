@@ -11,6 +11,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/auth"
 	"github.com/Beigelman/nossas-despesas/internal/modules/auth/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 	"github.com/Beigelman/nossas-despesas/internal/shared/service"
 )
@@ -36,7 +37,7 @@ func TestSignInWithGoogle(t *testing.T) {
 		Picture: func() *string { s := "https://example.com/pic.jpg"; return &s }(),
 	}
 
-	signInWithGoogle := usecase.NewSignInWithGoogle(userRepo, authRepo, tokenProvider, googleValidator)
+	signInWithGoogle := usecase.NewSignInWithGoogle(userRepo, authRepo, tokenProvider, googleValidator, dbtest.UnitOfWork)
 
 	t.Run("googleValidator.ValidateToken returns error", func(t *testing.T) {
 		googleValidator.EXPECT().ValidateToken(ctx, "invalid-token").Return(nil, errors.New("invalid token")).Once()
@@ -7,6 +7,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/auth"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 	"github.com/Beigelman/nossas-despesas/internal/shared/service"
 )
@@ -28,7 +29,7 @@ type SignUpWithCredentialsResponse struct {
 
 type SignUpWithCredentials func(ctx context.Context, p SignUpWithCredentialsParams) (*SignUpWithCredentialsResponse, error)
 
-func NewSignUpWithCredentials(userRepo user.Repository, authRepo auth.Repository, tokenProvider service.TokenProvider) SignUpWithCredentials {
+func NewSignUpWithCredentials(userRepo user.Repository, authRepo auth.Repository, tokenProvider service.TokenProvider, unitOfWork db.UnitOfWork) SignUpWithCredentials {
 	return func(ctx context.Context, p SignUpWithCredentialsParams) (*SignUpWithCredentialsResponse, error) {
 		existingAuth, err := authRepo.GetByEmail(ctx, p.Email, auth.Types.Credentials)
 		if err != nil {
@@ -59,10 +60,6 @@ func NewSignUpWithCredentials(userRepo user.Repository, authRepo auth.Repository
 				ProfilePicture: p.ProfilePicture,
 				GroupID:        p.GroupID,
 			})
-
-			if err := userRepo.Store(ctx, usr); err != nil {
-				return nil, fmt.Errorf("userRepo.Store: %w", err)
-			}
 		}
 
 		authentic, err := auth.NewCredentialAuth(auth.CredentialsAttributes{
@@ -74,8 +71,20 @@ func NewSignUpWithCredentials(userRepo user.Repository, authRepo auth.Repository
 			return nil, fmt.Errorf("auth.NewCredentialAuth: %w", err)
 		}
 
-		if err := authRepo.Store(ctx, authentic); err != nil {
-			return nil, fmt.Errorf("authRepo.Store: %w", err)
+		if err := unitOfWork(ctx, func(ctx context.Context) error {
+			if existingUser == nil {
+				if err := userRepo.Store(ctx, usr); err != nil {
+					return fmt.Errorf("userRepo.Store: %w", err)
+				}
+			}
+
+			if err := authRepo.Store(ctx, authentic); err != nil {
+				return fmt.Errorf("authRepo.Store: %w", err)
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
 		}
 
 		authToken, refreshToken, err := tokenProvider.GenerateUserTokens(*usr)
@@ -11,6 +11,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/auth"
 	"github.com/Beigelman/nossas-despesas/internal/modules/auth/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
 
@@ -35,7 +36,7 @@ func TestSignUpWithCredentials(t *testing.T) {
 		Password: "12345678",
 	})
 
-	signUpWithCredentials := usecase.NewSignUpWithCredentials(userRepo, authRepo, tokenProvider)
+	signUpWithCredentials := usecase.NewSignUpWithCredentials(userRepo, authRepo, tokenProvider, dbtest.UnitOfWork)
 
 	t.Run("should return error with authRepo fails", func(t *testing.T) {
 		authRepo.EXPECT().GetByEmail(ctx, "test@email.com", auth.Types.Credentials).Return(nil, errors.New("test error")).Once()
@@ -5,26 +5,23 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
-
-	"github.com/jmoiron/sqlx"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 )
 
 type CategoryGroupRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
 func NewCategoryGroupRepository(db *db.Client) category.GroupRepository {
-	return &CategoryGroupRepository{db: db.Conn()}
+	return &CategoryGroupRepository{db: db}
 }
 
 func (repo *CategoryGroupRepository) GetNextID() category.GroupID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT NEXTVAL('category_groups_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT NEXTVAL('category_groups_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.QueryRow: %w", err))
 	}
 
@@ -34,7 +31,7 @@ func (repo *CategoryGroupRepository) GetNextID() category.GroupID {
 func (repo *CategoryGroupRepository) GetByName(ctx context.Context, name string) (*category.Group, error) {
 	var model CategoryGroupModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, name, icon, created_at, updated_at, deleted_at, version
 		FROM category_groups WHERE name = $1
 		AND deleted_at IS NULL
@@ -53,7 +50,7 @@ func (repo *CategoryGroupRepository) GetByName(ctx context.Context, name string)
 func (repo *CategoryGroupRepository) GetByID(ctx context.Context, id category.GroupID) (*category.Group, error) {
 	var model CategoryGroupModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, name, icon, created_at, updated_at, deleted_at, version
 		FROM category_groups WHERE id = $1
 		AND deleted_at IS NULL
@@ -71,32 +68,40 @@ func (repo *CategoryGroupRepository) GetByID(ctx context.Context, id category.Gr
 
 func (repo *CategoryGroupRepository) Store(ctx context.Context, entity *category.Group) error {
 	model := groupCategoryToModel(entity)
-	if err := repo.create(ctx, model); err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			if err := repo.update(ctx, model); err != nil {
-				return fmt.Errorf("repo.update: %w", err)
-			}
-			return nil
-		}
+	created, err := repo.create(ctx, model)
+	if err != nil {
 		return fmt.Errorf("repo.create: %w", err)
 	}
 
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func (repo *CategoryGroupRepository) create(ctx context.Context, model CategoryGroupModel) error {
-	if _, err := repo.db.NamedExecContext(ctx, `
+func (repo *CategoryGroupRepository) create(ctx context.Context, model CategoryGroupModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		INSERT INTO category_groups (id, name, icon, created_at, updated_at, deleted_at, version)
 		VALUES (:id, :name, :icon, :created_at, :updated_at, :deleted_at, :version)
-	`, model); err != nil {
-		return fmt.Errorf("db.Insert: %w", err)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
 }
 
 func (repo *CategoryGroupRepository) update(ctx context.Context, model CategoryGroupModel) error {
-	result, err := repo.db.NamedExecContext(ctx, `
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		UPDATE category_groups SET name = :name, icon = :icon, updated_at = :updated_at, deleted_at = :deleted_at, version = version + 1
 		WHERE id = :id AND version = :version
 	`, model)
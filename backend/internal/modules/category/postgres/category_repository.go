@@ -5,26 +5,23 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
-
-	"github.com/jmoiron/sqlx"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 )
 
 type CategoryRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
 func NewCategoryRepository(db *db.Client) category.Repository {
-	return &CategoryRepository{db: db.Conn()}
+	return &CategoryRepository{db: db}
 }
 
 func (repo *CategoryRepository) GetNextID() category.ID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT NEXTVAL('categories_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT NEXTVAL('categories_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.QueryRow: %w", err))
 	}
 
@@ -34,7 +31,7 @@ func (repo *CategoryRepository) GetNextID() category.ID {
 func (repo *CategoryRepository) GetByName(ctx context.Context, name string) (*category.Category, error) {
 	var model CategoryModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, name, icon, category_group_id, created_at, updated_at, deleted_at, version
 		FROM categories WHERE name = $1
 		AND deleted_at IS NULL
@@ -53,7 +50,7 @@ func (repo *CategoryRepository) GetByName(ctx context.Context, name string) (*ca
 func (repo *CategoryRepository) GetByID(ctx context.Context, id category.ID) (*category.Category, error) {
 	var model CategoryModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, name, icon, category_group_id, created_at, updated_at, deleted_at, version
 		FROM categories WHERE id = $1
 		AND deleted_at IS NULL
@@ -71,32 +68,40 @@ func (repo *CategoryRepository) GetByID(ctx context.Context, id category.ID) (*c
 
 func (repo *CategoryRepository) Store(ctx context.Context, entity *category.Category) error {
 	model := categoryToModel(entity)
-	if err := repo.create(ctx, model); err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			if err := repo.update(ctx, model); err != nil {
-				return fmt.Errorf("repo.update: %w", err)
-			}
-			return nil
-		}
+	created, err := repo.create(ctx, model)
+	if err != nil {
 		return fmt.Errorf("repo.create: %w", err)
 	}
 
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func (repo *CategoryRepository) create(ctx context.Context, model CategoryModel) error {
-	if _, err := repo.db.NamedExecContext(ctx, `
+func (repo *CategoryRepository) create(ctx context.Context, model CategoryModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		INSERT INTO categories (id, name, icon, category_group_id, created_at, updated_at, deleted_at, version)
 		VALUES (:id, :name, :icon, :category_group_id, :created_at, :updated_at, :deleted_at, :version)
-	`, model); err != nil {
-		return fmt.Errorf("db.Insert: %w", err)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
 }
 
 func (repo *CategoryRepository) update(ctx context.Context, model CategoryModel) error {
-	result, err := repo.db.NamedExecContext(ctx, `
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		UPDATE categories SET name = :name, icon = :icon, category_group_id = :category_group_id, updated_at = :updated_at, deleted_at = :deleted_at, version = version + 1
 		WHERE id = :id AND version = :version
 	`, model)
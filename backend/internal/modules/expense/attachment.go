@@ -0,0 +1,108 @@
+package expense
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+// MaxAttachmentSize is the largest file that can be attached to an expense, in bytes.
+const MaxAttachmentSize = 10 << 20
+
+// AttachmentContentTypes are the formats receipts and invoices are accepted in.
+var AttachmentContentTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp", "application/pdf"}
+
+type AttachmentID struct{ Value int }
+
+// Attachment is a file, usually the photo of a receipt or the PDF of an invoice, attached to an
+// expense. The file itself is kept in the file storage under StorageKey.
+type Attachment struct {
+	ddd.Entity[AttachmentID]
+	ExpenseID   ID
+	GroupID     group.ID
+	FileName    string
+	ContentType string
+	Size        int
+	StorageKey  string
+	// ThumbnailKey is where the preview of an image is kept, nil for files without one.
+	ThumbnailKey *string
+	UploadedBy   user.ID
+}
+
+type AttachmentAttributes struct {
+	ID          AttachmentID
+	ExpenseID   ID
+	GroupID     group.ID
+	FileName    string
+	ContentType string
+	Size        int
+	UploadedBy  user.ID
+}
+
+func NewAttachment(attr AttachmentAttributes) (*Attachment, error) {
+	attachment := &Attachment{
+		Entity: ddd.Entity[AttachmentID]{
+			ID:        attr.ID,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			Version:   0,
+		},
+		ExpenseID:   attr.ExpenseID,
+		GroupID:     attr.GroupID,
+		FileName:    attr.FileName,
+		ContentType: attr.ContentType,
+		Size:        attr.Size,
+		StorageKey:  fmt.Sprintf("attachments/%d/%d/%d", attr.GroupID.Value, attr.ExpenseID.Value, attr.ID.Value),
+		UploadedBy:  attr.UploadedBy,
+	}
+
+	if err := attachment.validate(); err != nil {
+		return nil, fmt.Errorf("attachment validation failed: %w", err)
+	}
+
+	return attachment, nil
+}
+
+func (a *Attachment) validate() error {
+	if a.FileName == "" {
+		return fmt.Errorf("file name is required")
+	}
+
+	if a.Size <= 0 {
+		return fmt.Errorf("file is empty")
+	}
+
+	if a.Size > MaxAttachmentSize {
+		return fmt.Errorf("file is larger than %d MB", MaxAttachmentSize>>20)
+	}
+
+	if !slices.Contains(AttachmentContentTypes, a.ContentType) {
+		return fmt.Errorf("unsupported file type %s", a.ContentType)
+	}
+
+	return nil
+}
+
+// IsImage tells whether a thumbnail can be made of the file.
+func (a *Attachment) IsImage() bool {
+	return strings.HasPrefix(a.ContentType, "image/")
+}
+
+// SetThumbnail records that a preview of the file was kept, returning the key to keep it under.
+func (a *Attachment) SetThumbnail() string {
+	key := a.StorageKey + "-thumbnail"
+	a.ThumbnailKey = &key
+	return key
+}
+
+type AttachmentRepository interface {
+	ddd.Repository[AttachmentID, Attachment]
+	GetByExpenseID(ctx context.Context, expenseID ID) ([]Attachment, error)
+	Delete(ctx context.Context, id AttachmentID) error
+}
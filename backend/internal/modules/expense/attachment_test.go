@@ -0,0 +1,43 @@
+package expense
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+)
+
+func TestNewAttachment(t *testing.T) {
+	attr := AttachmentAttributes{
+		ID:          AttachmentID{Value: 3},
+		ExpenseID:   ID{Value: 2},
+		GroupID:     group.ID{Value: 1},
+		FileName:    "nota-fiscal.pdf",
+		ContentType: "application/pdf",
+		Size:        1024,
+		UploadedBy:  user.ID{Value: 1},
+	}
+
+	attachment, err := NewAttachment(attr)
+	assert.NoError(t, err)
+	assert.Equal(t, "attachments/1/2/3", attachment.StorageKey)
+	assert.False(t, attachment.IsImage())
+	assert.Nil(t, attachment.ThumbnailKey)
+
+	attr.ContentType = "image/jpeg"
+	attachment, err = NewAttachment(attr)
+	assert.NoError(t, err)
+	assert.True(t, attachment.IsImage())
+	assert.Equal(t, "attachments/1/2/3-thumbnail", attachment.SetThumbnail())
+	assert.Equal(t, "attachments/1/2/3-thumbnail", *attachment.ThumbnailKey)
+
+	attr.ContentType = "application/zip"
+	_, err = NewAttachment(attr)
+	assert.EqualError(t, err, "attachment validation failed: unsupported file type application/zip")
+
+	attr.ContentType, attr.Size = "image/png", MaxAttachmentSize+1
+	_, err = NewAttachment(attr)
+	assert.EqualError(t, err, "attachment validation failed: file is larger than 10 MB")
+}
@@ -0,0 +1,177 @@
+package expense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+// BudgetAlertThresholds are the percentages of a budget that, once reached, warn the group.
+var BudgetAlertThresholds = []int{80, 100}
+
+var ErrInvalidBudgetScope = errors.New("a budget is either for a category or for a category group")
+
+type BudgetID struct{ Value int }
+
+// Budget is the monthly spending limit of a group for a category or a whole category group. It
+// applies from the month it was created on. With CarryOver, what is left of a month, or what
+// was overspent, moves to the next one.
+type Budget struct {
+	ddd.Entity[BudgetID]
+	GroupID         group.ID
+	CategoryID      *category.ID
+	CategoryGroupID *category.GroupID
+	Amount          int
+	CarryOver       bool
+}
+
+type BudgetAttributes struct {
+	ID              BudgetID
+	GroupID         group.ID
+	CategoryID      *category.ID
+	CategoryGroupID *category.GroupID
+	Amount          int
+	CarryOver       bool
+}
+
+type UpdateBudgetAttributes struct {
+	Amount    *int
+	CarryOver *bool
+}
+
+// BudgetAlert tells that an expense took the spending of a month to a threshold of a budget.
+type BudgetAlert struct {
+	BudgetID        BudgetID
+	CategoryID      *category.ID
+	CategoryGroupID *category.GroupID
+	Month           time.Time
+	Threshold       int
+	Limit           int
+	Spent           int
+}
+
+func NewBudget(attr BudgetAttributes) (*Budget, error) {
+	budget := &Budget{
+		Entity: ddd.Entity[BudgetID]{
+			ID:        attr.ID,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			Version:   0,
+		},
+		GroupID:         attr.GroupID,
+		CategoryID:      attr.CategoryID,
+		CategoryGroupID: attr.CategoryGroupID,
+		Amount:          attr.Amount,
+		CarryOver:       attr.CarryOver,
+	}
+
+	if err := budget.validate(); err != nil {
+		return nil, fmt.Errorf("budget validation failed: %w", err)
+	}
+
+	return budget, nil
+}
+
+func (b *Budget) Update(attr UpdateBudgetAttributes) error {
+	if attr.Amount != nil {
+		b.Amount = *attr.Amount
+	}
+	if attr.CarryOver != nil {
+		b.CarryOver = *attr.CarryOver
+	}
+	b.UpdatedAt = time.Now()
+
+	if err := b.validate(); err != nil {
+		return fmt.Errorf("budget validation failed: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Budget) validate() error {
+	if (b.CategoryID == nil) == (b.CategoryGroupID == nil) {
+		return ErrInvalidBudgetScope
+	}
+
+	if b.Amount <= 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+
+	return nil
+}
+
+// Covers tells whether expenses of the category count against the budget.
+func (b *Budget) Covers(cat category.Category) bool {
+	if b.CategoryID != nil {
+		return *b.CategoryID == cat.ID
+	}
+
+	return *b.CategoryGroupID == cat.GroupCategoryID
+}
+
+// StartMonth is the first month the budget applies to.
+func (b *Budget) StartMonth() time.Time {
+	return MonthOf(b.CreatedAt)
+}
+
+// Limit is how much can be spent in a month, given what was spent in each month from StartMonth
+// up to the one before it, oldest first. Without CarryOver it is always the budget amount.
+func (b *Budget) Limit(earlierSpending []int) int {
+	limit := b.Amount
+	if !b.CarryOver {
+		return limit
+	}
+
+	for _, spent := range earlierSpending {
+		limit = b.Amount + limit - spent
+	}
+
+	return limit
+}
+
+// Alerts lists the thresholds an expense crossed when it took the spending of the month from
+// before to after.
+func (b *Budget) Alerts(month time.Time, limit, before, after int) []BudgetAlert {
+	var alerts []BudgetAlert
+	for _, threshold := range BudgetAlertThresholds {
+		// A month that starts overspent, from the carry over, has nothing left to warn about
+		if limit <= 0 {
+			break
+		}
+
+		reach := limit * threshold / 100
+		if before < reach && after >= reach {
+			alerts = append(alerts, BudgetAlert{
+				BudgetID:        b.ID,
+				CategoryID:      b.CategoryID,
+				CategoryGroupID: b.CategoryGroupID,
+				Month:           month,
+				Threshold:       threshold,
+				Limit:           limit,
+				Spent:           after,
+			})
+		}
+	}
+
+	return alerts
+}
+
+// MonthOf returns the first instant of the month of t, in UTC.
+func MonthOf(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+type BudgetRepository interface {
+	ddd.Repository[BudgetID, Budget]
+	GetByGroupID(ctx context.Context, groupID group.ID) ([]Budget, error)
+	Delete(ctx context.Context, id BudgetID) error
+	// GetMonthlySpending returns how much the group spent in the scope of the budget in each
+	// month from its StartMonth up to the given one, oldest first.
+	GetMonthlySpending(ctx context.Context, budget Budget, until time.Time) ([]int, error)
+}
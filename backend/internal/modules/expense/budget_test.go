@@ -0,0 +1,103 @@
+package expense
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+)
+
+func TestNewBudget(t *testing.T) {
+	categoryID := category.ID{Value: 1}
+	categoryGroupID := category.GroupID{Value: 2}
+
+	budget, err := NewBudget(BudgetAttributes{ID: BudgetID{Value: 1}, GroupID: group.ID{Value: 1}, CategoryID: &categoryID, Amount: 50000})
+	assert.NoError(t, err)
+	assert.Equal(t, 50000, budget.Amount)
+	assert.False(t, budget.CarryOver)
+
+	_, err = NewBudget(BudgetAttributes{GroupID: group.ID{Value: 1}, CategoryID: &categoryID, CategoryGroupID: &categoryGroupID, Amount: 50000})
+	assert.ErrorIs(t, err, ErrInvalidBudgetScope)
+
+	_, err = NewBudget(BudgetAttributes{GroupID: group.ID{Value: 1}, Amount: 50000})
+	assert.ErrorIs(t, err, ErrInvalidBudgetScope)
+
+	_, err = NewBudget(BudgetAttributes{GroupID: group.ID{Value: 1}, CategoryGroupID: &categoryGroupID})
+	assert.EqualError(t, err, "budget validation failed: amount must be positive")
+}
+
+func TestBudget_Update(t *testing.T) {
+	categoryID := category.ID{Value: 1}
+	budget, err := NewBudget(BudgetAttributes{GroupID: group.ID{Value: 1}, CategoryID: &categoryID, Amount: 50000})
+	assert.NoError(t, err)
+
+	amount, carryOver := 30000, true
+	assert.NoError(t, budget.Update(UpdateBudgetAttributes{Amount: &amount, CarryOver: &carryOver}))
+	assert.Equal(t, 30000, budget.Amount)
+	assert.True(t, budget.CarryOver)
+
+	amount = 0
+	assert.Error(t, budget.Update(UpdateBudgetAttributes{Amount: &amount}))
+}
+
+func TestBudget_Covers(t *testing.T) {
+	categoryID := category.ID{Value: 1}
+	categoryGroupID := category.GroupID{Value: 2}
+	cat := *category.New(category.Attributes{ID: categoryID, CategoryGroupID: categoryGroupID})
+	other := *category.New(category.Attributes{ID: category.ID{Value: 3}, CategoryGroupID: category.GroupID{Value: 4}})
+
+	categoryBudget := Budget{CategoryID: &categoryID}
+	assert.True(t, categoryBudget.Covers(cat))
+	assert.False(t, categoryBudget.Covers(other))
+
+	groupBudget := Budget{CategoryGroupID: &categoryGroupID}
+	assert.True(t, groupBudget.Covers(cat))
+	assert.False(t, groupBudget.Covers(other))
+}
+
+func TestBudget_Limit(t *testing.T) {
+	budget := Budget{Amount: 10000}
+	assert.Equal(t, 10000, budget.Limit([]int{2000, 15000}))
+
+	budget.CarryOver = true
+	assert.Equal(t, 10000, budget.Limit(nil))
+	// 8000 left from the first month, 3000 overspent in the second
+	assert.Equal(t, 18000, budget.Limit([]int{2000}))
+	assert.Equal(t, 7000, budget.Limit([]int{2000, 21000}))
+}
+
+func TestBudget_Alerts(t *testing.T) {
+	categoryID := category.ID{Value: 1}
+	budget := Budget{CategoryID: &categoryID, Amount: 10000}
+	month := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Empty(t, budget.Alerts(month, 10000, 1000, 7999))
+
+	alerts := budget.Alerts(month, 10000, 7000, 8000)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, 80, alerts[0].Threshold)
+	assert.Equal(t, 8000, alerts[0].Spent)
+	assert.Equal(t, &categoryID, alerts[0].CategoryID)
+	assert.Equal(t, month, alerts[0].Month)
+
+	alerts = budget.Alerts(month, 10000, 7000, 12000)
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, 100, alerts[1].Threshold)
+
+	// Thresholds already reached are not raised again
+	alerts = budget.Alerts(month, 10000, 9000, 10500)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, 100, alerts[0].Threshold)
+	assert.Empty(t, budget.Alerts(month, 10000, 10500, 11000))
+
+	assert.Empty(t, budget.Alerts(month, -500, 0, 1000))
+}
+
+func TestMonthOf(t *testing.T) {
+	saoPaulo := time.FixedZone("America/Sao_Paulo", -3*60*60)
+	assert.Equal(t, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), MonthOf(time.Date(2026, 5, 31, 22, 0, 0, 0, saoPaulo)))
+	assert.Equal(t, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), MonthOf(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)))
+}
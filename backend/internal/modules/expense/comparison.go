@@ -0,0 +1,192 @@
+package expense
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+)
+
+// ComparisonTopMovers is how many categories that changed the most a spending comparison points out.
+const ComparisonTopMovers = 5
+
+// ComparisonPeriod is a window of whole months, starting at the first instant of Start.
+type ComparisonPeriod struct {
+	Start  time.Time
+	Months int
+}
+
+// ComparisonPeriods returns the window of months ending at the month of endMonth and the one it
+// is compared to: the window right before it or, when lastYear is set, the same one a year before.
+func ComparisonPeriods(endMonth time.Time, months int, lastYear bool) (current, previous ComparisonPeriod) {
+	shift := months
+	if lastYear {
+		shift = 12
+	}
+
+	start := MonthOf(endMonth).AddDate(0, 1-months, 0)
+
+	return ComparisonPeriod{Start: start, Months: months}, ComparisonPeriod{Start: start.AddDate(0, -shift, 0), Months: months}
+}
+
+// End is the last instant of the window.
+func (p ComparisonPeriod) End() time.Time {
+	return p.Start.AddDate(0, p.Months, 0).Add(-time.Microsecond)
+}
+
+// EndMonth is the first day of the last month of the window.
+func (p ComparisonPeriod) EndMonth() time.Time {
+	return p.Start.AddDate(0, p.Months-1, 0)
+}
+
+// AmountComparison puts what was spent in a window next to what was spent in the one it is
+// compared to. DeltaPercent is nil when nothing was spent before.
+type AmountComparison struct {
+	Current      int
+	Previous     int
+	Delta        int
+	DeltaPercent *float64
+}
+
+// CategoryAmount is what was spent in a category in a window.
+type CategoryAmount struct {
+	ID     category.ID
+	Name   string
+	Amount int
+}
+
+// CategoryGroupAmount is what was spent in a category group in a window, per category.
+type CategoryGroupAmount struct {
+	ID         category.GroupID
+	Name       string
+	Amount     int
+	Categories []CategoryAmount
+}
+
+type CategoryComparison struct {
+	ID   category.ID
+	Name string
+	AmountComparison
+}
+
+type CategoryGroupComparison struct {
+	ID   category.GroupID
+	Name string
+	AmountComparison
+	Categories []CategoryComparison
+}
+
+// TopMover is a category that changed the most between the windows, either way.
+type TopMover struct {
+	CategoryID    category.ID
+	Category      string
+	CategoryGroup string
+	AmountComparison
+}
+
+type SpendingComparison struct {
+	Total          AmountComparison
+	CategoryGroups []CategoryGroupComparison
+	TopMovers      []TopMover
+}
+
+// CompareSpending matches the category groups and categories of both windows, keeping the ones
+// that only had expenses in one of them. Groups and categories are sorted by what they spent in
+// the current window, the most first, and top movers by how much they changed.
+func CompareSpending(current, previous []CategoryGroupAmount) SpendingComparison {
+	comparison := SpendingComparison{
+		CategoryGroups: []CategoryGroupComparison{},
+		TopMovers:      []TopMover{},
+	}
+
+	for _, period := range []struct {
+		amounts   []CategoryGroupAmount
+		isCurrent bool
+	}{{current, true}, {previous, false}} {
+		for _, groupAmount := range period.amounts {
+			i := slices.IndexFunc(comparison.CategoryGroups, func(g CategoryGroupComparison) bool { return g.ID == groupAmount.ID })
+			if i < 0 {
+				comparison.CategoryGroups = append(comparison.CategoryGroups, CategoryGroupComparison{
+					ID:         groupAmount.ID,
+					Name:       groupAmount.Name,
+					Categories: []CategoryComparison{},
+				})
+				i = len(comparison.CategoryGroups) - 1
+			}
+
+			group := &comparison.CategoryGroups[i]
+			group.add(groupAmount.Amount, period.isCurrent)
+			comparison.Total.add(groupAmount.Amount, period.isCurrent)
+
+			for _, categoryAmount := range groupAmount.Categories {
+				j := slices.IndexFunc(group.Categories, func(c CategoryComparison) bool { return c.ID == categoryAmount.ID })
+				if j < 0 {
+					group.Categories = append(group.Categories, CategoryComparison{ID: categoryAmount.ID, Name: categoryAmount.Name})
+					j = len(group.Categories) - 1
+				}
+				group.Categories[j].add(categoryAmount.Amount, period.isCurrent)
+			}
+		}
+	}
+
+	comparison.Total.compare()
+	for i := range comparison.CategoryGroups {
+		group := &comparison.CategoryGroups[i]
+		group.compare()
+		for j := range group.Categories {
+			cat := &group.Categories[j]
+			cat.compare()
+			if cat.Delta != 0 {
+				comparison.TopMovers = append(comparison.TopMovers, TopMover{
+					CategoryID:       cat.ID,
+					Category:         cat.Name,
+					CategoryGroup:    group.Name,
+					AmountComparison: cat.AmountComparison,
+				})
+			}
+		}
+
+		slices.SortFunc(group.Categories, func(a, b CategoryComparison) int {
+			return cmp.Or(b.Current-a.Current, a.ID.Value-b.ID.Value)
+		})
+	}
+
+	slices.SortFunc(comparison.CategoryGroups, func(a, b CategoryGroupComparison) int {
+		return cmp.Or(b.Current-a.Current, a.ID.Value-b.ID.Value)
+	})
+
+	slices.SortFunc(comparison.TopMovers, func(a, b TopMover) int {
+		return cmp.Or(abs(b.Delta)-abs(a.Delta), a.CategoryID.Value-b.CategoryID.Value)
+	})
+	if len(comparison.TopMovers) > ComparisonTopMovers {
+		comparison.TopMovers = comparison.TopMovers[:ComparisonTopMovers]
+	}
+
+	return comparison
+}
+
+func (c *AmountComparison) add(amount int, isCurrent bool) {
+	if isCurrent {
+		c.Current += amount
+	} else {
+		c.Previous += amount
+	}
+}
+
+func (c *AmountComparison) compare() {
+	c.Delta = c.Current - c.Previous
+	if c.Previous != 0 {
+		percent := math.Round(float64(c.Delta)*10000/float64(c.Previous)) / 100
+		c.DeltaPercent = &percent
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,74 @@
+package expense
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+)
+
+func TestComparisonPeriods(t *testing.T) {
+	current, previous := ComparisonPeriods(time.Date(2026, 5, 20, 0, 0, 0, 0, time.UTC), 3, false)
+	assert.Equal(t, ComparisonPeriod{Start: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Months: 3}, current)
+	assert.Equal(t, time.Date(2026, 5, 31, 23, 59, 59, 999999000, time.UTC), current.End())
+	assert.Equal(t, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), current.EndMonth())
+	assert.Equal(t, ComparisonPeriod{Start: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), Months: 3}, previous)
+
+	_, previous = ComparisonPeriods(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), 3, true)
+	assert.Equal(t, ComparisonPeriod{Start: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Months: 3}, previous)
+}
+
+func TestCompareSpending(t *testing.T) {
+	percent := func(p float64) *float64 { return &p }
+	groupAmount := func(id int, name string, categories ...CategoryAmount) CategoryGroupAmount {
+		amount := CategoryGroupAmount{ID: category.GroupID{Value: id}, Name: name, Categories: categories}
+		for _, cat := range categories {
+			amount.Amount += cat.Amount
+		}
+		return amount
+	}
+	categoryAmount := func(id int, name string, amount int) CategoryAmount {
+		return CategoryAmount{ID: category.ID{Value: id}, Name: name, Amount: amount}
+	}
+
+	current := []CategoryGroupAmount{
+		groupAmount(3, "Lazer", categoryAmount(30, "Cinema", 4000)),
+		groupAmount(1, "Alimentação", categoryAmount(10, "Restaurante", 9000), categoryAmount(11, "Supermercado", 45000)),
+		groupAmount(2, "Transporte", categoryAmount(21, "Ônibus", 2000), categoryAmount(20, "Uber", 2000)),
+	}
+	previous := []CategoryGroupAmount{
+		groupAmount(1, "Alimentação", categoryAmount(10, "Restaurante", 10000), categoryAmount(11, "Supermercado", 30000)),
+		groupAmount(3, "Lazer", categoryAmount(30, "Cinema", 8000)),
+		groupAmount(4, "Casa", categoryAmount(40, "Limpeza", 4000)),
+	}
+
+	comparison := CompareSpending(current, previous)
+	assert.Equal(t, AmountComparison{Current: 62000, Previous: 52000, Delta: 10000, DeltaPercent: percent(19.23)}, comparison.Total)
+
+	// Sorted by the current amount, the ID breaking ties
+	var groups []string
+	for _, group := range comparison.CategoryGroups {
+		groups = append(groups, group.Name)
+	}
+	assert.Equal(t, []string{"Alimentação", "Transporte", "Lazer", "Casa"}, groups)
+	assert.Equal(t, []CategoryComparison{
+		{ID: category.ID{Value: 20}, Name: "Uber", AmountComparison: AmountComparison{Current: 2000, Delta: 2000}},
+		{ID: category.ID{Value: 21}, Name: "Ônibus", AmountComparison: AmountComparison{Current: 2000, Delta: 2000}},
+	}, comparison.CategoryGroups[1].Categories)
+	assert.Equal(t, AmountComparison{Previous: 4000, Delta: -4000, DeltaPercent: percent(-100)}, comparison.CategoryGroups[3].AmountComparison)
+
+	// Sorted by how much they changed either way, the ID breaking ties
+	var movers []category.ID
+	for _, mover := range comparison.TopMovers {
+		movers = append(movers, mover.CategoryID)
+	}
+	assert.Equal(t, []category.ID{{Value: 11}, {Value: 30}, {Value: 40}, {Value: 20}, {Value: 21}}, movers)
+	assert.Equal(t, "Alimentação", comparison.TopMovers[0].CategoryGroup)
+}
+
+func TestCompareSpending_Empty(t *testing.T) {
+	comparison := CompareSpending(nil, nil)
+	assert.Equal(t, SpendingComparison{CategoryGroups: []CategoryGroupComparison{}, TopMovers: []TopMover{}}, comparison)
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	vo "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	ConfirmImportCandidate func(ctx *fiber.Ctx) error
+
+	// ConfirmImportCandidateRequest takes the fields of an expense a statement does not have.
+	// Every one of them is optional, so the body may be omitted altogether.
+	ConfirmImportCandidateRequest struct {
+		Description  string         `json:"description"`
+		CategoryID   *int           `json:"category_id" validate:"omitempty,gt=0"`
+		SplitType    string         `json:"split_type" validate:"omitempty,oneof=equal proportional transfer exact weighted"`
+		PayerID      *int           `json:"payer_id" validate:"omitempty,gt=0"`
+		ReceiverID   int            `json:"receiver_id"`
+		Participants []int          `json:"participants" validate:"omitempty,min=2,dive,required"`
+		Shares       []ShareRequest `json:"shares" validate:"omitempty,dive"`
+	}
+)
+
+func NewConfirmImportCandidate(confirmImportCandidate usecase.ConfirmImportCandidate) ConfirmImportCandidate {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		candidateID, groupID, err := importCandidateParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		var req ConfirmImportCandidateRequest
+		if len(ctx.Body()) > 0 {
+			if err := ctx.BodyParser(&req); err != nil {
+				return except.UnprocessableEntityError().SetInternal(err)
+			}
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		input := usecase.ConfirmImportCandidateParams{
+			ID:           candidateID,
+			GroupID:      groupID,
+			Description:  req.Description,
+			SplitType:    vo.SplitType(req.SplitType),
+			ReceiverID:   user.ID{Value: req.ReceiverID},
+			Participants: toUserIDs(req.Participants),
+			Shares:       toShares(req.Shares),
+			UserID:       currentUserID(ctx),
+		}
+		if req.CategoryID != nil {
+			input.CategoryID = &category.ID{Value: *req.CategoryID}
+		}
+		if req.PayerID != nil {
+			input.PayerID = &user.ID{Value: *req.PayerID}
+		}
+
+		result, err := confirmImportCandidate(ctx.Context(), input)
+		if err != nil {
+			return fmt.Errorf("ConfirmImportCandidate: %w", err)
+		}
+
+		return ctx.Status(http.StatusCreated).JSON(
+			api.NewResponse(http.StatusCreated, toCreateExpenseResponse(result)),
+		)
+	}
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	CreateBudget func(ctx *fiber.Ctx) error
+
+	CreateBudgetRequest struct {
+		CategoryID      *int `json:"category_id" validate:"required_without=CategoryGroupID,excluded_with=CategoryGroupID"`
+		CategoryGroupID *int `json:"category_group_id" validate:"required_without=CategoryID"`
+		Amount          int  `json:"amount" validate:"required,gt=0"`
+		CarryOver       bool `json:"carry_over"`
+	}
+
+	BudgetResponse struct {
+		ID              int       `json:"id"`
+		CategoryID      *int      `json:"category_id"`
+		CategoryGroupID *int      `json:"category_group_id"`
+		Amount          float32   `json:"amount"`
+		CarryOver       bool      `json:"carry_over"`
+		CreatedAt       time.Time `json:"created_at"`
+	}
+)
+
+func NewCreateBudget(createBudget usecase.CreateBudget) CreateBudget {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		var req CreateBudgetRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		input := usecase.CreateBudgetParams{
+			GroupID:   group.ID{Value: groupID},
+			Amount:    req.Amount,
+			CarryOver: req.CarryOver,
+		}
+		if req.CategoryID != nil {
+			input.CategoryID = &category.ID{Value: *req.CategoryID}
+		}
+		if req.CategoryGroupID != nil {
+			input.CategoryGroupID = &category.GroupID{Value: *req.CategoryGroupID}
+		}
+
+		budget, err := createBudget(ctx.Context(), input)
+		if err != nil {
+			return fmt.Errorf("CreateBudget: %w", err)
+		}
+
+		return ctx.Status(http.StatusCreated).JSON(
+			api.NewResponse(http.StatusCreated, toBudgetResponse(budget)),
+		)
+	}
+}
+
+func toBudgetResponse(budget *expense.Budget) BudgetResponse {
+	response := BudgetResponse{
+		ID:        budget.ID.Value,
+		Amount:    float32(budget.Amount) / 100,
+		CarryOver: budget.CarryOver,
+		CreatedAt: budget.CreatedAt,
+	}
+
+	if budget.CategoryID != nil {
+		response.CategoryID = &budget.CategoryID.Value
+	}
+	if budget.CategoryGroupID != nil {
+		response.CategoryGroupID = &budget.CategoryGroupID.Value
+	}
+
+	return response
+}
@@ -11,6 +11,7 @@ import (
 	vo "github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
@@ -21,22 +22,47 @@ type (
 	CreateExpense func(ctx *fiber.Ctx) error
 
 	CreateExpenseRequest struct {
-		Name        string     `json:"name" validate:"required"`
-		Amount      int        `json:"amount" validate:"required"`
-		Description string     `json:"description"`
-		CategoryID  int        `json:"category_id" validate:"required"`
-		SplitType   string     `json:"split_type" validate:"required,oneof=equal proportional transfer"`
-		PayerID     int        `json:"payer_id" validate:"required"`
-		ReceiverID  int        `json:"receiver_id" validate:"required"`
-		CreatedAt   *time.Time `json:"created_at"`
+		Name         string         `json:"name" validate:"required"`
+		Amount       int            `json:"amount" validate:"required"`
+		Description  string         `json:"description"`
+		CategoryID   int            `json:"category_id" validate:"required"`
+		SplitType    string         `json:"split_type" validate:"required,oneof=equal proportional transfer exact weighted"`
+		PayerID      int            `json:"payer_id" validate:"required"`
+		ReceiverID   int            `json:"receiver_id" validate:"required_without_all=Participants Shares"`
+		Participants []int          `json:"participants" validate:"omitempty,min=2,dive,required"`
+		Shares       []ShareRequest `json:"shares" validate:"omitempty,dive"`
+		Installments int            `json:"installments" validate:"omitempty,min=1,max=72"`
+		TagIDs       []int          `json:"tag_ids" validate:"omitempty,unique,dive,gt=0"`
+		CreatedAt    *time.Time     `json:"created_at"`
+	}
+
+	// ShareRequest is the part of a custom split owed by a participant: the exact amount in
+	// cents for exact splits or the weight for weighted ones.
+	ShareRequest struct {
+		UserID int `json:"user_id" validate:"required"`
+		Amount int `json:"amount" validate:"gte=0"`
+		Weight int `json:"weight" validate:"gte=0"`
 	}
 
 	CreateExpenseResponse struct {
-		ID         int     `json:"id"`
-		Name       string  `json:"name"`
-		Amount     float32 `json:"amount"`
-		PayerID    int     `json:"payer_id"`
-		ReceiverID int     `json:"receiver_id"`
+		ID          int     `json:"id"`
+		Name        string  `json:"name"`
+		Amount      float32 `json:"amount"`
+		PayerID     int     `json:"payer_id"`
+		ReceiverID  int     `json:"receiver_id"`
+		PurchaseID  string  `json:"purchase_id,omitempty"`
+		Installment string  `json:"installment,omitempty"`
+		TagIDs      []int   `json:"tag_ids"`
+		// PossibleDuplicates warns about expenses of the group that look like the one created.
+		PossibleDuplicates []PossibleDuplicateResponse `json:"possible_duplicates,omitempty"`
+	}
+
+	PossibleDuplicateResponse struct {
+		ID        int       `json:"id"`
+		Name      string    `json:"name"`
+		Amount    float32   `json:"amount"`
+		PayerID   int       `json:"payer_id"`
+		CreatedAt time.Time `json:"created_at"`
 	}
 )
 
@@ -57,29 +83,120 @@ func NewCreateExpense(createExpense usecase.CreateExpense) CreateExpense {
 			return except.UnprocessableEntityError("group_id not found in context")
 		}
 
-		expense, err := createExpense(ctx.Context(), usecase.CreateExpenseParams{
-			GroupID:     group.ID{Value: groupID},
-			Name:        req.Name,
-			Amount:      req.Amount,
-			Description: req.Description,
-			CategoryID:  category.ID{Value: req.CategoryID},
-			SplitType:   vo.SplitType(req.SplitType),
-			PayerID:     user.ID{Value: req.PayerID},
-			ReceiverID:  user.ID{Value: req.ReceiverID},
-			CreatedAt:   req.CreatedAt,
+		result, err := createExpense(ctx.Context(), usecase.CreateExpenseParams{
+			GroupID:      group.ID{Value: groupID},
+			Name:         req.Name,
+			Amount:       req.Amount,
+			Description:  req.Description,
+			CategoryID:   category.ID{Value: req.CategoryID},
+			SplitType:    vo.SplitType(req.SplitType),
+			PayerID:      user.ID{Value: req.PayerID},
+			ReceiverID:   user.ID{Value: req.ReceiverID},
+			Participants: toUserIDs(req.Participants),
+			Shares:       toShares(req.Shares),
+			Installments: req.Installments,
+			TagIDs:       toTagIDParams(req.TagIDs),
+			CreatedAt:    req.CreatedAt,
+			UserID:       currentUserID(ctx),
 		})
 		if err != nil {
 			return fmt.Errorf("CreateExpense: %w", err)
 		}
 
 		return ctx.Status(http.StatusCreated).JSON(
-			api.NewResponse(http.StatusCreated, CreateExpenseResponse{
-				ID:         expense.ID.Value,
-				Name:       expense.Name,
-				Amount:     float32(expense.Amount) / 100,
-				PayerID:    expense.PayerID.Value,
-				ReceiverID: expense.ReceiverID.Value,
-			}),
+			api.NewResponse(http.StatusCreated, toCreateExpenseResponse(result)),
 		)
 	}
 }
+
+func toCreateExpenseResponse(result *usecase.CreateExpenseResult) CreateExpenseResponse {
+	expense := result.Expense
+	response := CreateExpenseResponse{
+		ID:         expense.ID.Value,
+		Name:       expense.Name,
+		Amount:     float32(expense.Amount) / 100,
+		PayerID:    expense.PayerID.Value,
+		ReceiverID: expense.ReceiverID.Value,
+		TagIDs:     toTagIDs(expense.TagIDs),
+	}
+
+	if expense.Installment != nil {
+		response.PurchaseID = expense.Installment.PurchaseID
+		response.Installment = expense.Installment.String()
+	}
+
+	for _, duplicate := range result.PossibleDuplicates {
+		response.PossibleDuplicates = append(response.PossibleDuplicates, PossibleDuplicateResponse{
+			ID:        duplicate.ID.Value,
+			Name:      duplicate.Name,
+			Amount:    float32(duplicate.Amount) / 100,
+			PayerID:   duplicate.PayerID.Value,
+			CreatedAt: duplicate.CreatedAt,
+		})
+	}
+
+	return response
+}
+
+// currentUserID returns the authenticated user, if any, to be recorded as the author of a change.
+func currentUserID(ctx *fiber.Ctx) *user.ID {
+	userID, ok := ctx.Locals("user_id").(int)
+	if !ok {
+		return nil
+	}
+
+	return &user.ID{Value: userID}
+}
+
+func toUserIDs(ids []int) []user.ID {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	userIDs := make([]user.ID, len(ids))
+	for i, id := range ids {
+		userIDs[i] = user.ID{Value: id}
+	}
+
+	return userIDs
+}
+
+func toTagIDParams(ids []int) []tag.ID {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tagIDs := make([]tag.ID, len(ids))
+	for i, id := range ids {
+		tagIDs[i] = tag.ID{Value: id}
+	}
+
+	return tagIDs
+}
+
+// toTagIDs presents the tags of an expense as plain ids, an empty list when it has none.
+func toTagIDs(tagIDs []tag.ID) []int {
+	ids := make([]int, len(tagIDs))
+	for i, id := range tagIDs {
+		ids[i] = id.Value
+	}
+
+	return ids
+}
+
+func toShares(shares []ShareRequest) []vo.Share {
+	if len(shares) == 0 {
+		return nil
+	}
+
+	result := make([]vo.Share, len(shares))
+	for i, share := range shares {
+		result[i] = vo.Share{
+			UserID: user.ID{Value: share.UserID},
+			Amount: share.Amount,
+			Weight: share.Weight,
+		}
+	}
+
+	return result
+}
@@ -3,10 +3,15 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 )
 
@@ -31,7 +36,14 @@ func NewCreateExpenseFromScheduled(
 					continue
 				}
 
+				// Events written before occurrences were assigned an ID get a new one
+				var id *expense.ID
+				if payload.Expense.ID != (expense.ID{}) {
+					id = &payload.Expense.ID
+				}
+
 				if _, err := createExpense(ctx, usecase.CreateExpenseParams{
+					ID:          id,
 					GroupID:     payload.GroupID,
 					Name:        payload.Expense.Name,
 					Amount:      payload.Expense.Amount,
@@ -42,6 +54,20 @@ func NewCreateExpenseFromScheduled(
 					ReceiverID:  payload.Expense.ReceiverID,
 					CreatedAt:   &payload.Expense.CreatedAt,
 				}); err != nil {
+					// A redelivered occurrence was already created
+					if errors.Is(err, ddd.ErrVersionConflict) {
+						msg.Ack()
+						continue
+					}
+
+					// An occurrence the usecase rejects would be rejected again on every redelivery
+					var httpErr *except.HTTPError
+					if errors.As(err, &httpErr) && httpErr.Code < http.StatusInternalServerError {
+						slog.ErrorContext(ctx, "dropping invalid expense from scheduled", "error", err)
+						msg.Ack()
+						continue
+					}
+
 					slog.ErrorContext(ctx, "failed to create expense from scheduled", "error", err)
 					msg.Nack()
 					continue
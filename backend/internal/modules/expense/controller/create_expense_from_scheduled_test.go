@@ -2,14 +2,20 @@ package controller_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
@@ -21,7 +27,9 @@ func TestCreateExpenseFromScheduledHandler(t *testing.T) {
 	testCases := []struct {
 		name          string
 		mockSetup     func(subscriber *mocks.MockpubsubSubscriber, createExpense *mocks.MockusecaseCreateExpense)
+		message       *message.Message
 		expectedError bool
+		expectedAck   bool
 	}{
 		{
 			name: "should return error if subscriber fails",
@@ -45,6 +53,48 @@ func TestCreateExpenseFromScheduledHandler(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name:    "should ack the message when the expense is created",
+			message: expenseMessage(t),
+			mockSetup: func(subscriber *mocks.MockpubsubSubscriber, createExpense *mocks.MockusecaseCreateExpense) {
+				createExpense.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			expectedAck: true,
+		},
+		{
+			name:    "should create the expense under the ID it was generated with",
+			message: expenseMessage(t),
+			mockSetup: func(subscriber *mocks.MockpubsubSubscriber, createExpense *mocks.MockusecaseCreateExpense) {
+				createExpense.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.CreateExpenseParams) bool {
+					return p.ID != nil && *p.ID == expense.ID{Value: 10}
+				})).Return(nil, nil).Once()
+			},
+			expectedAck: true,
+		},
+		{
+			name:    "should ack the message when the expense was already created",
+			message: expenseMessage(t),
+			mockSetup: func(subscriber *mocks.MockpubsubSubscriber, createExpense *mocks.MockusecaseCreateExpense) {
+				createExpense.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, &ddd.VersionConflictError{CurrentVersion: 0}).Once()
+			},
+			expectedAck: true,
+		},
+		{
+			name:    "should ack the message when the expense is invalid",
+			message: expenseMessage(t),
+			mockSetup: func(subscriber *mocks.MockpubsubSubscriber, createExpense *mocks.MockusecaseCreateExpense) {
+				createExpense.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.UnprocessableEntityError()).Once()
+			},
+			expectedAck: true,
+		},
+		{
+			name:    "should nack the message when creating the expense fails",
+			message: expenseMessage(t),
+			mockSetup: func(subscriber *mocks.MockpubsubSubscriber, createExpense *mocks.MockusecaseCreateExpense) {
+				createExpense.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, errors.New("database error")).Once()
+			},
+			expectedAck: false,
+		},
 	}
 
 	// Execução dos casos de teste
@@ -55,6 +105,12 @@ func TestCreateExpenseFromScheduledHandler(t *testing.T) {
 			mockCreateExpense := mocks.NewMockusecaseCreateExpense(t)
 
 			tc.mockSetup(mockSubscriber, mockCreateExpense)
+			if tc.message != nil {
+				msgChan := make(chan *message.Message, 1)
+				msgChan <- tc.message
+				close(msgChan)
+				mockSubscriber.EXPECT().Subscribe(mock.Anything, pubsub.ExpensesTopic).Return(msgChan, nil).Once()
+			}
 
 			// Create the handler
 			handler := controller.NewCreateExpenseFromScheduled(mockSubscriber, mockCreateExpense.Execute)
@@ -69,6 +125,27 @@ func TestCreateExpenseFromScheduledHandler(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
+
+			if tc.message != nil {
+				select {
+				case <-tc.message.Acked():
+					assert.True(t, tc.expectedAck)
+				case <-tc.message.Nacked():
+					assert.False(t, tc.expectedAck)
+				case <-time.After(time.Second):
+					t.Fatal("message was neither acked nor nacked")
+				}
+			}
 		})
 	}
 }
+
+func expenseMessage(t *testing.T) *message.Message {
+	event := pubsub.ExpenseEvent{}
+	event.Expense.ID = expense.ID{Value: 10}
+
+	payload, err := json.Marshal(event)
+	assert.NoError(t, err)
+
+	return message.NewMessage("1", payload)
+}
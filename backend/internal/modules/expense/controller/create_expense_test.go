@@ -15,6 +15,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
@@ -52,11 +53,22 @@ func TestCreateExpenseHandler(t *testing.T) {
 		Description: "My first expense",
 		GroupID:     group.ID{Value: 1},
 		CategoryID:  category.ID{Value: 1},
-		SplitRatio:  expense.SplitRatio{Payer: 50, Receiver: 50},
+		SplitRatio:  expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
 		PayerID:     user.ID{Value: 1},
 		ReceiverID:  user.ID{Value: 2},
 	})
 
+	duplicate, _ := expense.New(expense.Attributes{
+		ID:         expense.ID{Value: 2},
+		Name:       "test expense",
+		Amount:     100,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 1},
+		SplitRatio: expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
+		PayerID:    user.ID{Value: 2},
+		ReceiverID: user.ID{Value: 1},
+	})
+
 	// Definição dos casos de teste
 	testCases := []struct {
 		name             string
@@ -69,14 +81,15 @@ func TestCreateExpenseHandler(t *testing.T) {
 		{
 			name: "should return 201 and create a new expense",
 			body: validBodyReq,
-			mockSetup: func(usecase *mocks.MockusecaseCreateExpense) {
-				usecase.EXPECT().Execute(mock.Anything, mock.Anything).Return(newExpense, nil).Once()
+			mockSetup: func(uc *mocks.MockusecaseCreateExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(&usecase.CreateExpenseResult{Expense: newExpense}, nil).Once()
 			},
 			expectedStatus: 201,
 			customAssertions: func(t *testing.T, body []byte) {
 				var response api.Response[controller.CreateExpenseResponse]
 				assert.Nil(t, json.Unmarshal(body, &response))
 				assert.Equal(t, 201, response.StatusCode)
+				assert.Empty(t, response.Data.PossibleDuplicates)
 				assert.Equal(t, 1, response.Data.ID)
 				assert.Equal(t, "Test Expense", response.Data.Name)
 				assert.Equal(t, float32(1), response.Data.Amount)
@@ -85,6 +98,26 @@ func TestCreateExpenseHandler(t *testing.T) {
 				assert.NotEmpty(t, response.Date) // Verifica se a data existe
 			},
 		},
+		{
+			name: "should return 201 warning about possible duplicates",
+			body: validBodyReq,
+			mockSetup: func(uc *mocks.MockusecaseCreateExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(&usecase.CreateExpenseResult{
+					Expense:            newExpense,
+					PossibleDuplicates: []expense.Expense{*duplicate},
+				}, nil).Once()
+			},
+			expectedStatus: 201,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.CreateExpenseResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 1, response.Data.ID)
+				assert.Len(t, response.Data.PossibleDuplicates, 1)
+				assert.Equal(t, 2, response.Data.PossibleDuplicates[0].ID)
+				assert.Equal(t, "test expense", response.Data.PossibleDuplicates[0].Name)
+				assert.Equal(t, float32(1), response.Data.PossibleDuplicates[0].Amount)
+			},
+		},
 		{
 			name:             "should return 400 if request body is invalid",
 			body:             invalidBodyReq,
@@ -17,16 +17,29 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
 )
 
+type RecurrenceRequest struct {
+	Frequency   string     `json:"frequency" validate:"required,oneof=daily weekly monthly yearly"`
+	Interval    int        `json:"interval" validate:"omitempty,min=1"`
+	DayOfMonth  int        `json:"day_of_month" validate:"min=-1,max=31"`
+	Weekday     *int       `json:"weekday" validate:"omitempty,min=0,max=6"`
+	Month       int        `json:"month" validate:"min=0,max=12"`
+	BusinessDay string     `json:"business_day" validate:"omitempty,oneof=previous next"`
+	StartDate   *time.Time `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+	MaxCount    int        `json:"max_count" validate:"min=0"`
+}
+
 type CreateScheduledExpenseRequest struct {
-	Name            string     `json:"name" validate:"required"`
-	Amount          int        `json:"amount" validate:"required"`
-	Description     string     `json:"description" validate:"required"`
-	CategoryID      int        `json:"category_id" validate:"required"`
-	SplitType       string     `json:"split_type" validate:"required"`
-	PayerID         int        `json:"payer_id" validate:"required"`
-	ReceiverID      int        `json:"receiver_id" validate:"required"`
-	FrequencyInDays int        `json:"frequency_in_days" validate:"required"`
-	LastGeneratedAt *time.Time `json:"last_generated_at"`
+	Name            string             `json:"name" validate:"required"`
+	Amount          int                `json:"amount" validate:"required"`
+	Description     string             `json:"description" validate:"required"`
+	CategoryID      int                `json:"category_id" validate:"required"`
+	SplitType       string             `json:"split_type" validate:"required,oneof=equal proportional transfer"`
+	PayerID         int                `json:"payer_id" validate:"required"`
+	ReceiverID      int                `json:"receiver_id" validate:"required"`
+	FrequencyInDays int                `json:"frequency_in_days" validate:"required_without=Recurrence"`
+	Recurrence      *RecurrenceRequest `json:"recurrence"`
+	LastGeneratedAt *time.Time         `json:"last_generated_at"`
 }
 
 type CreateScheduledExpense func(ctx *fiber.Ctx) error
@@ -64,6 +77,7 @@ func NewCreateScheduledExpense(createScheduledExpense usecase.CreateScheduledExp
 			PayerID:         user.ID{Value: req.PayerID},
 			ReceiverID:      user.ID{Value: req.ReceiverID},
 			FrequencyInDays: req.FrequencyInDays,
+			Recurrence:      toRecurrence(req.Recurrence),
 			LastGeneratedAt: lastGeneratedAt,
 		})
 		if err != nil {
@@ -73,3 +87,35 @@ func NewCreateScheduledExpense(createScheduledExpense usecase.CreateScheduledExp
 		return c.SendStatus(http.StatusCreated)
 	}
 }
+
+func toRecurrence(req *RecurrenceRequest) *vo.Recurrence {
+	if req == nil {
+		return nil
+	}
+
+	recurrence := vo.Recurrence{
+		Frequency:   vo.Frequency(req.Frequency),
+		Interval:    max(req.Interval, 1),
+		DayOfMonth:  req.DayOfMonth,
+		Month:       time.Month(req.Month),
+		BusinessDay: vo.BusinessDayRule(req.BusinessDay),
+		MaxCount:    req.MaxCount,
+	}
+
+	if req.Weekday != nil {
+		weekday := time.Weekday(*req.Weekday)
+		recurrence.Weekday = &weekday
+	}
+
+	if req.StartDate != nil {
+		date := civil.DateOf(*req.StartDate)
+		recurrence.StartDate = &date
+	}
+
+	if req.EndDate != nil {
+		date := civil.DateOf(*req.EndDate)
+		recurrence.EndDate = &date
+	}
+
+	return &recurrence
+}
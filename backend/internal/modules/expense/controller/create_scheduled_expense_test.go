@@ -14,7 +14,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
@@ -50,6 +52,22 @@ func TestCreateScheduledExpenseHandler(t *testing.T) {
 		}(),
 	}
 
+	validBodyReqWithRecurrence := controller.CreateScheduledExpenseRequest{
+		Name:        "Rent",
+		Amount:      200,
+		Description: "Rent on the last business day of the month",
+		CategoryID:  1,
+		SplitType:   "equal",
+		PayerID:     1,
+		ReceiverID:  2,
+		Recurrence: &controller.RecurrenceRequest{
+			Frequency:   "monthly",
+			DayOfMonth:  -1,
+			BusinessDay: "previous",
+			MaxCount:    12,
+		},
+	}
+
 	invalidBodyReq := map[string]any{
 		"name":        "Test Scheduled Expense",
 		"amount":      200,
@@ -86,12 +104,39 @@ func TestCreateScheduledExpenseHandler(t *testing.T) {
 			expectedStatus:   201,
 			expectedResponse: "Created",
 		},
+		{
+			name: "should return 201 and create a new scheduled expense with a recurrence",
+			body: validBodyReqWithRecurrence,
+			mockSetup: func(uc *mocks.MockusecaseCreateScheduledExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(input usecase.CreateScheduledExpenseInput) bool {
+					return input.Recurrence != nil &&
+						input.Recurrence.Frequency == expense.Frequencies.Monthly &&
+						input.Recurrence.Interval == 1 &&
+						input.Recurrence.DayOfMonth == expense.LastDayOfMonth &&
+						input.Recurrence.BusinessDay == expense.BusinessDayRules.Previous &&
+						input.Recurrence.MaxCount == 12
+				})).Return(nil).Once()
+			},
+			expectedStatus:   201,
+			expectedResponse: "Created",
+		},
 		{
 			name:             "should return 400 if request body is invalid",
 			body:             invalidBodyReq,
 			mockSetup:        func(usecase *mocks.MockusecaseCreateScheduledExpense) {}, // Não precisa de mock para este caso
 			expectedStatus:   400,
-			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [SplitType]: '' | Needs to implement 'required' and [FrequencyInDays]: '0' | Needs to implement 'required'"}`,
+			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [SplitType]: '' | Needs to implement 'required' and [FrequencyInDays]: '0' | Needs to implement 'required_without'"}`,
+		},
+		{
+			name: "should return 400 if split type is unknown",
+			body: func() controller.CreateScheduledExpenseRequest {
+				req := validBodyReq
+				req.SplitType = "custom"
+				return req
+			}(),
+			mockSetup:        func(usecase *mocks.MockusecaseCreateScheduledExpense) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [SplitType]: 'custom' | Needs to implement 'oneof'"}`,
 		},
 		{
 			name:             "should return 422 if request body cannot be parsed",
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	DeleteAttachment func(ctx *fiber.Ctx) error
+
+	DeleteAttachmentResponse struct {
+		ID int `json:"id"`
+	}
+)
+
+func NewDeleteAttachment(deleteAttachment usecase.DeleteAttachment) DeleteAttachment {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		expenseID, err := strconv.Atoi(ctx.Params("expense_id"))
+		if err != nil {
+			return except.BadRequestError("invalid expense id")
+		}
+
+		attachmentID, err := strconv.Atoi(ctx.Params("attachment_id"))
+		if err != nil {
+			return except.BadRequestError("invalid attachment id")
+		}
+
+		if err := deleteAttachment(ctx.Context(), usecase.DeleteAttachmentParams{
+			GroupID:      group.ID{Value: groupID},
+			ExpenseID:    expense.ID{Value: expenseID},
+			AttachmentID: expense.AttachmentID{Value: attachmentID},
+		}); err != nil {
+			return fmt.Errorf("DeleteAttachment: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, DeleteAttachmentResponse{ID: attachmentID}),
+		)
+	}
+}
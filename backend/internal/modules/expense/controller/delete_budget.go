@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+type (
+	DeleteBudget func(ctx *fiber.Ctx) error
+
+	DeleteBudgetResponse struct {
+		ID int `json:"id"`
+	}
+)
+
+func NewDeleteBudget(deleteBudget usecase.DeleteBudget) DeleteBudget {
+	return func(ctx *fiber.Ctx) error {
+		params, err := budgetParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteBudget(ctx.Context(), params); err != nil {
+			return fmt.Errorf("DeleteBudget: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, DeleteBudgetResponse{ID: params.ID.Value}),
+		)
+	}
+}
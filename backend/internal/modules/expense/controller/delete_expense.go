@@ -42,7 +42,7 @@ func NewDeleteExpense(deleteExpense usecase.DeleteExpense) DeleteExpense {
 			return except.BadRequestError("invalid expense id")
 		}
 
-		expns, err := deleteExpense(ctx.Context(), expense.ID{Value: expenseID})
+		expns, err := deleteExpense(ctx.Context(), expense.ID{Value: expenseID}, currentUserID(ctx))
 		if err != nil {
 			return fmt.Errorf("DeleteExpense: %w", err)
 		}
@@ -31,7 +31,7 @@ func TestDeleteExpenseHandler(t *testing.T) {
 		Description: "My first expense",
 		GroupID:     group.ID{Value: 1},
 		CategoryID:  category.ID{Value: 1},
-		SplitRatio:  expense.SplitRatio{Payer: 50, Receiver: 50},
+		SplitRatio:  expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
 		PayerID:     user.ID{Value: 1},
 		ReceiverID:  user.ID{Value: 2},
 	})
@@ -49,7 +49,7 @@ func TestDeleteExpenseHandler(t *testing.T) {
 			name:      "should return 200 and delete the expense",
 			expenseID: "1",
 			mockSetup: func(usecase *mocks.MockusecaseDeleteExpense) {
-				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 1}).Return(deletedExpense, nil).Once()
+				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 1}, mock.Anything).Return(deletedExpense, nil).Once()
 			},
 			expectedStatus: 200,
 			customAssertions: func(t *testing.T, body []byte) {
@@ -71,7 +71,7 @@ func TestDeleteExpenseHandler(t *testing.T) {
 			name:      "should return 404 if expense is not found",
 			expenseID: "999",
 			mockSetup: func(usecase *mocks.MockusecaseDeleteExpense) {
-				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 999}).Return(nil, except.NotFoundError("expense not found")).Once()
+				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 999}, mock.Anything).Return(nil, except.NotFoundError("expense not found")).Once()
 			},
 			expectedStatus:   404,
 			expectedResponse: `{"status_code":404,"message":"expense not found","error":"DeleteExpense: expense not found"}`,
@@ -80,7 +80,7 @@ func TestDeleteExpenseHandler(t *testing.T) {
 			name:      "should return 422 if request is not processable",
 			expenseID: "1",
 			mockSetup: func(usecase *mocks.MockusecaseDeleteExpense) {
-				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 1}).Return(nil, except.UnprocessableEntityError()).Once()
+				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 1}, mock.Anything).Return(nil, except.UnprocessableEntityError()).Once()
 			},
 			expectedStatus:   422,
 			expectedResponse: `{"status_code":422,"message":"Unprocessable Entity","error":"DeleteExpense: Unprocessable Entity"}`,
@@ -89,7 +89,7 @@ func TestDeleteExpenseHandler(t *testing.T) {
 			name:      "should return 500 if it gets an unexpected error",
 			expenseID: "1",
 			mockSetup: func(usecase *mocks.MockusecaseDeleteExpense) {
-				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 1}).Return(nil, errors.New("unexpected error")).Once()
+				usecase.EXPECT().Execute(mock.Anything, expense.ID{Value: 1}, mock.Anything).Return(nil, errors.New("unexpected error")).Once()
 			},
 			expectedStatus:   500,
 			expectedResponse: `{"status_code":500,"message":"Internal Server Error","error":"DeleteExpense: unexpected error"}`,
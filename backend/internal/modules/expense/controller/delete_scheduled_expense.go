@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+type DeleteScheduledExpense func(ctx *fiber.Ctx) error
+
+func NewDeleteScheduledExpense(deleteScheduledExpense usecase.DeleteScheduledExpense) DeleteScheduledExpense {
+	return func(ctx *fiber.Ctx) error {
+		params, err := scheduledExpenseParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		scheduledExpense, err := deleteScheduledExpense(ctx.Context(), params)
+		if err != nil {
+			return fmt.Errorf("DeleteScheduledExpense: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toScheduledExpenseResponse(scheduledExpense)),
+		)
+	}
+}
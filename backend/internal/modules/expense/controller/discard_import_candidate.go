@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+type DiscardImportCandidate func(ctx *fiber.Ctx) error
+
+func NewDiscardImportCandidate(discardImportCandidate usecase.DiscardImportCandidate) DiscardImportCandidate {
+	return func(ctx *fiber.Ctx) error {
+		candidateID, groupID, err := importCandidateParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		candidate, err := discardImportCandidate(ctx.Context(), usecase.DiscardImportCandidateParams{
+			ID:      candidateID,
+			GroupID: groupID,
+		})
+		if err != nil {
+			return fmt.Errorf("DiscardImportCandidate: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toImportCandidateResponse(candidate)),
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	DismissDuplicates func(ctx *fiber.Ctx) error
+
+	// DismissDuplicatesRequest lists expenses that are not duplicates of each other, usually a
+	// whole duplicate cluster.
+	DismissDuplicatesRequest struct {
+		ExpenseIDs []int `json:"expense_ids" validate:"required,min=2,unique,dive,gt=0"`
+	}
+
+	DismissDuplicatesResponse struct {
+		ExpenseIDs []int `json:"expense_ids"`
+	}
+)
+
+func NewDismissDuplicates(dismissDuplicates usecase.DismissDuplicates) DismissDuplicates {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var req DismissDuplicatesRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		if err := dismissDuplicates(ctx.Context(), usecase.DismissDuplicatesParams{
+			GroupID:    group.ID{Value: groupID},
+			ExpenseIDs: toExpenseIDs(req.ExpenseIDs),
+		}); err != nil {
+			return fmt.Errorf("DismissDuplicates: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, DismissDuplicatesResponse{ExpenseIDs: req.ExpenseIDs}),
+		)
+	}
+}
+
+func toExpenseIDs(ids []int) []expense.ID {
+	expenseIDs := make([]expense.ID, len(ids))
+	for i, id := range ids {
+		expenseIDs[i] = expense.ID{Value: id}
+	}
+
+	return expenseIDs
+}
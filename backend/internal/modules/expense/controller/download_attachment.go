@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"fmt"
+	"mime"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type DownloadAttachment func(ctx *fiber.Ctx) error
+
+// NewDownloadAttachment sends the file to be shown by the browser. Passing thumbnail=true sends the
+// preview of images instead.
+func NewDownloadAttachment(downloadAttachment usecase.DownloadAttachment) DownloadAttachment {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		expenseID, err := strconv.Atoi(ctx.Params("expense_id"))
+		if err != nil {
+			return except.BadRequestError("invalid expense id")
+		}
+
+		attachmentID, err := strconv.Atoi(ctx.Params("attachment_id"))
+		if err != nil {
+			return except.BadRequestError("invalid attachment id")
+		}
+
+		result, err := downloadAttachment(ctx.Context(), usecase.DownloadAttachmentParams{
+			GroupID:      group.ID{Value: groupID},
+			ExpenseID:    expense.ID{Value: expenseID},
+			AttachmentID: expense.AttachmentID{Value: attachmentID},
+			Thumbnail:    ctx.QueryBool("thumbnail"),
+		})
+		if err != nil {
+			return fmt.Errorf("DownloadAttachment: %w", err)
+		}
+
+		size := -1
+		if result.ContentType == result.Attachment.ContentType {
+			size = result.Attachment.Size
+		}
+
+		ctx.Set(fiber.HeaderContentType, result.ContentType)
+		ctx.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": result.Attachment.FileName}))
+
+		// The stream is closed once the response is written
+		return ctx.SendStream(result.Content, size)
+	}
+}
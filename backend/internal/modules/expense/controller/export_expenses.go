@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/spreadsheet"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	ExportExpenses func(ctx *fiber.Ctx) error
+
+	ExportRequest struct {
+		Format string `query:"format" validate:"omitempty,oneof=csv xlsx"`
+		Locale string `query:"locale" validate:"omitempty,oneof=pt-BR"`
+	}
+)
+
+var exportExpensesHeaders = map[spreadsheet.Locale][]string{
+	spreadsheet.Locales.Default: {"Date", "Name", "Description", "Category", "Category group", "Amount", "Refund", "Payer", "Receiver", "Split type", "Shares", "Installment"},
+	spreadsheet.Locales.PtBR:    {"Data", "Nome", "Descrição", "Categoria", "Grupo de categoria", "Valor", "Reembolso", "Pagador", "Recebedor", "Divisão", "Participações", "Parcela"},
+}
+
+// NewExportExpenses streams the expenses matching the same filters and sorting as the listing,
+// without pagination, as a CSV or XLSX file.
+func NewExportExpenses(exportExpenses postgres.ExportExpenses) ExportExpenses {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		format, locale, err := parseExportRequest(ctx, valid)
+		if err != nil {
+			return err
+		}
+
+		_, input, err := parseExpensesFilters(ctx, valid)
+		if err != nil {
+			return err
+		}
+		input.GroupID = groupID
+
+		return api.StreamAttachment(ctx, "expenses."+string(format), format.ContentType(), func(c context.Context, w io.Writer) error {
+			writer, err := spreadsheet.NewWriter(w, format, locale, "Expenses", exportExpensesHeaders[locale])
+			if err != nil {
+				return fmt.Errorf("spreadsheet.NewWriter: %w", err)
+			}
+
+			if err := exportExpenses(c, input, func(row postgres.ExpenseExportRow) error {
+				return writer.Write(expenseExportCells(row, locale))
+			}); err != nil {
+				return fmt.Errorf("query.ExportExpenses: %w", err)
+			}
+
+			return writer.Close()
+		})
+	}
+}
+
+func parseExportRequest(ctx *fiber.Ctx, valid *validator.Validator) (spreadsheet.Format, spreadsheet.Locale, error) {
+	var req ExportRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return "", "", except.BadRequestError("invalid query params").SetInternal(err)
+	}
+
+	if err := valid.Validate(req); err != nil {
+		return "", "", except.BadRequestError("invalid query params").SetInternal(err)
+	}
+
+	format := spreadsheet.Formats.CSV
+	if req.Format != "" {
+		format = spreadsheet.Format(req.Format)
+	}
+
+	return format, spreadsheet.Locale(req.Locale), nil
+}
+
+func expenseExportCells(row postgres.ExpenseExportRow, locale spreadsheet.Locale) []any {
+	var refund any
+	if row.RefundAmountCents != nil {
+		refund = spreadsheet.Money(*row.RefundAmountCents)
+	}
+
+	var installment any
+	if row.InstallmentNumber != nil && row.InstallmentTotal != nil {
+		installment = fmt.Sprintf("%d/%d", *row.InstallmentNumber, *row.InstallmentTotal)
+	}
+
+	shares := make([]string, 0, len(row.Shares))
+	for _, share := range row.Shares {
+		text := share.Name + ": " + spreadsheet.FormatMoney(spreadsheet.Money(share.AmountCents), locale)
+		if share.Percent > 0 {
+			text += fmt.Sprintf(" (%d%%)", share.Percent)
+		}
+		shares = append(shares, text)
+	}
+
+	return []any{
+		spreadsheet.Date(row.CreatedAt),
+		row.Name,
+		row.Description,
+		row.CategoryName,
+		row.CategoryGroupName,
+		spreadsheet.Money(row.AmountCents),
+		refund,
+		row.PayerName,
+		row.ReceiverName,
+		row.SplitType,
+		strings.Join(shares, " | "),
+		installment,
+	}
+}
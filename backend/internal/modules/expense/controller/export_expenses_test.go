@@ -0,0 +1,140 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+func TestExportExpensesHandler(t *testing.T) {
+	t.Parallel()
+
+	refund, number, total := 1500, 2, 10
+	rows := []postgres.ExpenseExportRow{
+		{
+			ID:                1,
+			CreatedAt:         time.Date(2025, 3, 7, 12, 0, 0, 0, time.UTC),
+			Name:              "Mercado",
+			CategoryName:      "Supermercado",
+			CategoryGroupName: "Alimentação",
+			AmountCents:       123456,
+			RefundAmountCents: &refund,
+			PayerName:         "Ana",
+			ReceiverName:      "Bia",
+			SplitType:         "equal",
+			Shares:            postgres.ExportShares{{Name: "Ana", Percent: 50, AmountCents: 61728}, {Name: "Bia", Percent: 50, AmountCents: 61728}},
+			InstallmentNumber: &number,
+			InstallmentTotal:  &total,
+		},
+	}
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name                string
+		query               string
+		exportError         error
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+		assertInput         func(t *testing.T, input postgres.GetExpensesInput)
+	}{
+		{
+			name:                "should stream a csv with the default formatting",
+			query:               "",
+			expectedStatus:      200,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody: "Date,Name,Description,Category,Category group,Amount,Refund,Payer,Receiver,Split type,Shares,Installment\n" +
+				"2025-03-07,Mercado,,Supermercado,Alimentação,1234.56,15.00,Ana,Bia,equal,Ana: 617.28 (50%) | Bia: 617.28 (50%),2/10\n",
+		},
+		{
+			name:                "should stream a pt-BR csv with the listing filters",
+			query:               "locale=pt-BR&payer_id=3&sort=amount&order=asc&end_date=2025-03-31",
+			expectedStatus:      200,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody: "Data;Nome;Descrição;Categoria;Grupo de categoria;Valor;Reembolso;Pagador;Recebedor;Divisão;Participações;Parcela\n" +
+				"07/03/2025;Mercado;;Supermercado;Alimentação;1.234,56;15,00;Ana;Bia;equal;Ana: 617,28 (50%) | Bia: 617,28 (50%);2/10\n",
+			assertInput: func(t *testing.T, input postgres.GetExpensesInput) {
+				assert.Equal(t, 1, input.GroupID)
+				assert.Equal(t, 3, input.PayerID)
+				assert.Equal(t, postgres.ExpensesSorts.Amount, input.Sort)
+				assert.True(t, input.Ascending)
+				assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), *input.EndDate)
+				assert.Zero(t, input.Limit)
+			},
+		},
+		{
+			name:                "should stream a xlsx",
+			query:               "format=xlsx",
+			expectedStatus:      200,
+			expectedContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		},
+		{
+			name:           "should return 400 if format is invalid",
+			query:          "format=pdf",
+			expectedStatus: 400,
+			expectedBody:   `{"status_code":400,"message":"invalid query params","error":"invalid query params: internal=validation errors: [Format]: 'pdf' | Needs to implement 'oneof'"}`,
+		},
+		{
+			name:                "should truncate the file if the query fails while streaming",
+			query:               "",
+			exportError:         errors.New("database error"),
+			expectedStatus:      200,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "Date,Name,Description,Category,Category group,Amount,Refund,Payer,Receiver,Split type,Shares,Installment\n",
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var input *postgres.GetExpensesInput
+			exportExpenses := func(ctx context.Context, in postgres.GetExpensesInput, each func(row postgres.ExpenseExportRow) error) error {
+				input = &in
+				if tc.exportError != nil {
+					return tc.exportError
+				}
+				for _, row := range rows {
+					if err := each(row); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/expenses/export", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewExportExpenses(exportExpenses))
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/expenses/export?"+tc.query, nil))
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedContentType != "" {
+				assert.Equal(t, tc.expectedContentType, resp.Header.Get("Content-Type"))
+				assert.Contains(t, resp.Header.Get("Content-Disposition"), "attachment")
+			}
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, string(body))
+			}
+			if tc.assertInput != nil {
+				tc.assertInput(t, *input)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
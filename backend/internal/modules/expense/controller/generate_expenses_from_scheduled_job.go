@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+)
+
+type GenerateExpensesFromScheduledJob func(ctx context.Context) error
+
+func NewGenerateExpensesFromScheduledJob(generateExpensesFromScheduled usecase.GenerateExpensesFromScheduledUseCase) GenerateExpensesFromScheduledJob {
+	return func(ctx context.Context) error {
+		expensesCreated, err := generateExpensesFromScheduled(ctx)
+		if err != nil {
+			return fmt.Errorf("GenerateExpensesFromScheduled: %w", err)
+		}
+
+		slog.InfoContext(ctx, "Expenses generated from scheduled", "count", expensesCreated)
+
+		return nil
+	}
+}
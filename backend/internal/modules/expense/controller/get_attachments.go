@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type GetAttachments func(ctx *fiber.Ctx) error
+
+func NewGetAttachments(getAttachments usecase.GetAttachments) GetAttachments {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		expenseID, err := strconv.Atoi(ctx.Params("expense_id"))
+		if err != nil {
+			return except.BadRequestError("invalid expense id")
+		}
+
+		attachments, err := getAttachments(ctx.Context(), usecase.GetAttachmentsParams{
+			GroupID:   group.ID{Value: groupID},
+			ExpenseID: expense.ID{Value: expenseID},
+		})
+		if err != nil {
+			return fmt.Errorf("GetAttachments: %w", err)
+		}
+
+		response := make([]AttachmentResponse, 0, len(attachments))
+		for _, attachment := range attachments {
+			response = append(response, toAttachmentResponse(&attachment))
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, response),
+		)
+	}
+}
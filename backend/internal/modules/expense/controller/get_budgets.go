@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetBudgets func(ctx *fiber.Ctx) error
+
+	GetBudgetsRequest struct {
+		// Month is formatted as YYYY-MM, the current month when not given.
+		Month string `query:"month"`
+	}
+
+	// BudgetStatusResponse compares a budget with what was spent in the month.
+	BudgetStatusResponse struct {
+		BudgetResponse
+		Limit       float32 `json:"limit"`
+		CarriedOver float32 `json:"carried_over"`
+		Spent       float32 `json:"spent"`
+		Remaining   float32 `json:"remaining"`
+		Percent     int     `json:"percent"`
+	}
+
+	GetBudgetsResponse struct {
+		Month   string                 `json:"month"`
+		Budgets []BudgetStatusResponse `json:"budgets"`
+	}
+)
+
+func NewGetBudgets(getBudgets usecase.GetBudgets, getExpensesPerCategory postgres.GetExpensesPerCategory) GetBudgets {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		var req GetBudgetsRequest
+		if err := ctx.QueryParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		month := expense.MonthOf(time.Now())
+		if req.Month != "" {
+			parsed, err := time.Parse("2006-01", req.Month)
+			if err != nil {
+				return except.BadRequestError("invalid month").SetInternal(err)
+			}
+			month = parsed
+		}
+
+		statuses, err := getBudgets(ctx.Context(), usecase.GetBudgetsParams{
+			GroupID: group.ID{Value: groupID},
+			Month:   month,
+		})
+		if err != nil {
+			return fmt.Errorf("GetBudgets: %w", err)
+		}
+
+		actuals, err := getExpensesPerCategory(ctx.Context(), postgres.GetExpensesPerCategoryInput{
+			GroupID:   groupID,
+			StartDate: month,
+			EndDate:   month.AddDate(0, 1, 0).Add(-time.Microsecond),
+		})
+		if err != nil {
+			return fmt.Errorf("query.getExpensesPerCategory: %w", err)
+		}
+
+		response := GetBudgetsResponse{
+			Month:   month.Format("2006-01"),
+			Budgets: make([]BudgetStatusResponse, 0, len(statuses)),
+		}
+		for _, status := range statuses {
+			response.Budgets = append(response.Budgets, toBudgetStatusResponse(status, actuals))
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, response))
+	}
+}
+
+func toBudgetStatusResponse(status usecase.BudgetStatus, actuals []postgres.ExpensesPerCategory) BudgetStatusResponse {
+	spent := budgetSpent(status.Budget, actuals)
+
+	// A limit eaten up by the months before is already overspent
+	percent := 100
+	if status.Limit > 0 {
+		percent = spent * 100 / status.Limit
+	}
+
+	return BudgetStatusResponse{
+		BudgetResponse: toBudgetResponse(&status.Budget),
+		Limit:          float32(status.Limit) / 100,
+		CarriedOver:    float32(status.CarriedOver) / 100,
+		Spent:          float32(spent) / 100,
+		Remaining:      float32(status.Limit-spent) / 100,
+		Percent:        percent,
+	}
+}
+
+// budgetSpent finds what was spent in the scope of the budget among the expenses per category.
+func budgetSpent(budget expense.Budget, actuals []postgres.ExpensesPerCategory) int {
+	for _, categoryGroup := range actuals {
+		if budget.CategoryGroupID != nil {
+			if categoryGroup.ID == budget.CategoryGroupID.Value {
+				return categoryGroup.Amount
+			}
+			continue
+		}
+
+		for _, cat := range categoryGroup.Categories {
+			if cat.ID == budget.CategoryID.Value {
+				return cat.Amount
+			}
+		}
+	}
+
+	return 0
+}
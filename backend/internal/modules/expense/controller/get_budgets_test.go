@@ -0,0 +1,179 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestGetBudgets(t *testing.T) {
+	t.Parallel()
+
+	groceriesID := category.ID{Value: 11}
+	transportID := category.GroupID{Value: 2}
+	statuses := []usecase.BudgetStatus{
+		{
+			Budget: expense.Budget{
+				Entity:     ddd.Entity[expense.BudgetID]{ID: expense.BudgetID{Value: 1}},
+				GroupID:    group.ID{Value: 1},
+				CategoryID: &groceriesID,
+				Amount:     50000,
+				CarryOver:  true,
+			},
+			Limit:       60000,
+			CarriedOver: 10000,
+		},
+		{
+			Budget: expense.Budget{
+				Entity:          ddd.Entity[expense.BudgetID]{ID: expense.BudgetID{Value: 2}},
+				GroupID:         group.ID{Value: 1},
+				CategoryGroupID: &transportID,
+				Amount:          20000,
+			},
+			Limit: 20000,
+		},
+	}
+	actuals := []postgres.ExpensesPerCategory{
+		{
+			ID:            1,
+			CategoryGroup: "Alimentação",
+			Amount:        54000,
+			Categories: []postgres.ExpensesPerInnerCategory{
+				{ID: 10, Category: "Restaurante", Amount: 9000},
+				{ID: 11, Category: "Supermercado", Amount: 45000},
+			},
+		},
+		{ID: 2, CategoryGroup: "Transporte", Amount: 25000},
+	}
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		query            string
+		mockSetup        func(getBudgets *mocks.MockusecaseGetBudgets)
+		mockActuals      []postgres.ExpensesPerCategory
+		mockError        error
+		expectedStatus   int
+		expectedResponse string
+		customAssertions func(t *testing.T, body []byte)
+	}{
+		{
+			name:  "should compare the budgets with what was spent in the month",
+			query: "?month=2026-05",
+			mockSetup: func(getBudgets *mocks.MockusecaseGetBudgets) {
+				getBudgets.EXPECT().Execute(mock.Anything, usecase.GetBudgetsParams{
+					GroupID: group.ID{Value: 1},
+					Month:   time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(statuses, nil).Once()
+			},
+			mockActuals:    actuals,
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.GetBudgetsResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, "2026-05", response.Data.Month)
+				assert.Len(t, response.Data.Budgets, 2)
+
+				groceries := response.Data.Budgets[0]
+				assert.Equal(t, 11, *groceries.CategoryID)
+				assert.Equal(t, float32(600), groceries.Limit)
+				assert.Equal(t, float32(100), groceries.CarriedOver)
+				assert.Equal(t, float32(450), groceries.Spent)
+				assert.Equal(t, float32(150), groceries.Remaining)
+				assert.Equal(t, 75, groceries.Percent)
+
+				transport := response.Data.Budgets[1]
+				assert.Equal(t, 2, *transport.CategoryGroupID)
+				assert.Equal(t, float32(250), transport.Spent)
+				assert.Equal(t, float32(-50), transport.Remaining)
+				assert.Equal(t, 125, transport.Percent)
+			},
+		},
+		{
+			name:  "should count nothing spent for budgets without expenses",
+			query: "?month=2026-05",
+			mockSetup: func(getBudgets *mocks.MockusecaseGetBudgets) {
+				getBudgets.EXPECT().Execute(mock.Anything, mock.Anything).Return(statuses[:1], nil).Once()
+			},
+			mockActuals:    nil,
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.GetBudgetsResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Len(t, response.Data.Budgets, 1)
+				assert.Equal(t, float32(0), response.Data.Budgets[0].Spent)
+				assert.Equal(t, float32(600), response.Data.Budgets[0].Remaining)
+				assert.Equal(t, 0, response.Data.Budgets[0].Percent)
+			},
+		},
+		{
+			name:             "should return 400 if month is invalid",
+			query:            "?month=05-2026",
+			mockSetup:        func(getBudgets *mocks.MockusecaseGetBudgets) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid month","error":"invalid month: internal=parsing time \"05-2026\" as \"2006-01\": cannot parse \"05-2026\" as \"2006\""}`,
+		},
+		{
+			name:  "should return 500 if the actuals fail",
+			query: "",
+			mockSetup: func(getBudgets *mocks.MockusecaseGetBudgets) {
+				getBudgets.EXPECT().Execute(mock.Anything, mock.Anything).Return(statuses, nil).Once()
+			},
+			mockError:        errors.New("database error"),
+			expectedStatus:   500,
+			expectedResponse: `{"status_code":500,"message":"Internal Server Error","error":"query.getExpensesPerCategory: database error"}`,
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getBudgets := mocks.NewMockusecaseGetBudgets(t)
+			tc.mockSetup(getBudgets)
+			mockGetExpensesPerCategory := &MockGetExpensesPerCategory{
+				ExpensesPerCategory: tc.mockActuals,
+				Error:               tc.mockError,
+			}
+
+			// Setup comum para todos os testes
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/budgets", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewGetBudgets(getBudgets.Execute, mockGetExpensesPerCategory.Execute))
+
+			req := httptest.NewRequest("GET", "http://localhost:8080/budgets"+tc.query, nil)
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			}
+			if tc.customAssertions != nil {
+				tc.customAssertions(t, body)
+			}
+		})
+	}
+}
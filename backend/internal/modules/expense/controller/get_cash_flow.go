@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type GetCashFlow func(ctx *fiber.Ctx) error
+
+type GetCashFlowReq struct {
+	Aggregate string    `query:"aggregate"`
+	StartDate time.Time `query:"start_date"`
+	EndDate   time.Time `query:"end_date"`
+}
+
+func NewGetCashFlow(getCashFlow postgres.GetCashFlow) GetCashFlow {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var params GetCashFlowReq
+		if err := ctx.QueryParser(&params); err != nil {
+			return except.BadRequestError().SetInternal(err)
+		}
+
+		cashFlow, err := getCashFlow(ctx.Context(), postgres.GetCashFlowInput{
+			GroupID:   groupID,
+			Aggregate: params.Aggregate,
+			StartDate: params.StartDate,
+			EndDate:   params.EndDate,
+		})
+		if err != nil {
+			return fmt.Errorf("query.GetCashFlow: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, cashFlow))
+	}
+}
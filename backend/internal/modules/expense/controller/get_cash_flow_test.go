@@ -0,0 +1,112 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+func TestGetCashFlowHandler(t *testing.T) {
+	t.Parallel()
+
+	rate := 95.25
+	cashFlow := []postgres.CashFlow{
+		{
+			Date:        "2024-06",
+			Income:      800000,
+			Expense:     38000,
+			NetSavings:  762000,
+			SavingsRate: &rate,
+			Members:     []postgres.MemberCashFlow{{UserID: 1, Expense: 24000}, {UserID: 2, Expense: 14000}},
+		},
+		{Date: "2024-08", Expense: 6000, NetSavings: -6000, Members: []postgres.MemberCashFlow{}},
+	}
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		query            string
+		mockCashFlow     []postgres.CashFlow
+		mockError        error
+		expectedInput    postgres.GetCashFlowInput
+		expectedStatus   int
+		expectedResponse string
+		customAssertions func(t *testing.T, body []byte)
+	}{
+		{
+			name:         "should return 200 and the cash flow per month",
+			query:        "?aggregate=month&start_date=2024-06-01T00:00:00Z&end_date=2024-08-31T23:59:59Z",
+			mockCashFlow: cashFlow,
+			expectedInput: postgres.GetCashFlowInput{
+				GroupID:   1,
+				Aggregate: "month",
+				StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2024, 8, 31, 23, 59, 59, 0, time.UTC),
+			},
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[[]postgres.CashFlow]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, cashFlow, response.Data)
+			},
+		},
+		{
+			name:             "should return 400 if invalid date format",
+			query:            "?start_date=invalid-date",
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"Bad Request","error":"Bad Request: internal=failed to decode: schema: error converting value for \"start_date\". Details: parsing time \"invalid-date\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"invalid-date\" as \"2006\""}`,
+		},
+		{
+			name:             "should return 500 if database error",
+			query:            "?aggregate=day",
+			mockError:        errors.New("database error"),
+			expectedInput:    postgres.GetCashFlowInput{GroupID: 1, Aggregate: "day"},
+			expectedStatus:   500,
+			expectedResponse: `{"status_code":500,"message":"Internal Server Error","error":"query.GetCashFlow: database error"}`,
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getCashFlow := func(ctx context.Context, input postgres.GetCashFlowInput) ([]postgres.CashFlow, error) {
+				assert.Equal(t, tc.expectedInput, input)
+				return tc.mockCashFlow, tc.mockError
+			}
+
+			// Setup comum para todos os testes
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/cash-flow", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewGetCashFlow(getCashFlow))
+
+			req := httptest.NewRequest("GET", "http://localhost:8080/cash-flow"+tc.query, nil)
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			}
+			if tc.customAssertions != nil {
+				tc.customAssertions(t, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type GetDuplicateClusters func(ctx *fiber.Ctx) error
+
+func NewGetDuplicateClusters(getDuplicateClusters postgres.GetDuplicateClusters) GetDuplicateClusters {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		clusters, err := getDuplicateClusters(ctx.Context(), groupID)
+		if err != nil {
+			return fmt.Errorf("query.GetDuplicateClusters: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, clusters))
+	}
+}
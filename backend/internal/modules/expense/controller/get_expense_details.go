@@ -41,6 +41,8 @@ func NewGetExpenseDetails(getExpenseDetails postgres.GetExpenseDetails) GetExpen
 			return except.ForbiddenError("group mismatch")
 		}
 
+		api.SetETag(ctx, expenseDetails[len(expenseDetails)-1].Version)
+
 		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, expenseDetails))
 	}
 }
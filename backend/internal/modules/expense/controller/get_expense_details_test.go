@@ -45,8 +45,7 @@ func TestGetExpenseDetailsHandler(t *testing.T) {
 			ReceiverID:   2,
 			GroupID:      1,
 			SplitRatio: postgres.SplitRatio{
-				Payer:    50,
-				Receiver: 50,
+				Shares: []postgres.Share{{UserID: 1, Percent: 50}, {UserID: 2, Percent: 50}},
 			},
 			SplitType: "equal",
 			CreatedAt: time.Now(),
@@ -67,8 +66,7 @@ func TestGetExpenseDetailsHandler(t *testing.T) {
 			ReceiverID:   2,
 			GroupID:      1,
 			SplitRatio: postgres.SplitRatio{
-				Payer:    70,
-				Receiver: 30,
+				Shares: []postgres.Share{{UserID: 1, Percent: 70}, {UserID: 2, Percent: 30}},
 			},
 			SplitType: "proportional",
 			CreatedAt: time.Now(),
@@ -89,8 +87,7 @@ func TestGetExpenseDetailsHandler(t *testing.T) {
 			ReceiverID:   2,
 			GroupID:      999, // Grupo diferente
 			SplitRatio: postgres.SplitRatio{
-				Payer:    50,
-				Receiver: 50,
+				Shares: []postgres.Share{{UserID: 1, Percent: 50}, {UserID: 2, Percent: 50}},
 			},
 			SplitType: "equal",
 			CreatedAt: time.Now(),
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetExpenseHistory func(ctx *fiber.Ctx) error
+
+	ExpenseRevisionResponse struct {
+		Version   int                     `json:"version"`
+		UpdatedAt time.Time               `json:"updated_at"`
+		UpdatedBy *int                    `json:"updated_by"`
+		Changes   []ExpenseChangeResponse `json:"changes"`
+	}
+
+	ExpenseChangeResponse struct {
+		Field string `json:"field"`
+		From  any    `json:"from"`
+		To    any    `json:"to"`
+	}
+
+	ShareResponse struct {
+		UserID  int     `json:"user_id"`
+		Percent int     `json:"percent"`
+		Amount  float32 `json:"amount,omitempty"`
+		Weight  int     `json:"weight,omitempty"`
+	}
+)
+
+func NewGetExpenseHistory(getExpenseHistory usecase.GetExpenseHistory) GetExpenseHistory {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		expenseID, err := strconv.Atoi(ctx.Params("expense_id"))
+		if err != nil {
+			return except.BadRequestError("invalid expense id")
+		}
+
+		revisions, err := getExpenseHistory(ctx.Context(), usecase.GetExpenseHistoryParams{
+			ID:      expense.ID{Value: expenseID},
+			GroupID: group.ID{Value: groupID},
+		})
+		if err != nil {
+			return fmt.Errorf("GetExpenseHistory: %w", err)
+		}
+
+		response := make([]ExpenseRevisionResponse, 0, len(revisions))
+		for _, revision := range revisions {
+			var updatedBy *int
+			if revision.UpdatedBy != nil {
+				updatedBy = &revision.UpdatedBy.Value
+			}
+
+			changes := make([]ExpenseChangeResponse, 0, len(revision.Changes))
+			for _, change := range revision.Changes {
+				changes = append(changes, ExpenseChangeResponse{
+					Field: change.Field,
+					From:  toChangeValue(change.From),
+					To:    toChangeValue(change.To),
+				})
+			}
+
+			response = append(response, ExpenseRevisionResponse{
+				Version:   revision.Version,
+				UpdatedAt: revision.UpdatedAt,
+				UpdatedBy: updatedBy,
+				Changes:   changes,
+			})
+		}
+
+		api.SetETag(ctx, revisions[len(revisions)-1].Version)
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, response))
+	}
+}
+
+// toChangeValue presents a field of the expense the same way the other endpoints do, with amounts
+// in reais and references as plain ids.
+func toChangeValue(value any) any {
+	switch v := value.(type) {
+	case int:
+		return float32(v) / 100
+	case *int:
+		if v == nil {
+			return nil
+		}
+		return float32(*v) / 100
+	case category.ID:
+		return v.Value
+	case user.ID:
+		return v.Value
+	case []tag.ID:
+		return toTagIDs(v)
+	case expense.SplitType:
+		return v.String()
+	case expense.SplitRatio:
+		return toShareResponses(v)
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return *v
+	default:
+		return v
+	}
+}
+
+func toShareResponses(splitRatio expense.SplitRatio) []ShareResponse {
+	shares := make([]ShareResponse, 0, len(splitRatio.Shares))
+	for _, share := range splitRatio.Shares {
+		shares = append(shares, ShareResponse{
+			UserID:  share.UserID.Value,
+			Percent: share.Percent,
+			Amount:  float32(share.Amount) / 100,
+			Weight:  share.Weight,
+		})
+	}
+
+	return shares
+}
@@ -12,14 +12,37 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
 )
 
 type (
 	GetExpenses func(ctx *fiber.Ctx) error
 
+	GetExpensesRequest struct {
+		NextToken        string   `query:"next_token"`
+		Search           string   `query:"search"`
+		CategoryIDs      []int    `query:"category_id" validate:"dive,gt=0"`
+		CategoryGroupIDs []int    `query:"category_group_id" validate:"dive,gt=0"`
+		PayerID          int      `query:"payer_id" validate:"gte=0"`
+		ReceiverID       int      `query:"receiver_id" validate:"gte=0"`
+		SplitTypes       []string `query:"split_type" validate:"dive,oneof=equal proportional transfer exact weighted"`
+		TagIDs           []int    `query:"tag_id" validate:"dive,gt=0"`
+		MinAmount        *int     `query:"min_amount" validate:"omitempty,gte=0"`
+		MaxAmount        *int     `query:"max_amount" validate:"omitempty,gte=0"`
+		StartDate        string   `query:"start_date" validate:"omitempty,datetime=2006-01-02"`
+		EndDate          string   `query:"end_date" validate:"omitempty,datetime=2006-01-02"`
+		Sort             string   `query:"sort" validate:"omitempty,oneof=date amount"`
+		Order            string   `query:"order" validate:"omitempty,oneof=asc desc"`
+	}
+
 	GetExpensesCursor struct {
-		LastExpenseID   int       `json:"last_expense_id"`
-		LastExpenseDate time.Time `json:"last_expense_date"`
+		LastExpenseID     int       `json:"last_expense_id"`
+		LastExpenseDate   time.Time `json:"last_expense_date"`
+		LastExpenseAmount int       `json:"last_expense_amount,omitempty"`
+		// Sort and Order tie the cursor to the ordering of the page it was built from. Tokens
+		// issued before sorting existed have them empty, meaning the default ordering.
+		Sort  string `json:"sort,omitempty"`
+		Order string `json:"order,omitempty"`
 	}
 
 	GetExpensesResponse struct {
@@ -28,8 +51,14 @@ type (
 	}
 )
 
+const (
+	defaultExpensesSort  = "date"
+	defaultExpensesOrder = "desc"
+)
+
 func NewGetExpenses(getGroupExpenses postgres.GetExpenses) GetExpenses {
 	const defaultLimit = 25
+	valid := validator.New()
 
 	return func(ctx *fiber.Ctx) error {
 		groupID, ok := ctx.Locals("group_id").(int)
@@ -37,20 +66,33 @@ func NewGetExpenses(getGroupExpenses postgres.GetExpenses) GetExpenses {
 			return except.BadRequestError("invalid group id")
 		}
 
-		token, err := decodeCursor(ctx.Query("next_token", ""))
+		req, input, err := parseExpensesFilters(ctx, valid)
+		if err != nil {
+			return err
+		}
+
+		token, err := decodeCursor(req.NextToken)
 		if err != nil {
 			return except.BadRequestError("invalid next token").SetInternal(err)
 		}
 
-		search := ctx.Query("search")
+		if !token.matches(req.Sort, req.Order) {
+			return except.BadRequestError("next token does not match the requested sort")
+		}
+
+		input.GroupID = groupID
+		input.LastExpenseDate = token.LastExpenseDate
+		input.LastExpenseAmount = token.LastExpenseAmount
+		input.LastExpenseID = token.LastExpenseID
+		input.Limit = defaultLimit
 
-		expenses, err := getGroupExpenses(ctx.Context(), postgres.GetExpensesInput{
-			GroupID:         groupID,
-			LastExpenseDate: token.LastExpenseDate,
-			LastExpenseID:   token.LastExpenseID,
-			Limit:           defaultLimit,
-			Search:          search,
-		})
+		// Installments far in the future are hidden unless the client asks for them
+		if input.EndDate == nil {
+			endDate := time.Now().AddDate(0, 2, 0)
+			input.EndDate = &endDate
+		}
+
+		expenses, err := getGroupExpenses(ctx.Context(), input)
 		if err != nil {
 			return fmt.Errorf("query.GetExpenses: %w", err)
 		}
@@ -59,8 +101,11 @@ func NewGetExpenses(getGroupExpenses postgres.GetExpenses) GetExpenses {
 		if len(expenses) == defaultLimit {
 			lastExpense := expenses[len(expenses)-1]
 			nextToken, err = encodeCursor(&GetExpensesCursor{
-				LastExpenseDate: lastExpense.CreatedAt,
-				LastExpenseID:   lastExpense.ID,
+				LastExpenseDate:   lastExpense.CreatedAt,
+				LastExpenseAmount: lastExpense.AmountCents,
+				LastExpenseID:     lastExpense.ID,
+				Sort:              req.Sort,
+				Order:             req.Order,
 			})
 			if err != nil {
 				return fmt.Errorf("encodeCursor: %w", err)
@@ -74,6 +119,72 @@ func NewGetExpenses(getGroupExpenses postgres.GetExpenses) GetExpenses {
 	}
 }
 
+// parseExpensesFilters reads the filters and sorting shared by the expense listing and export.
+func parseExpensesFilters(ctx *fiber.Ctx, valid *validator.Validator) (GetExpensesRequest, postgres.GetExpensesInput, error) {
+	var req GetExpensesRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return req, postgres.GetExpensesInput{}, except.BadRequestError("invalid query params").SetInternal(err)
+	}
+
+	if err := valid.Validate(req); err != nil {
+		return req, postgres.GetExpensesInput{}, except.BadRequestError("invalid query params").SetInternal(err)
+	}
+
+	if req.MinAmount != nil && req.MaxAmount != nil && *req.MinAmount > *req.MaxAmount {
+		return req, postgres.GetExpensesInput{}, except.BadRequestError("min_amount must not be greater than max_amount")
+	}
+
+	if req.Sort == "" {
+		req.Sort = defaultExpensesSort
+	}
+	if req.Order == "" {
+		req.Order = defaultExpensesOrder
+	}
+
+	input := postgres.GetExpensesInput{
+		Search:           req.Search,
+		CategoryIDs:      req.CategoryIDs,
+		CategoryGroupIDs: req.CategoryGroupIDs,
+		PayerID:          req.PayerID,
+		ReceiverID:       req.ReceiverID,
+		SplitTypes:       req.SplitTypes,
+		TagIDs:           req.TagIDs,
+		MinAmount:        req.MinAmount,
+		MaxAmount:        req.MaxAmount,
+		Sort:             postgres.ExpensesSort(req.Sort),
+		Ascending:        req.Order == "asc",
+	}
+
+	// Dates were validated above, the end date is inclusive
+	if req.StartDate != "" {
+		startDate, _ := time.Parse(time.DateOnly, req.StartDate)
+		input.StartDate = &startDate
+	}
+	if req.EndDate != "" {
+		endDate, _ := time.Parse(time.DateOnly, req.EndDate)
+		endDate = endDate.AddDate(0, 0, 1)
+		input.EndDate = &endDate
+	}
+
+	return req, input, nil
+}
+
+func (c *GetExpensesCursor) matches(sort, order string) bool {
+	if c.LastExpenseID == 0 {
+		return true
+	}
+
+	cursorSort, cursorOrder := c.Sort, c.Order
+	if cursorSort == "" {
+		cursorSort = defaultExpensesSort
+	}
+	if cursorOrder == "" {
+		cursorOrder = defaultExpensesOrder
+	}
+
+	return cursorSort == sort && cursorOrder == order
+}
+
 func encodeCursor(cursor *GetExpensesCursor) (string, error) {
 	serializedCursor, err := json.Marshal(cursor)
 	if err != nil {
@@ -90,9 +201,7 @@ func decodeCursor(cursor string) (*GetExpensesCursor, error) {
 	}
 
 	if string(decodedCursor) == "" {
-		return &GetExpensesCursor{
-			LastExpenseDate: time.Now().AddDate(0, 2, 0),
-		}, nil
+		return &GetExpensesCursor{}, nil
 	}
 
 	var cur *GetExpensesCursor
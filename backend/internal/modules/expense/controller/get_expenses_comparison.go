@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+const maxComparisonMonths = 12
+
+type (
+	GetExpensesComparison func(ctx *fiber.Ctx) error
+
+	GetExpensesComparisonRequest struct {
+		// Month is the last month of the window, as YYYY-MM, the current month when not given.
+		Month string `query:"month"`
+		// Months is how many months the window takes, one when not given.
+		Months int `query:"months"`
+		// CompareTo is either the previous window, by default, or the same window a year before.
+		CompareTo string `query:"compare_to" validate:"omitempty,oneof=previous last_year"`
+	}
+
+	ComparisonPeriodResponse struct {
+		StartMonth string `json:"start_month"`
+		EndMonth   string `json:"end_month"`
+	}
+
+	// AmountComparison puts what was spent in the window next to what was spent in the one it is
+	// compared to. DeltaPercent is nil when nothing was spent before.
+	AmountComparison struct {
+		Current      int      `json:"current"`
+		Previous     int      `json:"previous"`
+		Delta        int      `json:"delta"`
+		DeltaPercent *float64 `json:"delta_percent"`
+	}
+
+	CategoryComparisonResponse struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+		AmountComparison
+	}
+
+	CategoryGroupComparisonResponse struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+		AmountComparison
+		Categories []CategoryComparisonResponse `json:"categories"`
+	}
+
+	TopMoverResponse struct {
+		CategoryID    int    `json:"category_id"`
+		Category      string `json:"category"`
+		CategoryGroup string `json:"category_group"`
+		AmountComparison
+	}
+
+	GetExpensesComparisonResponse struct {
+		Current        ComparisonPeriodResponse          `json:"current"`
+		Previous       ComparisonPeriodResponse          `json:"previous"`
+		Total          AmountComparison                  `json:"total"`
+		CategoryGroups []CategoryGroupComparisonResponse `json:"category_groups"`
+		// TopMovers are the categories that changed the most, either way.
+		TopMovers []TopMoverResponse `json:"top_movers"`
+	}
+)
+
+func NewGetExpensesComparison(getExpensesPerCategory postgres.GetExpensesPerCategory) GetExpensesComparison {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		var req GetExpensesComparisonRequest
+		if err := ctx.QueryParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid query params").SetInternal(err)
+		}
+
+		endMonth := expense.MonthOf(time.Now())
+		if req.Month != "" {
+			parsed, err := time.Parse("2006-01", req.Month)
+			if err != nil {
+				return except.BadRequestError("invalid month").SetInternal(err)
+			}
+			endMonth = parsed
+		}
+
+		months := req.Months
+		if months == 0 {
+			months = 1
+		}
+		if months < 1 || months > maxComparisonMonths {
+			return except.BadRequestError(fmt.Sprintf("months must be between 1 and %d", maxComparisonMonths))
+		}
+
+		current, previous := expense.ComparisonPeriods(endMonth, months, req.CompareTo == "last_year")
+
+		currentExpenses, err := getExpensesPerCategory(ctx.Context(), postgres.GetExpensesPerCategoryInput{
+			GroupID:   groupID,
+			StartDate: current.Start,
+			EndDate:   current.End(),
+		})
+		if err != nil {
+			return fmt.Errorf("query.getExpensesPerCategory: %w", err)
+		}
+
+		previousExpenses, err := getExpensesPerCategory(ctx.Context(), postgres.GetExpensesPerCategoryInput{
+			GroupID:   groupID,
+			StartDate: previous.Start,
+			EndDate:   previous.End(),
+		})
+		if err != nil {
+			return fmt.Errorf("query.getExpensesPerCategory: %w", err)
+		}
+
+		comparison := expense.CompareSpending(toCategoryGroupAmounts(currentExpenses), toCategoryGroupAmounts(previousExpenses))
+		response := toExpensesComparisonResponse(comparison)
+		response.Current = comparisonPeriod(current)
+		response.Previous = comparisonPeriod(previous)
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, response))
+	}
+}
+
+func comparisonPeriod(period expense.ComparisonPeriod) ComparisonPeriodResponse {
+	return ComparisonPeriodResponse{
+		StartMonth: period.Start.Format("2006-01"),
+		EndMonth:   period.EndMonth().Format("2006-01"),
+	}
+}
+
+func toCategoryGroupAmounts(expensesPerCategory []postgres.ExpensesPerCategory) []expense.CategoryGroupAmount {
+	amounts := make([]expense.CategoryGroupAmount, 0, len(expensesPerCategory))
+	for _, categoryGroup := range expensesPerCategory {
+		categories := make([]expense.CategoryAmount, 0, len(categoryGroup.Categories))
+		for _, cat := range categoryGroup.Categories {
+			categories = append(categories, expense.CategoryAmount{ID: category.ID{Value: cat.ID}, Name: cat.Category, Amount: cat.Amount})
+		}
+
+		amounts = append(amounts, expense.CategoryGroupAmount{
+			ID:         category.GroupID{Value: categoryGroup.ID},
+			Name:       categoryGroup.CategoryGroup,
+			Amount:     categoryGroup.Amount,
+			Categories: categories,
+		})
+	}
+
+	return amounts
+}
+
+func toExpensesComparisonResponse(comparison expense.SpendingComparison) GetExpensesComparisonResponse {
+	response := GetExpensesComparisonResponse{
+		Total:          AmountComparison(comparison.Total),
+		CategoryGroups: make([]CategoryGroupComparisonResponse, 0, len(comparison.CategoryGroups)),
+		TopMovers:      make([]TopMoverResponse, 0, len(comparison.TopMovers)),
+	}
+
+	for _, group := range comparison.CategoryGroups {
+		categories := make([]CategoryComparisonResponse, 0, len(group.Categories))
+		for _, cat := range group.Categories {
+			categories = append(categories, CategoryComparisonResponse{
+				ID:               cat.ID.Value,
+				Name:             cat.Name,
+				AmountComparison: AmountComparison(cat.AmountComparison),
+			})
+		}
+
+		response.CategoryGroups = append(response.CategoryGroups, CategoryGroupComparisonResponse{
+			ID:               group.ID.Value,
+			Name:             group.Name,
+			AmountComparison: AmountComparison(group.AmountComparison),
+			Categories:       categories,
+		})
+	}
+
+	for _, mover := range comparison.TopMovers {
+		response.TopMovers = append(response.TopMovers, TopMoverResponse{
+			CategoryID:       mover.CategoryID.Value,
+			Category:         mover.Category,
+			CategoryGroup:    mover.CategoryGroup,
+			AmountComparison: AmountComparison(mover.AmountComparison),
+		})
+	}
+
+	return response
+}
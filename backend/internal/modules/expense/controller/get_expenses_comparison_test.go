@@ -0,0 +1,175 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+func TestGetExpensesComparison(t *testing.T) {
+	t.Parallel()
+
+	may := []postgres.ExpensesPerCategory{
+		{
+			ID:            1,
+			CategoryGroup: "Alimentação",
+			Amount:        54000,
+			Categories: []postgres.ExpensesPerInnerCategory{
+				{ID: 10, Category: "Restaurante", Amount: 9000},
+				{ID: 11, Category: "Supermercado", Amount: 45000},
+			},
+		},
+		{ID: 2, CategoryGroup: "Transporte", Amount: 25000, Categories: []postgres.ExpensesPerInnerCategory{{ID: 20, Category: "Uber", Amount: 25000}}},
+	}
+	april := []postgres.ExpensesPerCategory{
+		{
+			ID:            1,
+			CategoryGroup: "Alimentação",
+			Amount:        40000,
+			Categories: []postgres.ExpensesPerInnerCategory{
+				{ID: 10, Category: "Restaurante", Amount: 10000},
+				{ID: 11, Category: "Supermercado", Amount: 30000},
+			},
+		},
+		{ID: 3, CategoryGroup: "Lazer", Amount: 8000, Categories: []postgres.ExpensesPerInnerCategory{{ID: 30, Category: "Cinema", Amount: 8000}}},
+	}
+	percent := func(p float64) *float64 { return &p }
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		query            string
+		mockError        error
+		expectedInputs   []postgres.GetExpensesPerCategoryInput
+		expectedStatus   int
+		expectedResponse string
+		customAssertions func(t *testing.T, body []byte)
+	}{
+		{
+			name:  "should compare the month with the previous one",
+			query: "?month=2026-05",
+			expectedInputs: []postgres.GetExpensesPerCategoryInput{
+				{GroupID: 1, StartDate: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 5, 31, 23, 59, 59, 999999000, time.UTC)},
+				{GroupID: 1, StartDate: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 4, 30, 23, 59, 59, 999999000, time.UTC)},
+			},
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.GetExpensesComparisonResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, controller.ComparisonPeriodResponse{StartMonth: "2026-05", EndMonth: "2026-05"}, response.Data.Current)
+				assert.Equal(t, controller.ComparisonPeriodResponse{StartMonth: "2026-04", EndMonth: "2026-04"}, response.Data.Previous)
+				assert.Equal(t, controller.AmountComparison{Current: 79000, Previous: 48000, Delta: 31000, DeltaPercent: percent(64.58)}, response.Data.Total)
+
+				groups := response.Data.CategoryGroups
+				assert.Len(t, groups, 3)
+				assert.Equal(t, controller.AmountComparison{Current: 54000, Previous: 40000, Delta: 14000, DeltaPercent: percent(35)}, groups[0].AmountComparison)
+				assert.Equal(t, controller.CategoryComparisonResponse{
+					ID:               10,
+					Name:             "Restaurante",
+					AmountComparison: controller.AmountComparison{Current: 9000, Previous: 10000, Delta: -1000, DeltaPercent: percent(-10)},
+				}, groups[0].Categories[1])
+				// Categories are sorted by what they spent in the current window
+				assert.Equal(t, "Supermercado", groups[0].Categories[0].Name)
+				// Nothing was spent in transport before, so there is no percentage
+				assert.Equal(t, "Transporte", groups[1].Name)
+				assert.Nil(t, groups[1].DeltaPercent)
+				assert.Equal(t, "Lazer", groups[2].Name)
+				assert.Equal(t, -8000, groups[2].Delta)
+
+				movers := make([]string, 0, len(response.Data.TopMovers))
+				for _, mover := range response.Data.TopMovers {
+					movers = append(movers, mover.Category)
+				}
+				assert.Equal(t, []string{"Uber", "Supermercado", "Cinema", "Restaurante"}, movers)
+				assert.Equal(t, "Alimentação", response.Data.TopMovers[1].CategoryGroup)
+			},
+		},
+		{
+			name:  "should compare a window with the same one a year before",
+			query: "?month=2026-05&months=3&compare_to=last_year",
+			expectedInputs: []postgres.GetExpensesPerCategoryInput{
+				{GroupID: 1, StartDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 5, 31, 23, 59, 59, 999999000, time.UTC)},
+				{GroupID: 1, StartDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 5, 31, 23, 59, 59, 999999000, time.UTC)},
+			},
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.GetExpensesComparisonResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, controller.ComparisonPeriodResponse{StartMonth: "2026-03", EndMonth: "2026-05"}, response.Data.Current)
+				assert.Equal(t, controller.ComparisonPeriodResponse{StartMonth: "2025-03", EndMonth: "2025-05"}, response.Data.Previous)
+			},
+		},
+		{
+			name:             "should return 400 if compare_to is invalid",
+			query:            "?compare_to=last_week",
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid query params","error":"invalid query params: internal=validation errors: [CompareTo]: 'last_week' | Needs to implement 'oneof'"}`,
+		},
+		{
+			name:             "should return 400 if the window is too long",
+			query:            "?months=13",
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"months must be between 1 and 12","error":"months must be between 1 and 12"}`,
+		},
+		{
+			name:             "should return 500 if database error",
+			query:            "?month=2026-05",
+			mockError:        errors.New("database error"),
+			expectedInputs:   []postgres.GetExpensesPerCategoryInput{{GroupID: 1, StartDate: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 5, 31, 23, 59, 59, 999999000, time.UTC)}},
+			expectedStatus:   500,
+			expectedResponse: `{"status_code":500,"message":"Internal Server Error","error":"query.getExpensesPerCategory: database error"}`,
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var inputs []postgres.GetExpensesPerCategoryInput
+			getExpensesPerCategory := func(ctx context.Context, input postgres.GetExpensesPerCategoryInput) ([]postgres.ExpensesPerCategory, error) {
+				inputs = append(inputs, input)
+				if tc.mockError != nil {
+					return nil, tc.mockError
+				}
+				if len(inputs) == 1 {
+					return may, nil
+				}
+				return april, nil
+			}
+
+			// Setup comum para todos os testes
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/comparison", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewGetExpensesComparison(getExpensesPerCategory))
+
+			req := httptest.NewRequest("GET", "http://localhost:8080/comparison"+tc.query, nil)
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tc.expectedInputs, inputs)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			}
+			if tc.customAssertions != nil {
+				tc.customAssertions(t, body)
+			}
+		})
+	}
+}
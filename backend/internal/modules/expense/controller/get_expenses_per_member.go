@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type GetExpensesPerMember func(ctx *fiber.Ctx) error
+
+type GetExpensesPerMemberReq struct {
+	StartDate time.Time `query:"start_date"`
+	EndDate   time.Time `query:"end_date"`
+}
+
+func NewGetExpensesPerMember(getExpensesPerMember postgres.GetExpensesPerMember) GetExpensesPerMember {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var params GetExpensesPerMemberReq
+		if err := ctx.QueryParser(&params); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		expensesPerMember, err := getExpensesPerMember(ctx.Context(), postgres.GetExpensesPerMemberInput{
+			GroupID:   groupID,
+			StartDate: params.StartDate,
+			EndDate:   params.EndDate,
+		})
+		if err != nil {
+			return fmt.Errorf("query.GetExpensesPerMember: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, expensesPerMember))
+	}
+}
@@ -0,0 +1,109 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+func TestGetExpensesPerMemberHandler(t *testing.T) {
+	t.Parallel()
+
+	expensesPerMember := []postgres.ExpensesPerMember{
+		{
+			UserID:     1,
+			Name:       "Ana",
+			Paid:       30000,
+			Consumed:   16400,
+			Balance:    13600,
+			Months:     []postgres.MemberExpensesPerMonth{{Date: "2024-06", Paid: 30000, Consumed: 16400}},
+			Categories: []postgres.MemberExpensesPerCategory{{CategoryID: 1, Category: "Restaurante", Paid: 30000, Consumed: 16400}},
+		},
+	}
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		query            string
+		mockMembers      []postgres.ExpensesPerMember
+		mockError        error
+		expectedInput    postgres.GetExpensesPerMemberInput
+		expectedStatus   int
+		expectedResponse string
+		customAssertions func(t *testing.T, body []byte)
+	}{
+		{
+			name:        "should return 200 and the expenses per member",
+			query:       "?start_date=2024-06-01T00:00:00Z&end_date=2024-06-30T23:59:59Z",
+			mockMembers: expensesPerMember,
+			expectedInput: postgres.GetExpensesPerMemberInput{
+				GroupID:   1,
+				StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC),
+			},
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[[]postgres.ExpensesPerMember]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, expensesPerMember, response.Data)
+			},
+		},
+		{
+			name:             "should return 422 if invalid date format",
+			query:            "?start_date=invalid-date",
+			expectedStatus:   422,
+			expectedResponse: `{"status_code":422,"message":"Unprocessable Entity","error":"Unprocessable Entity: internal=failed to decode: schema: error converting value for \"start_date\". Details: parsing time \"invalid-date\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"invalid-date\" as \"2006\""}`,
+		},
+		{
+			name:             "should return 500 if database error",
+			mockError:        errors.New("database error"),
+			expectedInput:    postgres.GetExpensesPerMemberInput{GroupID: 1},
+			expectedStatus:   500,
+			expectedResponse: `{"status_code":500,"message":"Internal Server Error","error":"query.GetExpensesPerMember: database error"}`,
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getExpensesPerMember := func(ctx context.Context, input postgres.GetExpensesPerMemberInput) ([]postgres.ExpensesPerMember, error) {
+				assert.Equal(t, tc.expectedInput, input)
+				return tc.mockMembers, tc.mockError
+			}
+
+			// Setup comum para todos os testes
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/members", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewGetExpensesPerMember(getExpensesPerMember))
+
+			req := httptest.NewRequest("GET", "http://localhost:8080/members"+tc.query, nil)
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			}
+			if tc.customAssertions != nil {
+				tc.customAssertions(t, body)
+			}
+		})
+	}
+}
@@ -21,9 +21,12 @@ import (
 type MockGetExpensesPerPeriod struct {
 	ExpensesPerPeriod []postgres.ExpensesPerPeriod
 	Error             error
+	// Input is the last input the query was called with
+	Input postgres.GetExpensesPerPeriodInput
 }
 
 func (m *MockGetExpensesPerPeriod) Execute(ctx context.Context, input postgres.GetExpensesPerPeriodInput) ([]postgres.ExpensesPerPeriod, error) {
+	m.Input = input
 	if m.Error != nil {
 		return nil, m.Error
 	}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type GetExpensesPerTag func(ctx *fiber.Ctx) error
+
+type GetExpensesPerTagReq struct {
+	StartDate time.Time `query:"start_date"`
+	EndDate   time.Time `query:"end_date"`
+}
+
+func NewGetExpensesPerTag(getExpensesPerTag postgres.GetExpensesPerTag) GetExpensesPerTag {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var params GetExpensesPerTagReq
+		if err := ctx.QueryParser(&params); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		expensesPerTag, err := getExpensesPerTag(ctx.Context(), postgres.GetExpensesPerTagInput{
+			GroupID:   groupID,
+			StartDate: params.StartDate,
+			EndDate:   params.EndDate,
+		})
+		if err != nil {
+			return fmt.Errorf("query.getExpensesPerTag: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, expensesPerTag))
+	}
+}
@@ -2,6 +2,7 @@ package controller_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -48,8 +49,7 @@ func TestGetExpensesHandler(t *testing.T) {
 			ReceiverID:   2,
 			GroupID:      1,
 			SplitRatio: postgres.SplitRatio{
-				Payer:    50,
-				Receiver: 50,
+				Shares: []postgres.Share{{UserID: 1, Percent: 50}, {UserID: 2, Percent: 50}},
 			},
 			SplitType: "equal",
 			CreatedAt: now,
@@ -67,8 +67,7 @@ func TestGetExpensesHandler(t *testing.T) {
 			ReceiverID:   2,
 			GroupID:      1,
 			SplitRatio: postgres.SplitRatio{
-				Payer:    70,
-				Receiver: 30,
+				Shares: []postgres.Share{{UserID: 1, Percent: 70}, {UserID: 2, Percent: 30}},
 			},
 			SplitType: "proportional",
 			CreatedAt: now.Add(-time.Hour),
@@ -91,8 +90,7 @@ func TestGetExpensesHandler(t *testing.T) {
 			ReceiverID:   2,
 			GroupID:      1,
 			SplitRatio: postgres.SplitRatio{
-				Payer:    50,
-				Receiver: 50,
+				Shares: []postgres.Share{{UserID: 1, Percent: 50}, {UserID: 2, Percent: 50}},
 			},
 			SplitType: "equal",
 			CreatedAt: now.Add(-time.Duration(i) * time.Hour),
@@ -298,3 +296,149 @@ func TestGetExpensesHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExpensesHandlerFilters(t *testing.T) {
+	t.Parallel()
+
+	amountSortedExpenses := make([]postgres.ExpenseDetails, 25)
+	for i := range amountSortedExpenses {
+		amountSortedExpenses[i] = postgres.ExpenseDetails{ID: i + 1, AmountCents: 1000 + i}
+	}
+
+	amountToken := base64.StdEncoding.EncodeToString([]byte(`{"last_expense_id":3,"last_expense_amount":500,"sort":"amount","order":"asc"}`))
+	legacyToken := base64.StdEncoding.EncodeToString([]byte(`{"last_expense_id":3,"last_expense_date":"2024-01-10T00:00:00Z"}`))
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		query            string
+		mockExpenses     []postgres.ExpenseDetails
+		expectedStatus   int
+		expectedResponse string
+		assertInput      func(t *testing.T, input postgres.GetExpensesInput)
+		assertResponse   func(t *testing.T, response controller.GetExpensesResponse)
+	}{
+		{
+			name:           "should pass the filters to the query",
+			query:          "category_id=1&category_id=2&category_group_id=3&payer_id=4&receiver_id=5&split_type=equal&split_type=exact&min_amount=100&max_amount=5000&start_date=2024-01-01&end_date=2024-01-31&search=mercado&tag_id=6&tag_id=7",
+			expectedStatus: 200,
+			assertInput: func(t *testing.T, input postgres.GetExpensesInput) {
+				assert.Equal(t, 1, input.GroupID)
+				assert.Equal(t, []int{1, 2}, input.CategoryIDs)
+				assert.Equal(t, []int{3}, input.CategoryGroupIDs)
+				assert.Equal(t, 4, input.PayerID)
+				assert.Equal(t, 5, input.ReceiverID)
+				assert.Equal(t, []string{"equal", "exact"}, input.SplitTypes)
+				assert.Equal(t, []int{6, 7}, input.TagIDs)
+				assert.Equal(t, 100, *input.MinAmount)
+				assert.Equal(t, 5000, *input.MaxAmount)
+				assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *input.StartDate)
+				assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), *input.EndDate)
+				assert.Equal(t, "mercado", input.Search)
+				assert.Equal(t, postgres.ExpensesSorts.Date, input.Sort)
+				assert.False(t, input.Ascending)
+				assert.Zero(t, input.LastExpenseID)
+			},
+		},
+		{
+			name:           "should sort by amount and encode the amount in the next token",
+			query:          "sort=amount&order=asc",
+			mockExpenses:   amountSortedExpenses,
+			expectedStatus: 200,
+			assertInput: func(t *testing.T, input postgres.GetExpensesInput) {
+				assert.Equal(t, postgres.ExpensesSorts.Amount, input.Sort)
+				assert.True(t, input.Ascending)
+			},
+			assertResponse: func(t *testing.T, response controller.GetExpensesResponse) {
+				decoded, err := base64.StdEncoding.DecodeString(response.NextToken)
+				assert.NoError(t, err)
+				var cursor controller.GetExpensesCursor
+				assert.NoError(t, json.Unmarshal(decoded, &cursor))
+				assert.Equal(t, 25, cursor.LastExpenseID)
+				assert.Equal(t, 1024, cursor.LastExpenseAmount)
+				assert.Equal(t, "amount", cursor.Sort)
+				assert.Equal(t, "asc", cursor.Order)
+			},
+		},
+		{
+			name:           "should continue from the next token of the same sort",
+			query:          "sort=amount&order=asc&next_token=" + amountToken,
+			expectedStatus: 200,
+			assertInput: func(t *testing.T, input postgres.GetExpensesInput) {
+				assert.Equal(t, 3, input.LastExpenseID)
+				assert.Equal(t, 500, input.LastExpenseAmount)
+			},
+		},
+		{
+			name:           "should accept tokens issued before sorting existed",
+			query:          "next_token=" + legacyToken,
+			expectedStatus: 200,
+			assertInput: func(t *testing.T, input postgres.GetExpensesInput) {
+				assert.Equal(t, 3, input.LastExpenseID)
+				assert.Equal(t, time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), input.LastExpenseDate)
+			},
+		},
+		{
+			name:             "should return 400 if the next token was built for another sort",
+			query:            "sort=date&next_token=" + amountToken,
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"next token does not match the requested sort","error":"next token does not match the requested sort"}`,
+		},
+		{
+			name:             "should return 400 if sort is invalid",
+			query:            "sort=name",
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid query params","error":"invalid query params: internal=validation errors: [Sort]: 'name' | Needs to implement 'oneof'"}`,
+		},
+		{
+			name:             "should return 400 if min amount is greater than max amount",
+			query:            "min_amount=500&max_amount=100",
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"min_amount must not be greater than max_amount","error":"min_amount must not be greater than max_amount"}`,
+		},
+		{
+			name:             "should return 400 if date is invalid",
+			query:            "start_date=01-01-2024",
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid query params","error":"invalid query params: internal=validation errors: [StartDate]: '01-01-2024' | Needs to implement 'datetime'"}`,
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var input *postgres.GetExpensesInput
+			getExpenses := func(ctx context.Context, in postgres.GetExpensesInput) ([]postgres.ExpenseDetails, error) {
+				input = &in
+				return tc.mockExpenses, nil
+			}
+
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/expenses", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewGetExpenses(getExpenses))
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/expenses?"+tc.query, nil))
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+				assert.Nil(t, input)
+			}
+			if tc.assertInput != nil {
+				tc.assertInput(t, *input)
+			}
+			if tc.assertResponse != nil {
+				var response api.Response[controller.GetExpensesResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				tc.assertResponse(t, response.Data)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	GetImportCandidates func(ctx *fiber.Ctx) error
+
+	GetImportCandidatesRequest struct {
+		Status string `query:"status" validate:"omitempty,oneof=pending confirmed discarded"`
+	}
+
+	ImportCandidateResponse struct {
+		ID         int        `json:"id"`
+		Source     string     `json:"source"`
+		Name       string     `json:"name"`
+		Amount     float32    `json:"amount"`
+		Date       civil.Date `json:"date"`
+		CategoryID *int       `json:"category_id"`
+		Status     string     `json:"status"`
+		ExpenseID  *int       `json:"expense_id,omitempty"`
+		ImportedBy int        `json:"imported_by"`
+		CreatedAt  time.Time  `json:"created_at"`
+		Version    int        `json:"version"`
+	}
+)
+
+// NewGetImportCandidates lists the review queue of the group, or the candidates already
+// reviewed when asked for another status.
+func NewGetImportCandidates(getImportCandidates usecase.GetImportCandidates) GetImportCandidates {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		var req GetImportCandidatesRequest
+		if err := ctx.QueryParser(&req); err != nil {
+			return except.BadRequestError("invalid query params").SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid query params").SetInternal(err)
+		}
+
+		candidates, err := getImportCandidates(ctx.Context(), usecase.GetImportCandidatesParams{
+			GroupID: group.ID{Value: groupID},
+			Status:  expense.ImportCandidateStatus(req.Status),
+		})
+		if err != nil {
+			return fmt.Errorf("GetImportCandidates: %w", err)
+		}
+
+		response := make([]ImportCandidateResponse, 0, len(candidates))
+		for _, candidate := range candidates {
+			response = append(response, toImportCandidateResponse(&candidate))
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, response),
+		)
+	}
+}
+
+func importCandidateParams(ctx *fiber.Ctx) (expense.ImportCandidateID, group.ID, error) {
+	candidateID, err := strconv.Atoi(ctx.Params("candidate_id"))
+	if err != nil {
+		return expense.ImportCandidateID{}, group.ID{}, except.BadRequestError("invalid import candidate id")
+	}
+
+	groupID, ok := ctx.Locals("group_id").(int)
+	if !ok {
+		return expense.ImportCandidateID{}, group.ID{}, except.UnprocessableEntityError("group_id not found in context")
+	}
+
+	return expense.ImportCandidateID{Value: candidateID}, group.ID{Value: groupID}, nil
+}
+
+func toImportCandidateResponse(candidate *expense.ImportCandidate) ImportCandidateResponse {
+	var categoryID *int
+	if candidate.CategoryID != nil {
+		categoryID = &candidate.CategoryID.Value
+	}
+
+	var expenseID *int
+	if candidate.ExpenseID != nil {
+		expenseID = &candidate.ExpenseID.Value
+	}
+
+	return ImportCandidateResponse{
+		ID:         candidate.ID.Value,
+		Source:     candidate.Source,
+		Name:       candidate.Name,
+		Amount:     float32(candidate.Amount) / 100,
+		Date:       candidate.Date,
+		CategoryID: categoryID,
+		Status:     string(candidate.Status),
+		ExpenseID:  expenseID,
+		ImportedBy: candidate.ImportedBy.Value,
+		CreatedAt:  candidate.CreatedAt,
+		Version:    candidate.Version,
+	}
+}
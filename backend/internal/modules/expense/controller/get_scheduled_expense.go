@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type GetScheduledExpense func(ctx *fiber.Ctx) error
+
+func NewGetScheduledExpense(getScheduledExpense usecase.GetScheduledExpense) GetScheduledExpense {
+	return func(ctx *fiber.Ctx) error {
+		params, err := scheduledExpenseParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		scheduledExpense, err := getScheduledExpense(ctx.Context(), params)
+		if err != nil {
+			return fmt.Errorf("GetScheduledExpense: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toScheduledExpenseResponse(scheduledExpense)),
+		)
+	}
+}
+
+// scheduledExpenseParams reads the scheduled expense id from the path and the group from the authenticated user.
+func scheduledExpenseParams(ctx *fiber.Ctx) (usecase.ScheduledExpenseParams, error) {
+	scheduledExpenseID, err := strconv.Atoi(ctx.Params("scheduled_expense_id"))
+	if err != nil {
+		return usecase.ScheduledExpenseParams{}, except.BadRequestError("invalid scheduled expense id")
+	}
+
+	groupID, ok := ctx.Locals("group_id").(int)
+	if !ok {
+		return usecase.ScheduledExpenseParams{}, except.UnprocessableEntityError("group_id not found in context")
+	}
+
+	return usecase.ScheduledExpenseParams{
+		ID:      expense.ScheduledExpenseID{Value: scheduledExpenseID},
+		GroupID: group.ID{Value: groupID},
+	}, nil
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetScheduledExpenses func(ctx *fiber.Ctx) error
+
+	RecurrenceResponse struct {
+		Frequency   string      `json:"frequency"`
+		Interval    int         `json:"interval"`
+		DayOfMonth  int         `json:"day_of_month,omitempty"`
+		Weekday     *int        `json:"weekday,omitempty"`
+		Month       int         `json:"month,omitempty"`
+		BusinessDay string      `json:"business_day,omitempty"`
+		StartDate   *civil.Date `json:"start_date,omitempty"`
+		EndDate     *civil.Date `json:"end_date,omitempty"`
+		MaxCount    int         `json:"max_count,omitempty"`
+	}
+
+	ScheduledExpenseResponse struct {
+		ID              int                 `json:"id"`
+		Name            string              `json:"name"`
+		Amount          float32             `json:"amount"`
+		Description     string              `json:"description"`
+		CategoryID      int                 `json:"category_id"`
+		SplitType       string              `json:"split_type"`
+		PayerID         int                 `json:"payer_id"`
+		ReceiverID      int                 `json:"receiver_id"`
+		FrequencyInDays int                 `json:"frequency_in_days,omitempty"`
+		Recurrence      *RecurrenceResponse `json:"recurrence,omitempty"`
+		LastGeneratedAt *civil.Date         `json:"last_generated_at"`
+		OccurrenceCount int                 `json:"occurrence_count"`
+		IsActive        bool                `json:"is_active"`
+		CreatedAt       time.Time           `json:"created_at"`
+		UpdatedAt       time.Time           `json:"updated_at"`
+	}
+)
+
+func NewGetScheduledExpenses(getScheduledExpenses usecase.GetScheduledExpenses) GetScheduledExpenses {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		scheduledExpenses, err := getScheduledExpenses(ctx.Context(), group.ID{Value: groupID})
+		if err != nil {
+			return fmt.Errorf("GetScheduledExpenses: %w", err)
+		}
+
+		response := make([]ScheduledExpenseResponse, 0, len(scheduledExpenses))
+		for _, scheduledExpense := range scheduledExpenses {
+			response = append(response, toScheduledExpenseResponse(&scheduledExpense))
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, response),
+		)
+	}
+}
+
+func toScheduledExpenseResponse(se *expense.ScheduledExpense) ScheduledExpenseResponse {
+	var recurrence *RecurrenceResponse
+	if se.Recurrence != nil {
+		var weekday *int
+		if se.Recurrence.Weekday != nil {
+			wd := int(*se.Recurrence.Weekday)
+			weekday = &wd
+		}
+
+		recurrence = &RecurrenceResponse{
+			Frequency:   se.Recurrence.Frequency.String(),
+			Interval:    se.Recurrence.Interval,
+			DayOfMonth:  se.Recurrence.DayOfMonth,
+			Weekday:     weekday,
+			Month:       int(se.Recurrence.Month),
+			BusinessDay: se.Recurrence.BusinessDay.String(),
+			StartDate:   se.Recurrence.StartDate,
+			EndDate:     se.Recurrence.EndDate,
+			MaxCount:    se.Recurrence.MaxCount,
+		}
+	}
+
+	return ScheduledExpenseResponse{
+		ID:              se.ID.Value,
+		Name:            se.Name,
+		Amount:          float32(se.Amount) / 100,
+		Description:     se.Description,
+		CategoryID:      se.CategoryID.Value,
+		SplitType:       se.SplitType.String(),
+		PayerID:         se.PayerID.Value,
+		ReceiverID:      se.ReceiverID.Value,
+		FrequencyInDays: se.FrequencyInDays,
+		Recurrence:      recurrence,
+		LastGeneratedAt: se.LastGeneratedAt,
+		OccurrenceCount: se.OccurrenceCount,
+		IsActive:        se.IsActive,
+		CreatedAt:       se.CreatedAt,
+		UpdatedAt:       se.UpdatedAt,
+	}
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetSpendingForecast func(ctx *fiber.Ctx) error
+
+	CategoryGroupForecastResponse struct {
+		CategoryGroupID int `json:"category_group_id"`
+		Spent           int `json:"spent"`
+		Scheduled       int `json:"scheduled"`
+		Discretionary   int `json:"discretionary"`
+		Projected       int `json:"projected"`
+	}
+
+	SpendingForecastResponse struct {
+		Month            string                          `json:"month"`
+		CategoryGroups   []CategoryGroupForecastResponse `json:"category_groups"`
+		Spent            int                             `json:"spent"`
+		Projected        int                             `json:"projected"`
+		Incomes          int                             `json:"incomes"`
+		ProjectedSavings int                             `json:"projected_savings"`
+	}
+)
+
+func NewGetSpendingForecast(getSpendingForecast usecase.GetSpendingForecast, getExpensesPerPeriod postgres.GetExpensesPerPeriod) GetSpendingForecast {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		now := time.Now()
+		month := expense.MonthOf(now)
+		expensesPerPeriod, err := getExpensesPerPeriod(ctx.Context(), postgres.GetExpensesPerPeriodInput{
+			GroupID:   groupID,
+			Aggregate: "month",
+			StartDate: month.AddDate(0, -expense.ForecastTrailingMonths, 0),
+			// Up to the end of the month, so expenses recorded ahead, as the next installments of a
+			// purchase, count as spent
+			EndDate:     month.AddDate(0, 1, 0).Add(-time.Microsecond),
+			PerCategory: true,
+		})
+		if err != nil {
+			return fmt.Errorf("query.GetExpensesPerPeriod: %w", err)
+		}
+
+		spending := make([]usecase.CategorySpending, 0, len(expensesPerPeriod))
+		for _, period := range expensesPerPeriod {
+			periodMonth, err := time.Parse("2006-01", period.Date)
+			if err != nil {
+				return fmt.Errorf("time.Parse: %w", err)
+			}
+
+			spending = append(spending, usecase.CategorySpending{
+				Month:           periodMonth,
+				CategoryID:      category.ID{Value: period.CategoryID},
+				CategoryGroupID: category.GroupID{Value: period.CategoryGroupID},
+				Amount:          period.Amount,
+			})
+		}
+
+		forecast, err := getSpendingForecast(ctx.Context(), usecase.GetSpendingForecastParams{
+			GroupID:  group.ID{Value: groupID},
+			Date:     now,
+			Spending: spending,
+		})
+		if err != nil {
+			return fmt.Errorf("GetSpendingForecast: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, toSpendingForecastResponse(forecast)))
+	}
+}
+
+func toSpendingForecastResponse(forecast *expense.SpendingForecast) SpendingForecastResponse {
+	response := SpendingForecastResponse{
+		Month:            forecast.Month.Format("2006-01"),
+		CategoryGroups:   make([]CategoryGroupForecastResponse, 0, len(forecast.CategoryGroups)),
+		Spent:            forecast.Spent(),
+		Projected:        forecast.Projected(),
+		Incomes:          forecast.Incomes,
+		ProjectedSavings: forecast.ProjectedSavings(),
+	}
+
+	for _, categoryGroup := range forecast.CategoryGroups {
+		response.CategoryGroups = append(response.CategoryGroups, CategoryGroupForecastResponse{
+			CategoryGroupID: categoryGroup.CategoryGroupID.Value,
+			Spent:           categoryGroup.Spent,
+			Scheduled:       categoryGroup.Scheduled,
+			Discretionary:   categoryGroup.Discretionary,
+			Projected:       categoryGroup.Projected,
+		})
+	}
+
+	return response
+}
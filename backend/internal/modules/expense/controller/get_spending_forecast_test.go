@@ -0,0 +1,130 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestGetSpendingForecast(t *testing.T) {
+	t.Parallel()
+
+	month := expense.MonthOf(time.Now())
+	expensesPerPeriod := []postgres.ExpensesPerPeriod{
+		{Date: month.AddDate(0, -1, 0).Format("2006-01"), CategoryID: 2, CategoryGroupID: 1, Amount: 9000, Count: 3},
+		{Date: month.Format("2006-01"), CategoryID: 2, CategoryGroupID: 1, Amount: 4000, Count: 1},
+	}
+	forecast := &expense.SpendingForecast{
+		Month: month,
+		CategoryGroups: []expense.CategoryGroupForecast{
+			{CategoryGroupID: category.GroupID{Value: 1}, Spent: 4000, Scheduled: 150000, Discretionary: 1000, Projected: 155000},
+		},
+		Incomes: 500000,
+	}
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name              string
+		mockSetup         func(getSpendingForecast *mocks.MockusecaseGetSpendingForecast)
+		expensesPerPeriod []postgres.ExpensesPerPeriod
+		mockError         error
+		expectedStatus    int
+		expectedResponse  string
+		customAssertions  func(t *testing.T, body []byte)
+	}{
+		{
+			name: "should project the spending of the month",
+			mockSetup: func(getSpendingForecast *mocks.MockusecaseGetSpendingForecast) {
+				getSpendingForecast.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.GetSpendingForecastParams) bool {
+					return p.GroupID == group.ID{Value: 1} && assert.ObjectsAreEqual([]usecase.CategorySpending{
+						{Month: month.AddDate(0, -1, 0), CategoryID: category.ID{Value: 2}, CategoryGroupID: category.GroupID{Value: 1}, Amount: 9000},
+						{Month: month, CategoryID: category.ID{Value: 2}, CategoryGroupID: category.GroupID{Value: 1}, Amount: 4000},
+					}, p.Spending)
+				})).Return(forecast, nil).Once()
+			},
+			expensesPerPeriod: expensesPerPeriod,
+			expectedStatus:    200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.SpendingForecastResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, month.Format("2006-01"), response.Data.Month)
+				assert.Equal(t, []controller.CategoryGroupForecastResponse{
+					{CategoryGroupID: 1, Spent: 4000, Scheduled: 150000, Discretionary: 1000, Projected: 155000},
+				}, response.Data.CategoryGroups)
+				assert.Equal(t, 4000, response.Data.Spent)
+				assert.Equal(t, 155000, response.Data.Projected)
+				assert.Equal(t, 500000, response.Data.Incomes)
+				assert.Equal(t, 345000, response.Data.ProjectedSavings)
+			},
+		},
+		{
+			name:             "should return 500 if the expenses per period fail",
+			mockSetup:        func(getSpendingForecast *mocks.MockusecaseGetSpendingForecast) {}, // Não precisa de mock para este caso
+			mockError:        errors.New("database error"),
+			expectedStatus:   500,
+			expectedResponse: `{"status_code":500,"message":"Internal Server Error","error":"query.GetExpensesPerPeriod: database error"}`,
+		},
+		{
+			name: "should return 500 if the forecast fails",
+			mockSetup: func(getSpendingForecast *mocks.MockusecaseGetSpendingForecast) {
+				getSpendingForecast.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, errors.New("test error")).Once()
+			},
+			expensesPerPeriod: expensesPerPeriod,
+			expectedStatus:    500,
+			expectedResponse:  `{"status_code":500,"message":"Internal Server Error","error":"GetSpendingForecast: test error"}`,
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getSpendingForecast := mocks.NewMockusecaseGetSpendingForecast(t)
+			tc.mockSetup(getSpendingForecast)
+			mockGetExpensesPerPeriod := &MockGetExpensesPerPeriod{
+				ExpensesPerPeriod: tc.expensesPerPeriod,
+				Error:             tc.mockError,
+			}
+
+			// Setup comum para todos os testes
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/forecast", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewGetSpendingForecast(getSpendingForecast.Execute, mockGetExpensesPerPeriod.Execute))
+
+			req := httptest.NewRequest("GET", "http://localhost:8080/forecast", nil)
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			// Despesas lançadas para os próximos dias do mês também contam
+			assert.Equal(t, month.AddDate(0, 1, 0).Add(-time.Microsecond), mockGetExpensesPerPeriod.Input.EndDate)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			}
+			if tc.customAssertions != nil {
+				tc.customAssertions(t, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/bankstatement"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	ImportStatement func(ctx *fiber.Ctx) error
+
+	ImportStatementRequest struct {
+		Layout string `form:"layout" validate:"required,oneof=ofx nubank itau inter"`
+	}
+
+	ImportStatementResponse struct {
+		Candidates []ImportCandidateResponse `json:"candidates"`
+		Duplicated int                       `json:"duplicated"`
+		Ignored    int                       `json:"ignored"`
+	}
+)
+
+// NewImportStatement reads a statement sent as the "file" field of a multipart form.
+func NewImportStatement(importStatement usecase.ImportStatement) ImportStatement {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		userID, ok := ctx.Locals("user_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("user_id not found in context")
+		}
+
+		var req ImportStatementRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		header, err := ctx.FormFile("file")
+		if err != nil {
+			return except.BadRequestError("missing statement file").SetInternal(err)
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			return fmt.Errorf("header.Open: %w", err)
+		}
+		defer file.Close()
+
+		result, err := importStatement(ctx.Context(), usecase.ImportStatementParams{
+			GroupID:   group.ID{Value: groupID},
+			UserID:    user.ID{Value: userID},
+			Layout:    bankstatement.Layout(req.Layout),
+			Statement: file,
+		})
+		if err != nil {
+			return fmt.Errorf("ImportStatement: %w", err)
+		}
+
+		response := ImportStatementResponse{
+			Candidates: make([]ImportCandidateResponse, 0, len(result.Candidates)),
+			Duplicated: result.Duplicated,
+			Ignored:    result.Ignored,
+		}
+		for _, candidate := range result.Candidates {
+			response.Candidates = append(response.Candidates, toImportCandidateResponse(&candidate))
+		}
+
+		return ctx.Status(http.StatusCreated).JSON(
+			api.NewResponse(http.StatusCreated, response),
+		)
+	}
+}
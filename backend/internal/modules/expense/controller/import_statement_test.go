@@ -0,0 +1,223 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/bankstatement"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestImportStatementHandler(t *testing.T) {
+	t.Parallel()
+
+	candidate, _ := expense.NewImportCandidate(expense.ImportCandidateAttributes{
+		ID:         expense.ImportCandidateID{Value: 1},
+		GroupID:    group.ID{Value: 1},
+		Source:     "nubank",
+		ExternalID: "abc",
+		Name:       "Padaria",
+		Amount:     5290,
+		Date:       civil.Date{Year: 2025, Month: 1, Day: 7},
+		CategoryID: &category.ID{Value: 3},
+		ImportedBy: user.ID{Value: 7},
+	})
+
+	statement := "date,title,amount\n2025-01-07,Padaria,52.90\n"
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		layout           string
+		file             string
+		mockSetup        func(uc *mocks.MockusecaseImportStatement)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:   "should return 201 with the queued candidates",
+			layout: "nubank",
+			file:   statement,
+			mockSetup: func(uc *mocks.MockusecaseImportStatement) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.ImportStatementParams) bool {
+					content, _ := io.ReadAll(p.Statement)
+					return p.GroupID.Value == 1 && p.UserID.Value == 7 && p.Layout == bankstatement.Layouts.Nubank && string(content) == statement
+				})).Return(&usecase.ImportStatementResult{Candidates: []expense.ImportCandidate{*candidate}, Duplicated: 2, Ignored: 1}, nil).Once()
+			},
+			expectedStatus: 201,
+		},
+		{
+			name:             "should return 400 if layout is unknown",
+			layout:           "bradesco",
+			file:             statement,
+			mockSetup:        func(uc *mocks.MockusecaseImportStatement) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [Layout]: 'bradesco' | Needs to implement 'oneof'"}`,
+		},
+		{
+			name:           "should return 400 if file is missing",
+			layout:         "nubank",
+			mockSetup:      func(uc *mocks.MockusecaseImportStatement) {}, // Não precisa de mock para este caso
+			expectedStatus: 400,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	importStatement := mocks.NewMockusecaseImportStatement(t)
+	app.Post("/expenses/imports", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		c.Locals("user_id", 7)
+		return c.Next()
+	}, controller.NewImportStatement(importStatement.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(importStatement)
+
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			assert.NoError(t, form.WriteField("layout", tc.layout))
+			if tc.file != "" {
+				part, err := form.CreateFormFile("file", "statement.csv")
+				assert.NoError(t, err)
+				_, err = part.Write([]byte(tc.file))
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, form.Close())
+
+			req := httptest.NewRequest("POST", "/expenses/imports", &body)
+			req.Header.Set("Content-Type", form.FormDataContentType())
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(respBody))
+			} else if tc.expectedStatus == 201 {
+				var response api.Response[controller.ImportStatementResponse]
+				assert.Nil(t, json.Unmarshal(respBody, &response))
+				assert.Equal(t, 2, response.Data.Duplicated)
+				assert.Equal(t, 1, response.Data.Ignored)
+				assert.Len(t, response.Data.Candidates, 1)
+				assert.Equal(t, float32(52.9), response.Data.Candidates[0].Amount)
+				assert.Equal(t, 3, *response.Data.Candidates[0].CategoryID)
+				assert.Equal(t, "pending", response.Data.Candidates[0].Status)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
+
+func TestConfirmImportCandidateHandler(t *testing.T) {
+	t.Parallel()
+
+	created, _ := expense.New(expense.Attributes{
+		ID:         expense.ID{Value: 10},
+		Name:       "Padaria",
+		Amount:     5290,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 3},
+		SplitRatio: expense.NewEqualSplitRatio(user.ID{Value: 7}, user.ID{Value: 8}),
+		PayerID:    user.ID{Value: 7},
+		ReceiverID: user.ID{Value: 8},
+	})
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		candidateID      string
+		body             string
+		mockSetup        func(uc *mocks.MockusecaseConfirmImportCandidate)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "should return 201 without a body",
+			candidateID: "1",
+			mockSetup: func(uc *mocks.MockusecaseConfirmImportCandidate) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.ConfirmImportCandidateParams) bool {
+					return p.ID.Value == 1 && p.GroupID.Value == 1 && p.CategoryID == nil && p.PayerID == nil && p.UserID.Value == 7
+				})).Return(&usecase.CreateExpenseResult{Expense: created}, nil).Once()
+			},
+			expectedStatus: 201,
+		},
+		{
+			name:        "should return 201 completing the expense",
+			candidateID: "1",
+			body:        `{"category_id":4,"split_type":"equal","payer_id":8,"receiver_id":7}`,
+			mockSetup: func(uc *mocks.MockusecaseConfirmImportCandidate) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.ConfirmImportCandidateParams) bool {
+					return p.CategoryID.Value == 4 && p.SplitType == expense.SplitTypes.Equal && p.PayerID.Value == 8 && p.ReceiverID.Value == 7
+				})).Return(&usecase.CreateExpenseResult{Expense: created}, nil).Once()
+			},
+			expectedStatus: 201,
+		},
+		{
+			name:             "should return 400 if candidate id is invalid",
+			candidateID:      "abc",
+			mockSetup:        func(uc *mocks.MockusecaseConfirmImportCandidate) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid import candidate id","error":"invalid import candidate id"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	confirmImportCandidate := mocks.NewMockusecaseConfirmImportCandidate(t)
+	app.Post("/expenses/imports/:candidate_id/confirm", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		c.Locals("user_id", 7)
+		return c.Next()
+	}, controller.NewConfirmImportCandidate(confirmImportCandidate.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(confirmImportCandidate)
+
+			req := httptest.NewRequest("POST", "/expenses/imports/"+tc.candidateID+"/confirm", bytes.NewBufferString(tc.body))
+			if tc.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			} else {
+				var response api.Response[controller.CreateExpenseResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 10, response.Data.ID)
+				assert.Equal(t, float32(52.9), response.Data.Amount)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
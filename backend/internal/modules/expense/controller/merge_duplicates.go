@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	MergeDuplicates func(ctx *fiber.Ctx) error
+
+	// MergeDuplicatesRequest keeps one expense of a duplicate cluster, sending the duplicates of
+	// it to the trash.
+	MergeDuplicatesRequest struct {
+		KeepID       int   `json:"keep_id" validate:"required,gt=0"`
+		DuplicateIDs []int `json:"duplicate_ids" validate:"required,min=1,unique,dive,gt=0"`
+	}
+
+	MergeDuplicatesResponse struct {
+		ID           int   `json:"id"`
+		DuplicateIDs []int `json:"duplicate_ids"`
+	}
+)
+
+func NewMergeDuplicates(mergeDuplicates usecase.MergeDuplicates) MergeDuplicates {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var req MergeDuplicatesRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		kept, err := mergeDuplicates(ctx.Context(), usecase.MergeDuplicatesParams{
+			GroupID:      group.ID{Value: groupID},
+			KeepID:       expense.ID{Value: req.KeepID},
+			DuplicateIDs: toExpenseIDs(req.DuplicateIDs),
+			UserID:       currentUserID(ctx),
+		})
+		if err != nil {
+			return fmt.Errorf("MergeDuplicates: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, MergeDuplicatesResponse{
+				ID:           kept.ID.Value,
+				DuplicateIDs: req.DuplicateIDs,
+			}),
+		)
+	}
+}
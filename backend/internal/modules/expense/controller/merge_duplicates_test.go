@@ -0,0 +1,163 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestMergeDuplicatesHandler(t *testing.T) {
+	t.Parallel()
+
+	kept := &expense.Expense{Name: "Mercado", Amount: 15890}
+	kept.ID = expense.ID{Value: 1}
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		body             string
+		mockSetup        func(uc *mocks.MockusecaseMergeDuplicates)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "should return 200 keeping one expense",
+			body: `{"keep_id":1,"duplicate_ids":[2,3]}`,
+			mockSetup: func(uc *mocks.MockusecaseMergeDuplicates) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.MergeDuplicatesParams) bool {
+					return p.GroupID.Value == 1 &&
+						p.KeepID.Value == 1 &&
+						len(p.DuplicateIDs) == 2 &&
+						p.UserID.Value == 7
+				})).Return(kept, nil).Once()
+			},
+			expectedStatus: 200,
+		},
+		{
+			name:             "should return 400 if there are no duplicates",
+			body:             `{"keep_id":1,"duplicate_ids":[]}`,
+			mockSetup:        func(uc *mocks.MockusecaseMergeDuplicates) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [DuplicateIDs]: '[]' | Needs to implement 'min'"}`,
+		},
+		{
+			name: "should return 404 if an expense is not found",
+			body: `{"keep_id":1,"duplicate_ids":[2]}`,
+			mockSetup: func(uc *mocks.MockusecaseMergeDuplicates) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.NotFoundError("expense 2 not found")).Once()
+			},
+			expectedStatus:   404,
+			expectedResponse: `{"status_code":404,"message":"expense 2 not found","error":"MergeDuplicates: expense 2 not found"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	mergeDuplicates := mocks.NewMockusecaseMergeDuplicates(t)
+	app.Post("/expenses/duplicates/merge", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		c.Locals("user_id", 7)
+		return c.Next()
+	}, controller.NewMergeDuplicates(mergeDuplicates.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(mergeDuplicates)
+
+			req := httptest.NewRequest("POST", "/expenses/duplicates/merge", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(respBody))
+			} else {
+				var response api.Response[controller.MergeDuplicatesResponse]
+				assert.Nil(t, json.Unmarshal(respBody, &response))
+				assert.Equal(t, 1, response.Data.ID)
+				assert.Equal(t, []int{2, 3}, response.Data.DuplicateIDs)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
+
+func TestDismissDuplicatesHandler(t *testing.T) {
+	t.Parallel()
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		body             string
+		mockSetup        func(uc *mocks.MockusecaseDismissDuplicates)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "should return 200 dismissing the expenses",
+			body: `{"expense_ids":[1,2]}`,
+			mockSetup: func(uc *mocks.MockusecaseDismissDuplicates) {
+				uc.EXPECT().Execute(mock.Anything, usecase.DismissDuplicatesParams{
+					GroupID:    group.ID{Value: 1},
+					ExpenseIDs: []expense.ID{{Value: 1}, {Value: 2}},
+				}).Return(nil).Once()
+			},
+			expectedStatus: 200,
+		},
+		{
+			name:             "should return 400 if an expense is repeated",
+			body:             `{"expense_ids":[1,1]}`,
+			mockSetup:        func(uc *mocks.MockusecaseDismissDuplicates) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [ExpenseIDs]: '[1 1]' | Needs to implement 'unique'"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	dismissDuplicates := mocks.NewMockusecaseDismissDuplicates(t)
+	app.Post("/expenses/duplicates/dismiss", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		return c.Next()
+	}, controller.NewDismissDuplicates(dismissDuplicates.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(dismissDuplicates)
+
+			req := httptest.NewRequest("POST", "/expenses/duplicates/dismiss", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedResponse != "" {
+				respBody, err := io.ReadAll(resp.Body)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.expectedResponse, string(respBody))
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
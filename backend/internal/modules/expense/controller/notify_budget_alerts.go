@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
+)
+
+type NotifyBudgetAlerts func(ctx context.Context) error
+
+func NewNotifyBudgetAlerts(
+	subscriber pubsub.Subscriber,
+	notifyBudgetAlert usecase.NotifyBudgetAlert,
+) NotifyBudgetAlerts {
+	return func(ctx context.Context) error {
+		messages, err := subscriber.Subscribe(ctx, pubsub.BudgetsTopic)
+		if err != nil {
+			return fmt.Errorf("subscriber.Subscribe: %w", err)
+		}
+
+		go func() {
+			slog.InfoContext(ctx, "Listening to budgets topic...")
+			for msg := range messages {
+				var payload pubsub.BudgetAlertEvent
+				if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+					msg.Nack()
+					continue
+				}
+
+				if err := notifyBudgetAlert(ctx, usecase.NotifyBudgetAlertInput{
+					GroupID: payload.GroupID,
+					Alert:   payload.Alert,
+				}); err != nil {
+					slog.ErrorContext(ctx, "failed to notify budget alert", "error", err)
+					msg.Nack()
+					continue
+				}
+
+				msg.Ack()
+			}
+		}()
+
+		return nil
+	}
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+type PauseScheduledExpense func(ctx *fiber.Ctx) error
+
+func NewPauseScheduledExpense(pauseScheduledExpense usecase.PauseScheduledExpense) PauseScheduledExpense {
+	return func(ctx *fiber.Ctx) error {
+		params, err := scheduledExpenseParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		scheduledExpense, err := pauseScheduledExpense(ctx.Context(), params)
+		if err != nil {
+			return fmt.Errorf("PauseScheduledExpense: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toScheduledExpenseResponse(scheduledExpense)),
+		)
+	}
+}
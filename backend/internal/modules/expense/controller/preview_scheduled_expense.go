@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+const (
+	defaultPreviewCount = 5
+	maxPreviewCount     = 24
+)
+
+type (
+	PreviewScheduledExpense func(ctx *fiber.Ctx) error
+
+	PreviewScheduledExpenseResponse struct {
+		ID          int          `json:"id"`
+		Occurrences []civil.Date `json:"occurrences"`
+	}
+)
+
+func NewPreviewScheduledExpense(previewScheduledExpense usecase.PreviewScheduledExpense) PreviewScheduledExpense {
+	return func(ctx *fiber.Ctx) error {
+		params, err := scheduledExpenseParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		count := ctx.QueryInt("count", defaultPreviewCount)
+		if count < 1 || count > maxPreviewCount {
+			return except.BadRequestError(fmt.Sprintf("count must be between 1 and %d", maxPreviewCount))
+		}
+
+		occurrences, err := previewScheduledExpense(ctx.Context(), usecase.PreviewScheduledExpenseParams{
+			ScheduledExpenseParams: params,
+			Count:                  count,
+		})
+		if err != nil {
+			return fmt.Errorf("PreviewScheduledExpense: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, PreviewScheduledExpenseResponse{
+				ID:          params.ID.Value,
+				Occurrences: occurrences,
+			}),
+		)
+	}
+}
@@ -0,0 +1,112 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestPreviewScheduledExpenseHandler(t *testing.T) {
+	t.Parallel()
+
+	occurrences := []civil.Date{
+		{Year: 2025, Month: time.February, Day: 5},
+		{Year: 2025, Month: time.March, Day: 5},
+	}
+
+	testCases := []struct {
+		name             string
+		query            string
+		mockSetup        func(uc *mocks.MockusecasePreviewScheduledExpense)
+		expectedStatus   int
+		expectedResponse string
+		customAssertions func(t *testing.T, body []byte)
+	}{
+		{
+			name: "should return 200 and the next occurrences, five by default",
+			mockSetup: func(uc *mocks.MockusecasePreviewScheduledExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.PreviewScheduledExpenseParams) bool {
+					return p.ID.Value == 1 && p.GroupID.Value == 1 && p.Count == 5
+				})).Return(occurrences, nil).Once()
+			},
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.PreviewScheduledExpenseResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 1, response.Data.ID)
+				assert.Equal(t, occurrences, response.Data.Occurrences)
+			},
+		},
+		{
+			name:  "should use the given count",
+			query: "?count=2",
+			mockSetup: func(uc *mocks.MockusecasePreviewScheduledExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.PreviewScheduledExpenseParams) bool {
+					return p.Count == 2
+				})).Return(occurrences, nil).Once()
+			},
+			expectedStatus: 200,
+		},
+		{
+			name:             "should return 400 if count is out of range",
+			query:            "?count=100",
+			mockSetup:        func(uc *mocks.MockusecasePreviewScheduledExpense) {},
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"count must be between 1 and 24","error":"count must be between 1 and 24"}`,
+		},
+		{
+			name: "should return 404 if the scheduled expense is not found",
+			mockSetup: func(uc *mocks.MockusecasePreviewScheduledExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.NotFoundError("scheduled expense not found")).Once()
+			},
+			expectedStatus:   404,
+			expectedResponse: `{"status_code":404,"message":"scheduled expense not found","error":"PreviewScheduledExpense: scheduled expense not found"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+
+	previewScheduledExpense := mocks.NewMockusecasePreviewScheduledExpense(t)
+	app.Get("/scheduled/:scheduled_expense_id/preview", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		return c.Next()
+	}, controller.NewPreviewScheduledExpense(previewScheduledExpense.Execute))
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(previewScheduledExpense)
+
+			req := httptest.NewRequest(http.MethodGet, "http://localhost:8080/scheduled/1/preview"+tc.query, nil)
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.customAssertions != nil {
+				tc.customAssertions(t, body)
+			} else if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	nossasdespesas "github.com/Beigelman/nossas-despesas"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+)
+
+type PurgeDeletedExpensesJob func(ctx context.Context) error
+
+func NewPurgeDeletedExpensesJob(purgeDeletedExpenses usecase.PurgeDeletedExpenses, cfg *nossasdespesas.Config) PurgeDeletedExpensesJob {
+	return func(ctx context.Context) error {
+		purged, err := purgeDeletedExpenses(ctx, time.Now().Add(-cfg.TrashRetention()))
+		if err != nil {
+			return fmt.Errorf("PurgeDeletedExpenses: %w", err)
+		}
+
+		slog.InfoContext(ctx, "Deleted expenses purged", "count", purged)
+
+		return nil
+	}
+}
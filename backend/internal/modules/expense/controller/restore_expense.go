@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type RestoreExpense func(ctx *fiber.Ctx) error
+
+func NewRestoreExpense(restoreExpense usecase.RestoreExpense) RestoreExpense {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		expenseID, err := strconv.Atoi(ctx.Params("expense_id"))
+		if err != nil {
+			return except.BadRequestError("invalid expense id")
+		}
+
+		expns, err := restoreExpense(ctx.Context(), usecase.RestoreExpenseParams{
+			ID:      expense.ID{Value: expenseID},
+			GroupID: group.ID{Value: groupID},
+			UserID:  currentUserID(ctx),
+		})
+		if err != nil {
+			return fmt.Errorf("RestoreExpense: %w", err)
+		}
+
+		api.SetETag(ctx, expns.Version)
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, UpdateExpenseResponse{
+				ID:         expns.ID.Value,
+				Name:       expns.Name,
+				Amount:     float32(expns.Amount) / 100,
+				PayerID:    expns.PayerID.Value,
+				ReceiverID: expns.ReceiverID.Value,
+				Version:    expns.Version,
+			}),
+		)
+	}
+}
@@ -0,0 +1,109 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestRestoreExpenseHandler(t *testing.T) {
+	t.Parallel()
+
+	restored, _ := expense.New(expense.Attributes{
+		ID:         expense.ID{Value: 1},
+		Name:       "Mercado",
+		Amount:     100,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 1},
+		SplitRatio: expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+	})
+	restored.Version = 2
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		expenseID        string
+		mockSetup        func(uc *mocks.MockusecaseRestoreExpense)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:      "should return 200 and restore the expense",
+			expenseID: "1",
+			mockSetup: func(uc *mocks.MockusecaseRestoreExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.RestoreExpenseParams) bool {
+					return p.ID.Value == 1 && p.GroupID.Value == 1 && p.UserID.Value == 7
+				})).Return(restored, nil).Once()
+			},
+			expectedStatus: 200,
+		},
+		{
+			name:             "should return 400 if expense id is invalid",
+			expenseID:        "abc",
+			mockSetup:        func(uc *mocks.MockusecaseRestoreExpense) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid expense id","error":"invalid expense id"}`,
+		},
+		{
+			name:      "should return 422 if expense is not deleted",
+			expenseID: "1",
+			mockSetup: func(uc *mocks.MockusecaseRestoreExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.UnprocessableEntityError("expense is not deleted")).Once()
+			},
+			expectedStatus:   422,
+			expectedResponse: `{"status_code":422,"message":"expense is not deleted","error":"RestoreExpense: expense is not deleted"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	restoreExpense := mocks.NewMockusecaseRestoreExpense(t)
+	app.Post("/expenses/:expense_id/restore", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		c.Locals("user_id", 7)
+		return c.Next()
+	}, controller.NewRestoreExpense(restoreExpense.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(restoreExpense)
+
+			req := httptest.NewRequest("POST", "/expenses/"+tc.expenseID+"/restore", nil)
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			} else {
+				var response api.Response[controller.UpdateExpenseResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 2, response.Data.Version)
+				assert.Equal(t, `"2"`, resp.Header.Get("ETag"))
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	RevertExpense func(ctx *fiber.Ctx) error
+
+	RevertExpenseRequest struct {
+		Version *int `json:"version" validate:"required,gte=0"`
+	}
+)
+
+func NewRevertExpense(revertExpense usecase.RevertExpense) RevertExpense {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		expenseID, err := strconv.Atoi(ctx.Params("expense_id"))
+		if err != nil {
+			return except.BadRequestError("invalid expense id")
+		}
+
+		version, err := api.IfMatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		var req RevertExpenseRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		expns, err := revertExpense(ctx.Context(), usecase.RevertExpenseParams{
+			ID:        expense.ID{Value: expenseID},
+			GroupID:   group.ID{Value: groupID},
+			ToVersion: *req.Version,
+			Version:   version,
+			UserID:    currentUserID(ctx),
+		})
+		if err != nil {
+			return fmt.Errorf("RevertExpense: %w", err)
+		}
+
+		api.SetETag(ctx, expns.Version)
+
+		return ctx.Status(http.StatusCreated).JSON(
+			api.NewResponse(http.StatusCreated, UpdateExpenseResponse{
+				ID:         expns.ID.Value,
+				Name:       expns.Name,
+				Amount:     float32(expns.Amount) / 100,
+				PayerID:    expns.PayerID.Value,
+				ReceiverID: expns.ReceiverID.Value,
+				Version:    expns.Version,
+			}),
+		)
+	}
+}
@@ -0,0 +1,122 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestRevertExpenseHandler(t *testing.T) {
+	t.Parallel()
+
+	reverted, _ := expense.New(expense.Attributes{
+		ID:         expense.ID{Value: 1},
+		Name:       "Mercado",
+		Amount:     100,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 1},
+		SplitRatio: expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+	})
+	reverted.Version = 3
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		ifMatch          string
+		body             string
+		mockSetup        func(uc *mocks.MockusecaseRevertExpense)
+		expectedStatus   int
+		expectedResponse string
+		expectedETag     string
+	}{
+		{
+			name:    "should return 201 and revert the expense",
+			ifMatch: `"2"`,
+			body:    `{"version":0}`,
+			mockSetup: func(uc *mocks.MockusecaseRevertExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.RevertExpenseParams) bool {
+					return p.ID.Value == 1 && p.GroupID.Value == 1 && p.ToVersion == 0 &&
+						*p.Version == 2 && p.UserID.Value == 7
+				})).Return(reverted, nil).Once()
+			},
+			expectedStatus: 201,
+			expectedETag:   `"3"`,
+		},
+		{
+			name:             "should return 400 if version is missing",
+			body:             `{}`,
+			mockSetup:        func(uc *mocks.MockusecaseRevertExpense) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [Version]: '\u003cnil\u003e' | Needs to implement 'required'"}`,
+		},
+		{
+			name: "should return 404 if version does not exist",
+			body: `{"version":9}`,
+			mockSetup: func(uc *mocks.MockusecaseRevertExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.NotFoundError("version not found")).Once()
+			},
+			expectedStatus:   404,
+			expectedResponse: `{"status_code":404,"message":"version not found","error":"RevertExpense: version not found"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	revertExpense := mocks.NewMockusecaseRevertExpense(t)
+	app.Post("/expenses/:expense_id/revert", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		c.Locals("user_id", 7)
+		return c.Next()
+	}, controller.NewRevertExpense(revertExpense.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(revertExpense)
+
+			req := httptest.NewRequest("POST", "/expenses/1/revert", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedETag != "" {
+				assert.Equal(t, tc.expectedETag, resp.Header.Get("ETag"))
+			}
+
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			} else {
+				var response api.Response[controller.UpdateExpenseResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 3, response.Data.Version)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
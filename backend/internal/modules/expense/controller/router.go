@@ -16,8 +16,39 @@ func Router(
 	getExpensesPerPeriodHandler GetExpensesPerPeriod,
 	getExpenseDetailsHandler GetExpenseDetails,
 	predictExpenseCategoryHandler PredictExpenseCategory,
-	generateExpensesFromScheduledHandler GenerateExpensesFromScheduled,
 	createScheduledExpenseHandler CreateScheduledExpense,
+	getScheduledExpensesHandler GetScheduledExpenses,
+	getScheduledExpenseHandler GetScheduledExpense,
+	updateScheduledExpenseHandler UpdateScheduledExpense,
+	pauseScheduledExpenseHandler PauseScheduledExpense,
+	resumeScheduledExpenseHandler ResumeScheduledExpense,
+	deleteScheduledExpenseHandler DeleteScheduledExpense,
+	previewScheduledExpenseHandler PreviewScheduledExpense,
+	getExpenseHistoryHandler GetExpenseHistory,
+	revertExpenseHandler RevertExpense,
+	restoreExpenseHandler RestoreExpense,
+	exportExpensesHandler ExportExpenses,
+	importStatementHandler ImportStatement,
+	getImportCandidatesHandler GetImportCandidates,
+	updateImportCandidateHandler UpdateImportCandidate,
+	confirmImportCandidateHandler ConfirmImportCandidate,
+	discardImportCandidateHandler DiscardImportCandidate,
+	getDuplicateClustersHandler GetDuplicateClusters,
+	dismissDuplicatesHandler DismissDuplicates,
+	mergeDuplicatesHandler MergeDuplicates,
+	uploadAttachmentHandler UploadAttachment,
+	getAttachmentsHandler GetAttachments,
+	downloadAttachmentHandler DownloadAttachment,
+	deleteAttachmentHandler DeleteAttachment,
+	getExpensesPerTagHandler GetExpensesPerTag,
+	createBudgetHandler CreateBudget,
+	getBudgetsHandler GetBudgets,
+	updateBudgetHandler UpdateBudget,
+	deleteBudgetHandler DeleteBudget,
+	getSpendingForecastHandler GetSpendingForecast,
+	getCashFlowHandler GetCashFlow,
+	getExpensesPerMemberHandler GetExpensesPerMember,
+	getExpensesComparisonHandler GetExpensesComparison,
 	authMiddleware middleware.AuthMiddleware,
 ) {
 	// Api group
@@ -28,16 +59,50 @@ func Router(
 	expense := v1.Group("expenses")
 	expense.Post("/", authMiddleware, createExpenseHandler)
 	expense.Get("/", authMiddleware, getExpensesHandler)
+	expense.Get("/export", authMiddleware, exportExpensesHandler)
+	// Bank statement imports, queued for review before becoming expenses
+	expense.Post("/imports", authMiddleware, importStatementHandler)
+	expense.Get("/imports", authMiddleware, getImportCandidatesHandler)
+	expense.Patch("/imports/:candidate_id", authMiddleware, updateImportCandidateHandler)
+	expense.Post("/imports/:candidate_id/confirm", authMiddleware, confirmImportCandidateHandler)
+	expense.Post("/imports/:candidate_id/discard", authMiddleware, discardImportCandidateHandler)
+	// Expenses suspected of being entered more than once
+	expense.Get("/duplicates", authMiddleware, getDuplicateClustersHandler)
+	expense.Post("/duplicates/dismiss", authMiddleware, dismissDuplicatesHandler)
+	expense.Post("/duplicates/merge", authMiddleware, mergeDuplicatesHandler)
+	// Monthly budgets per category or category group, compared with what was spent
+	expense.Post("/budgets", authMiddleware, createBudgetHandler)
+	expense.Get("/budgets", authMiddleware, getBudgetsHandler)
+	expense.Patch("/budgets/:budget_id", authMiddleware, updateBudgetHandler)
+	expense.Delete("/budgets/:budget_id", authMiddleware, deleteBudgetHandler)
 	expense.Post("/predict", authMiddleware, predictExpenseCategoryHandler)
 	expense.Get("/:expense_id/details", authMiddleware, getExpenseDetailsHandler)
+	expense.Get("/:expense_id/history", authMiddleware, getExpenseHistoryHandler)
+	expense.Post("/:expense_id/revert", authMiddleware, revertExpenseHandler)
+	expense.Post("/:expense_id/restore", authMiddleware, restoreExpenseHandler)
+	// Receipts and invoices attached to the expense
+	expense.Post("/:expense_id/attachments", authMiddleware, uploadAttachmentHandler)
+	expense.Get("/:expense_id/attachments", authMiddleware, getAttachmentsHandler)
+	expense.Get("/:expense_id/attachments/:attachment_id", authMiddleware, downloadAttachmentHandler)
+	expense.Delete("/:expense_id/attachments/:attachment_id", authMiddleware, deleteAttachmentHandler)
 	expense.Patch("/:expense_id", authMiddleware, updateExpenseHandler)
 	expense.Delete("/:expense_id", authMiddleware, deleteExpenseHandler)
 	expense.Post("/scheduled", authMiddleware, createScheduledExpenseHandler)
-	// Generate expenses from scheduled does not need auth
-	expense.Post("/scheduled/generate", generateExpensesFromScheduledHandler)
+	expense.Get("/scheduled", authMiddleware, getScheduledExpensesHandler)
+	expense.Get("/scheduled/:scheduled_expense_id", authMiddleware, getScheduledExpenseHandler)
+	expense.Get("/scheduled/:scheduled_expense_id/preview", authMiddleware, previewScheduledExpenseHandler)
+	expense.Patch("/scheduled/:scheduled_expense_id", authMiddleware, updateScheduledExpenseHandler)
+	expense.Post("/scheduled/:scheduled_expense_id/pause", authMiddleware, pauseScheduledExpenseHandler)
+	expense.Post("/scheduled/:scheduled_expense_id/resume", authMiddleware, resumeScheduledExpenseHandler)
+	expense.Delete("/scheduled/:scheduled_expense_id", authMiddleware, deleteScheduledExpenseHandler)
 
 	// Expenses insights routes
 	insights := expense.Group("insights", authMiddleware)
 	insights.Get("/", getExpensesPerPeriodHandler)
 	insights.Get("/category", getExpensesPerCategoryHandler)
+	insights.Get("/tag", getExpensesPerTagHandler)
+	insights.Get("/forecast", getSpendingForecastHandler)
+	insights.Get("/cash-flow", getCashFlowHandler)
+	insights.Get("/members", getExpensesPerMemberHandler)
+	insights.Get("/comparison", getExpensesComparisonHandler)
 }
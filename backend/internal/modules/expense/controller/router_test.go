@@ -34,9 +34,40 @@ func TestRouter(t *testing.T) {
 		h("getExpensesPerCategory"),
 		h("getExpensesPerPeriod"),
 		h("getExpenseDetails"),
-		h("generateExpensesFromScheduled"),
 		h("createScheduledExpense"),
 		h("predictExpenseCategory"),
+		h("getScheduledExpenses"),
+		h("getScheduledExpense"),
+		h("updateScheduledExpense"),
+		h("pauseScheduledExpense"),
+		h("resumeScheduledExpense"),
+		h("deleteScheduledExpense"),
+		h("previewScheduledExpense"),
+		h("getExpenseHistory"),
+		h("revertExpense"),
+		h("restoreExpense"),
+		h("exportExpenses"),
+		h("importStatement"),
+		h("getImportCandidates"),
+		h("updateImportCandidate"),
+		h("confirmImportCandidate"),
+		h("discardImportCandidate"),
+		h("getDuplicateClusters"),
+		h("dismissDuplicates"),
+		h("mergeDuplicates"),
+		h("uploadAttachment"),
+		h("getAttachments"),
+		h("downloadAttachment"),
+		h("deleteAttachment"),
+		h("getExpensesPerTag"),
+		h("createBudget"),
+		h("getBudgets"),
+		h("updateBudget"),
+		h("deleteBudget"),
+		h("getSpendingForecast"),
+		h("getCashFlow"),
+		h("getExpensesPerMember"),
+		h("getExpensesComparison"),
 		mockAuthMiddleware,
 	)
 
@@ -49,17 +80,48 @@ func TestRouter(t *testing.T) {
 	// Testa se as rotas básicas de expenses foram registradas
 	assert.Contains(t, paths, "POST /api/v1/expenses/")
 	assert.Contains(t, paths, "GET /api/v1/expenses/")
+	assert.Contains(t, paths, "GET /api/v1/expenses/export")
+	assert.Contains(t, paths, "POST /api/v1/expenses/imports")
+	assert.Contains(t, paths, "GET /api/v1/expenses/imports")
+	assert.Contains(t, paths, "PATCH /api/v1/expenses/imports/:candidate_id")
+	assert.Contains(t, paths, "POST /api/v1/expenses/imports/:candidate_id/confirm")
+	assert.Contains(t, paths, "POST /api/v1/expenses/imports/:candidate_id/discard")
+	assert.Contains(t, paths, "GET /api/v1/expenses/duplicates")
+	assert.Contains(t, paths, "POST /api/v1/expenses/duplicates/dismiss")
+	assert.Contains(t, paths, "POST /api/v1/expenses/duplicates/merge")
+	assert.Contains(t, paths, "POST /api/v1/expenses/budgets")
+	assert.Contains(t, paths, "GET /api/v1/expenses/budgets")
+	assert.Contains(t, paths, "PATCH /api/v1/expenses/budgets/:budget_id")
+	assert.Contains(t, paths, "DELETE /api/v1/expenses/budgets/:budget_id")
 	assert.Contains(t, paths, "GET /api/v1/expenses/:expense_id/details")
+	assert.Contains(t, paths, "POST /api/v1/expenses/:expense_id/attachments")
+	assert.Contains(t, paths, "GET /api/v1/expenses/:expense_id/attachments")
+	assert.Contains(t, paths, "GET /api/v1/expenses/:expense_id/attachments/:attachment_id")
+	assert.Contains(t, paths, "DELETE /api/v1/expenses/:expense_id/attachments/:attachment_id")
+	assert.Contains(t, paths, "GET /api/v1/expenses/:expense_id/history")
+	assert.Contains(t, paths, "POST /api/v1/expenses/:expense_id/revert")
+	assert.Contains(t, paths, "POST /api/v1/expenses/:expense_id/restore")
 	assert.Contains(t, paths, "PATCH /api/v1/expenses/:expense_id")
 	assert.Contains(t, paths, "DELETE /api/v1/expenses/:expense_id")
 
 	// Testa se as rotas de scheduled expenses foram registradas
 	assert.Contains(t, paths, "POST /api/v1/expenses/scheduled")
-	assert.Contains(t, paths, "POST /api/v1/expenses/scheduled/generate")
+	assert.Contains(t, paths, "GET /api/v1/expenses/scheduled")
+	assert.Contains(t, paths, "GET /api/v1/expenses/scheduled/:scheduled_expense_id")
+	assert.Contains(t, paths, "GET /api/v1/expenses/scheduled/:scheduled_expense_id/preview")
+	assert.Contains(t, paths, "PATCH /api/v1/expenses/scheduled/:scheduled_expense_id")
+	assert.Contains(t, paths, "POST /api/v1/expenses/scheduled/:scheduled_expense_id/pause")
+	assert.Contains(t, paths, "POST /api/v1/expenses/scheduled/:scheduled_expense_id/resume")
+	assert.Contains(t, paths, "DELETE /api/v1/expenses/scheduled/:scheduled_expense_id")
 
 	// Testa se as rotas de insights foram registradas
 	assert.Contains(t, paths, "GET /api/v1/expenses/insights/")
 	assert.Contains(t, paths, "GET /api/v1/expenses/insights/category")
+	assert.Contains(t, paths, "GET /api/v1/expenses/insights/tag")
+	assert.Contains(t, paths, "GET /api/v1/expenses/insights/forecast")
+	assert.Contains(t, paths, "GET /api/v1/expenses/insights/cash-flow")
+	assert.Contains(t, paths, "GET /api/v1/expenses/insights/members")
+	assert.Contains(t, paths, "GET /api/v1/expenses/insights/comparison")
 }
 
 func TestRouterAuthMiddleware(t *testing.T) {
@@ -86,8 +148,39 @@ func TestRouterAuthMiddleware(t *testing.T) {
 		h("getExpensesPerPeriod"),
 		h("getExpenseDetails"),
 		h("predictExpenseCategory"),
-		h("generateExpensesFromScheduled"),
 		h("createScheduledExpense"),
+		h("getScheduledExpenses"),
+		h("getScheduledExpense"),
+		h("updateScheduledExpense"),
+		h("pauseScheduledExpense"),
+		h("resumeScheduledExpense"),
+		h("deleteScheduledExpense"),
+		h("previewScheduledExpense"),
+		h("getExpenseHistory"),
+		h("revertExpense"),
+		h("restoreExpense"),
+		h("exportExpenses"),
+		h("importStatement"),
+		h("getImportCandidates"),
+		h("updateImportCandidate"),
+		h("confirmImportCandidate"),
+		h("discardImportCandidate"),
+		h("getDuplicateClusters"),
+		h("dismissDuplicates"),
+		h("mergeDuplicates"),
+		h("uploadAttachment"),
+		h("getAttachments"),
+		h("downloadAttachment"),
+		h("deleteAttachment"),
+		h("getExpensesPerTag"),
+		h("createBudget"),
+		h("getBudgets"),
+		h("updateBudget"),
+		h("deleteBudget"),
+		h("getSpendingForecast"),
+		h("getCashFlow"),
+		h("getExpensesPerMember"),
+		h("getExpensesComparison"),
 		mockAuthMiddleware,
 	)
 
@@ -106,9 +199,13 @@ func TestRouterAuthMiddleware(t *testing.T) {
 		"PATCH /api/v1/expenses/:expense_id",
 		"DELETE /api/v1/expenses/:expense_id",
 		"POST /api/v1/expenses/scheduled",
-		"POST /api/v1/expenses/scheduled/generate",
 		"GET /api/v1/expenses/insights/",
 		"GET /api/v1/expenses/insights/category",
+		"GET /api/v1/expenses/insights/tag",
+		"GET /api/v1/expenses/insights/forecast",
+		"GET /api/v1/expenses/insights/cash-flow",
+		"GET /api/v1/expenses/insights/members",
+		"GET /api/v1/expenses/insights/comparison",
 	}
 
 	actualRoutes := make([]string, len(routes))
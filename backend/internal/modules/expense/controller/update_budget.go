@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	UpdateBudget func(ctx *fiber.Ctx) error
+
+	UpdateBudgetRequest struct {
+		Amount    *int  `json:"amount" validate:"omitempty,gt=0"`
+		CarryOver *bool `json:"carry_over"`
+	}
+)
+
+func NewUpdateBudget(updateBudget usecase.UpdateBudget) UpdateBudget {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		params, err := budgetParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		var req UpdateBudgetRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		budget, err := updateBudget(ctx.Context(), usecase.UpdateBudgetParams{
+			ID:        params.ID,
+			GroupID:   params.GroupID,
+			Amount:    req.Amount,
+			CarryOver: req.CarryOver,
+		})
+		if err != nil {
+			return fmt.Errorf("UpdateBudget: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toBudgetResponse(budget)),
+		)
+	}
+}
+
+func budgetParams(ctx *fiber.Ctx) (usecase.BudgetParams, error) {
+	budgetID, err := strconv.Atoi(ctx.Params("budget_id"))
+	if err != nil {
+		return usecase.BudgetParams{}, except.BadRequestError("invalid budget id")
+	}
+
+	groupID, ok := ctx.Locals("group_id").(int)
+	if !ok {
+		return usecase.BudgetParams{}, except.UnprocessableEntityError("group_id not found in context")
+	}
+
+	return usecase.BudgetParams{
+		ID:      expense.BudgetID{Value: budgetID},
+		GroupID: group.ID{Value: groupID},
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
@@ -21,15 +22,19 @@ type (
 	UpdateExpense func(ctx *fiber.Ctx) error
 
 	UpdateExpenseRequest struct {
-		Name         *string    `json:"name"`
-		Amount       *int       `json:"amount"`
-		RefundAmount *int       `json:"refund_amount"`
-		Description  *string    `json:"description"`
-		CategoryID   *int       `json:"category_id"`
-		SplitType    *string    `json:"split_type" validate:"omitempty,oneof=equal proportional transfer"`
-		PayerID      *int       `json:"payer_id"`
-		ReceiverID   *int       `json:"receiver_id"`
-		CreatedAt    *time.Time `json:"created_at"`
+		Name         *string        `json:"name"`
+		Amount       *int           `json:"amount"`
+		RefundAmount *int           `json:"refund_amount"`
+		Description  *string        `json:"description"`
+		CategoryID   *int           `json:"category_id"`
+		SplitType    *string        `json:"split_type" validate:"omitempty,oneof=equal proportional transfer exact weighted"`
+		PayerID      *int           `json:"payer_id"`
+		ReceiverID   *int           `json:"receiver_id"`
+		Participants []int          `json:"participants" validate:"omitempty,min=2,dive,required"`
+		Shares       []ShareRequest `json:"shares" validate:"omitempty,dive"`
+		// TagIDs replaces the tags of the expense when present; an empty list removes them all.
+		TagIDs    *[]int     `json:"tag_ids" validate:"omitempty,unique,dive,gt=0"`
+		CreatedAt *time.Time `json:"created_at"`
 	}
 
 	UpdateExpenseResponse struct {
@@ -38,6 +43,8 @@ type (
 		Amount     float32 `json:"amount"`
 		PayerID    int     `json:"payer_id"`
 		ReceiverID int     `json:"receiver_id"`
+		TagIDs     []int   `json:"tag_ids"`
+		Version    int     `json:"version"`
 	}
 )
 
@@ -49,6 +56,11 @@ func NewUpdateExpense(updateExpense usecase.UpdateExpense) UpdateExpense {
 			return except.BadRequestError("invalid expense id")
 		}
 
+		version, err := api.IfMatch(ctx)
+		if err != nil {
+			return err
+		}
+
 		var req UpdateExpenseRequest
 		if err := ctx.BodyParser(&req); err != nil {
 			return except.UnprocessableEntityError().SetInternal(err)
@@ -89,12 +101,25 @@ func NewUpdateExpense(updateExpense usecase.UpdateExpense) UpdateExpense {
 				}
 				return nil
 			}(),
+			Participants: toUserIDs(req.Participants),
+			Shares:       toShares(req.Shares),
+			TagIDs: func() *[]tag.ID {
+				if req.TagIDs != nil {
+					tagIDs := toTagIDParams(*req.TagIDs)
+					return &tagIDs
+				}
+				return nil
+			}(),
 			CreatedAt: req.CreatedAt,
+			Version:   version,
+			UserID:    currentUserID(ctx),
 		})
 		if err != nil {
 			return fmt.Errorf("UpdateExpense: %w", err)
 		}
 
+		api.SetETag(ctx, expns.Version)
+
 		return ctx.Status(http.StatusCreated).JSON(
 			api.NewResponse(http.StatusCreated, UpdateExpenseResponse{
 				ID:         expns.ID.Value,
@@ -102,6 +127,8 @@ func NewUpdateExpense(updateExpense usecase.UpdateExpense) UpdateExpense {
 				Amount:     float32(expns.Amount) / 100,
 				PayerID:    expns.PayerID.Value,
 				ReceiverID: expns.ReceiverID.Value,
+				TagIDs:     toTagIDs(expns.TagIDs),
+				Version:    expns.Version,
 			}),
 		)
 	}
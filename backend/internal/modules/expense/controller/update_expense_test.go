@@ -16,9 +16,11 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
@@ -53,7 +55,7 @@ func TestUpdateExpenseHandler(t *testing.T) {
 		Description: "Updated description",
 		GroupID:     group.ID{Value: 1},
 		CategoryID:  category.ID{Value: 2},
-		SplitRatio:  expense.SplitRatio{Payer: 60, Receiver: 40},
+		SplitRatio:  expense.SplitRatio{Shares: []expense.Share{{UserID: user.ID{Value: 1}, Percent: 60}, {UserID: user.ID{Value: 2}, Percent: 40}}},
 		PayerID:     user.ID{Value: 1},
 		ReceiverID:  user.ID{Value: 2},
 	})
@@ -62,10 +64,12 @@ func TestUpdateExpenseHandler(t *testing.T) {
 	testCases := []struct {
 		name             string
 		expenseID        string
+		ifMatch          string
 		body             any
 		mockSetup        func(usecase *mocks.MockusecaseUpdateExpense)
 		expectedStatus   int
 		expectedResponse string
+		expectedETag     string
 		customAssertions func(t *testing.T, body []byte)
 	}{
 		{
@@ -76,6 +80,7 @@ func TestUpdateExpenseHandler(t *testing.T) {
 				usecase.EXPECT().Execute(mock.Anything, mock.Anything).Return(updatedExpense, nil).Once()
 			},
 			expectedStatus: 201,
+			expectedETag:   `"0"`,
 			customAssertions: func(t *testing.T, body []byte) {
 				var response api.Response[controller.UpdateExpenseResponse]
 				assert.Nil(t, json.Unmarshal(body, &response))
@@ -104,6 +109,45 @@ func TestUpdateExpenseHandler(t *testing.T) {
 				assert.NotEmpty(t, response.Date)
 			},
 		},
+		{
+			name:      "should send the If-Match version to the use case",
+			expenseID: "1",
+			ifMatch:   `W/"0"`,
+			body:      partialUpdateRequest,
+			mockSetup: func(uc *mocks.MockusecaseUpdateExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.UpdateExpenseParams) bool {
+					return p.Version != nil && *p.Version == 0
+				})).Return(updatedExpense, nil).Once()
+			},
+			expectedStatus: 201,
+			expectedETag:   `"0"`,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.UpdateExpenseResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 0, response.Data.Version)
+			},
+		},
+		{
+			name:             "should return 400 if If-Match is not a version",
+			expenseID:        "1",
+			ifMatch:          `"abc"`,
+			body:             partialUpdateRequest,
+			mockSetup:        func(usecase *mocks.MockusecaseUpdateExpense) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid If-Match header","error":"invalid If-Match header"}`,
+		},
+		{
+			name:      "should return 409 with the current version if the expense changed",
+			expenseID: "1",
+			ifMatch:   `"2"`,
+			body:      partialUpdateRequest,
+			mockSetup: func(usecase *mocks.MockusecaseUpdateExpense) {
+				usecase.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, &ddd.VersionConflictError{CurrentVersion: 4}).Once()
+			},
+			expectedStatus:   409,
+			expectedResponse: `{"status_code":409,"message":"version conflict","error":"UpdateExpense: version conflict: current version is 4","current_version":4}`,
+			expectedETag:     `"4"`,
+		},
 		{
 			name:             "should return 400 if expense_id is not a valid number",
 			expenseID:        "invalid",
@@ -184,6 +228,9 @@ func TestUpdateExpenseHandler(t *testing.T) {
 				req = httptest.NewRequest("PUT", "http://localhost:8080/expenses/"+tc.expenseID, bytes.NewBuffer(bodyBytes))
 			}
 			req.Header.Set("Content-Type", "application/json")
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
 
 			// Execução da requisição
 			resp, err := app.Test(req)
@@ -194,6 +241,9 @@ func TestUpdateExpenseHandler(t *testing.T) {
 
 			// Assertions
 			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedETag != "" {
+				assert.Equal(t, tc.expectedETag, resp.Header.Get("ETag"))
+			}
 
 			// Assertions específicas do caso
 			if tc.customAssertions != nil {
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	UpdateImportCandidate func(ctx *fiber.Ctx) error
+
+	UpdateImportCandidateRequest struct {
+		Name       *string     `json:"name" validate:"omitempty,min=1"`
+		Amount     *int        `json:"amount" validate:"omitempty,gt=0"`
+		Date       *civil.Date `json:"date"`
+		CategoryID *int        `json:"category_id" validate:"omitempty,gt=0"`
+	}
+)
+
+func NewUpdateImportCandidate(updateImportCandidate usecase.UpdateImportCandidate) UpdateImportCandidate {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		candidateID, groupID, err := importCandidateParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		var req UpdateImportCandidateRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		input := usecase.UpdateImportCandidateParams{
+			ID:      candidateID,
+			GroupID: groupID,
+			Name:    req.Name,
+			Amount:  req.Amount,
+			Date:    req.Date,
+		}
+		if req.CategoryID != nil {
+			input.CategoryID = &category.ID{Value: *req.CategoryID}
+		}
+
+		candidate, err := updateImportCandidate(ctx.Context(), input)
+		if err != nil {
+			return fmt.Errorf("UpdateImportCandidate: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toImportCandidateResponse(candidate)),
+		)
+	}
+}
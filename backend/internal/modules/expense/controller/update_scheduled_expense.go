@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	UpdateScheduledExpense func(ctx *fiber.Ctx) error
+
+	UpdateScheduledExpenseRequest struct {
+		Name            *string            `json:"name" validate:"omitempty,min=1"`
+		Amount          *int               `json:"amount" validate:"omitempty,gt=0"`
+		Description     *string            `json:"description"`
+		CategoryID      *int               `json:"category_id"`
+		SplitType       *string            `json:"split_type" validate:"omitempty,oneof=equal proportional transfer"`
+		PayerID         *int               `json:"payer_id"`
+		ReceiverID      *int               `json:"receiver_id"`
+		FrequencyInDays *int               `json:"frequency_in_days" validate:"omitempty,gt=0,excluded_with=Recurrence"`
+		Recurrence      *RecurrenceRequest `json:"recurrence"`
+	}
+)
+
+func NewUpdateScheduledExpense(updateScheduledExpense usecase.UpdateScheduledExpense) UpdateScheduledExpense {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		params, err := scheduledExpenseParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		var req UpdateScheduledExpenseRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		input := usecase.UpdateScheduledExpenseParams{
+			ID:              params.ID,
+			GroupID:         params.GroupID,
+			Name:            req.Name,
+			Amount:          req.Amount,
+			Description:     req.Description,
+			FrequencyInDays: req.FrequencyInDays,
+			Recurrence:      toRecurrence(req.Recurrence),
+		}
+		if req.CategoryID != nil {
+			input.CategoryID = &category.ID{Value: *req.CategoryID}
+		}
+		if req.SplitType != nil {
+			splitType := expense.SplitType(*req.SplitType)
+			input.SplitType = &splitType
+		}
+		if req.PayerID != nil {
+			input.PayerID = &user.ID{Value: *req.PayerID}
+		}
+		if req.ReceiverID != nil {
+			input.ReceiverID = &user.ID{Value: *req.ReceiverID}
+		}
+
+		scheduledExpense, err := updateScheduledExpense(ctx.Context(), input)
+		if err != nil {
+			return fmt.Errorf("UpdateScheduledExpense: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toScheduledExpenseResponse(scheduledExpense)),
+		)
+	}
+}
@@ -0,0 +1,150 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestUpdateScheduledExpenseHandler(t *testing.T) {
+	t.Parallel()
+
+	updatedScheduledExpense, _ := expense.NewScheduledExpense(expense.ScheduledExpenseAttributes{
+		ID:          expense.ScheduledExpenseID{Value: 1},
+		Name:        "Rent",
+		Amount:      150000,
+		Description: "Monthly rent",
+		GroupID:     group.ID{Value: 1},
+		CategoryID:  category.ID{Value: 1},
+		SplitType:   expense.SplitTypes.Equal,
+		PayerID:     user.ID{Value: 1},
+		ReceiverID:  user.ID{Value: 2},
+		Recurrence:  &expense.Recurrence{Frequency: expense.Frequencies.Monthly, Interval: 1, DayOfMonth: 5},
+	})
+
+	testCases := []struct {
+		name             string
+		scheduledID      string
+		body             any
+		mockSetup        func(uc *mocks.MockusecaseUpdateScheduledExpense)
+		expectedStatus   int
+		expectedResponse string
+		customAssertions func(t *testing.T, body []byte)
+	}{
+		{
+			name:        "should return 200 and update the scheduled expense",
+			scheduledID: "1",
+			body: controller.UpdateScheduledExpenseRequest{
+				Amount:     intPtr(150000),
+				Recurrence: &controller.RecurrenceRequest{Frequency: "monthly", DayOfMonth: 5},
+			},
+			mockSetup: func(uc *mocks.MockusecaseUpdateScheduledExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.UpdateScheduledExpenseParams) bool {
+					return p.ID.Value == 1 && p.GroupID.Value == 1 &&
+						*p.Amount == 150000 &&
+						p.Recurrence.Frequency == expense.Frequencies.Monthly &&
+						p.Recurrence.Interval == 1
+				})).Return(updatedScheduledExpense, nil).Once()
+			},
+			expectedStatus: 200,
+			customAssertions: func(t *testing.T, body []byte) {
+				var response api.Response[controller.ScheduledExpenseResponse]
+				assert.Nil(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 1, response.Data.ID)
+				assert.Equal(t, float32(1500), response.Data.Amount)
+				assert.Equal(t, "monthly", response.Data.Recurrence.Frequency)
+				assert.True(t, response.Data.IsActive)
+			},
+		},
+		{
+			name:             "should return 400 if scheduled_expense_id is not a valid number",
+			scheduledID:      "invalid",
+			body:             controller.UpdateScheduledExpenseRequest{},
+			mockSetup:        func(uc *mocks.MockusecaseUpdateScheduledExpense) {},
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid scheduled expense id","error":"invalid scheduled expense id"}`,
+		},
+		{
+			name:        "should return 400 if both frequency in days and recurrence are given",
+			scheduledID: "1",
+			body: controller.UpdateScheduledExpenseRequest{
+				FrequencyInDays: intPtr(30),
+				Recurrence:      &controller.RecurrenceRequest{Frequency: "monthly"},
+			},
+			mockSetup:      func(uc *mocks.MockusecaseUpdateScheduledExpense) {},
+			expectedStatus: 400,
+		},
+		{
+			name:        "should return 403 if the scheduled expense belongs to another group",
+			scheduledID: "1",
+			body:        controller.UpdateScheduledExpenseRequest{Name: stringPtr("Rent")},
+			mockSetup: func(uc *mocks.MockusecaseUpdateScheduledExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.ForbiddenError("scheduled expense does not belong to the group")).Once()
+			},
+			expectedStatus:   403,
+			expectedResponse: `{"status_code":403,"message":"scheduled expense does not belong to the group","error":"UpdateScheduledExpense: scheduled expense does not belong to the group"}`,
+		},
+		{
+			name:        "should return 500 if it gets an unexpected error",
+			scheduledID: "1",
+			body:        controller.UpdateScheduledExpenseRequest{Name: stringPtr("Rent")},
+			mockSetup: func(uc *mocks.MockusecaseUpdateScheduledExpense) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, errors.New("unexpected error")).Once()
+			},
+			expectedStatus:   500,
+			expectedResponse: `{"status_code":500,"message":"Internal Server Error","error":"UpdateScheduledExpense: unexpected error"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+
+	updateScheduledExpense := mocks.NewMockusecaseUpdateScheduledExpense(t)
+	app.Patch("/scheduled/:scheduled_expense_id", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		return c.Next()
+	}, controller.NewUpdateScheduledExpense(updateScheduledExpense.Execute))
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(updateScheduledExpense)
+
+			bodyBytes, _ := json.Marshal(tc.body)
+			req := httptest.NewRequest(http.MethodPatch, "http://localhost:8080/scheduled/"+tc.scheduledID, bytes.NewBuffer(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.customAssertions != nil {
+				tc.customAssertions(t, body)
+			} else if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(body))
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
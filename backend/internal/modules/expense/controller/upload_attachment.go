@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	UploadAttachment func(ctx *fiber.Ctx) error
+
+	AttachmentResponse struct {
+		ID           int       `json:"id"`
+		ExpenseID    int       `json:"expense_id"`
+		FileName     string    `json:"file_name"`
+		ContentType  string    `json:"content_type"`
+		Size         int       `json:"size"`
+		HasThumbnail bool      `json:"has_thumbnail"`
+		UploadedBy   int       `json:"uploaded_by"`
+		CreatedAt    time.Time `json:"created_at"`
+	}
+)
+
+// NewUploadAttachment reads the file sent as the "file" field of a multipart form.
+func NewUploadAttachment(uploadAttachment usecase.UploadAttachment) UploadAttachment {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		userID, ok := ctx.Locals("user_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("user_id not found in context")
+		}
+
+		expenseID, err := strconv.Atoi(ctx.Params("expense_id"))
+		if err != nil {
+			return except.BadRequestError("invalid expense id")
+		}
+
+		header, err := ctx.FormFile("file")
+		if err != nil {
+			return except.BadRequestError("missing attachment file").SetInternal(err)
+		}
+
+		if header.Size > expense.MaxAttachmentSize {
+			return except.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("file is larger than %d MB", expense.MaxAttachmentSize>>20))
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			return fmt.Errorf("header.Open: %w", err)
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return fmt.Errorf("io.ReadAll: %w", err)
+		}
+
+		attachment, err := uploadAttachment(ctx.Context(), usecase.UploadAttachmentParams{
+			GroupID:   group.ID{Value: groupID},
+			ExpenseID: expense.ID{Value: expenseID},
+			UserID:    user.ID{Value: userID},
+			FileName:  header.Filename,
+			Content:   content,
+		})
+		if err != nil {
+			return fmt.Errorf("UploadAttachment: %w", err)
+		}
+
+		return ctx.Status(http.StatusCreated).JSON(
+			api.NewResponse(http.StatusCreated, toAttachmentResponse(attachment)),
+		)
+	}
+}
+
+func toAttachmentResponse(attachment *expense.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:           attachment.ID.Value,
+		ExpenseID:    attachment.ExpenseID.Value,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		HasThumbnail: attachment.ThumbnailKey != nil,
+		UploadedBy:   attachment.UploadedBy.Value,
+		CreatedAt:    attachment.CreatedAt,
+	}
+}
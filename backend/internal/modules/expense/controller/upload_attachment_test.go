@@ -0,0 +1,204 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func newTestAttachment() *expense.Attachment {
+	attachment, _ := expense.NewAttachment(expense.AttachmentAttributes{
+		ID:          expense.AttachmentID{Value: 3},
+		ExpenseID:   expense.ID{Value: 1},
+		GroupID:     group.ID{Value: 1},
+		FileName:    "nota fiscal.pdf",
+		ContentType: "application/pdf",
+		Size:        9,
+		UploadedBy:  user.ID{Value: 7},
+	})
+	return attachment
+}
+
+func TestUploadAttachmentHandler(t *testing.T) {
+	t.Parallel()
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		file             []byte
+		mockSetup        func(uc *mocks.MockusecaseUploadAttachment)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "should return 201 with the attachment",
+			file: []byte("%PDF-1.7\n"),
+			mockSetup: func(uc *mocks.MockusecaseUploadAttachment) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.UploadAttachmentParams) bool {
+					return p.GroupID.Value == 1 &&
+						p.ExpenseID.Value == 1 &&
+						p.UserID.Value == 7 &&
+						p.FileName == "nota fiscal.pdf" &&
+						string(p.Content) == "%PDF-1.7\n"
+				})).Return(newTestAttachment(), nil).Once()
+			},
+			expectedStatus: 201,
+		},
+		{
+			name:             "should return 400 if file is missing",
+			mockSetup:        func(uc *mocks.MockusecaseUploadAttachment) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"missing attachment file","error":"missing attachment file: internal=there is no uploaded file associated with the given key"}`,
+		},
+		{
+			name:             "should return 413 if file is too large",
+			file:             make([]byte, expense.MaxAttachmentSize+1),
+			mockSetup:        func(uc *mocks.MockusecaseUploadAttachment) {}, // Não precisa de mock para este caso
+			expectedStatus:   413,
+			expectedResponse: `{"status_code":413,"message":"file is larger than 10 MB","error":"file is larger than 10 MB"}`,
+		},
+		{
+			name: "should return 422 if file type is not supported",
+			file: []byte("plain text"),
+			mockSetup: func(uc *mocks.MockusecaseUploadAttachment) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.UnprocessableEntityError("attachment validation failed: unsupported file type text/plain; charset=utf-8")).Once()
+			},
+			expectedStatus: 422,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler, BodyLimit: 12 << 20})
+	uploadAttachment := mocks.NewMockusecaseUploadAttachment(t)
+	app.Post("/expenses/:expense_id/attachments", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		c.Locals("user_id", 7)
+		return c.Next()
+	}, controller.NewUploadAttachment(uploadAttachment.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(uploadAttachment)
+
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			if tc.file != nil {
+				part, err := form.CreateFormFile("file", "nota fiscal.pdf")
+				assert.NoError(t, err)
+				_, err = part.Write(tc.file)
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, form.Close())
+
+			req := httptest.NewRequest("POST", "/expenses/1/attachments", &body)
+			req.Header.Set("Content-Type", form.FormDataContentType())
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(respBody))
+			} else if tc.expectedStatus == 201 {
+				var response api.Response[controller.AttachmentResponse]
+				assert.Nil(t, json.Unmarshal(respBody, &response))
+				assert.Equal(t, 3, response.Data.ID)
+				assert.Equal(t, "application/pdf", response.Data.ContentType)
+				assert.False(t, response.Data.HasThumbnail)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
+
+func TestDownloadAttachmentHandler(t *testing.T) {
+	t.Parallel()
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name                string
+		url                 string
+		mockSetup           func(uc *mocks.MockusecaseDownloadAttachment)
+		expectedStatus      int
+		expectedDisposition string
+		expectedBody        string
+	}{
+		{
+			name: "should return 200 with the file",
+			url:  "/expenses/1/attachments/3",
+			mockSetup: func(uc *mocks.MockusecaseDownloadAttachment) {
+				uc.EXPECT().Execute(mock.Anything, usecase.DownloadAttachmentParams{
+					GroupID:      group.ID{Value: 1},
+					ExpenseID:    expense.ID{Value: 1},
+					AttachmentID: expense.AttachmentID{Value: 3},
+				}).Return(&usecase.DownloadAttachmentResult{
+					Attachment:  *newTestAttachment(),
+					ContentType: "application/pdf",
+					Content:     io.NopCloser(bytes.NewBufferString("%PDF-1.7\n")),
+				}, nil).Once()
+			},
+			expectedStatus:      200,
+			expectedDisposition: `inline; filename="nota fiscal.pdf"`,
+			expectedBody:        "%PDF-1.7\n",
+		},
+		{
+			name: "should return 404 if the attachment is not found",
+			url:  "/expenses/1/attachments/4?thumbnail=true",
+			mockSetup: func(uc *mocks.MockusecaseDownloadAttachment) {
+				uc.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(p usecase.DownloadAttachmentParams) bool {
+					return p.AttachmentID.Value == 4 && p.Thumbnail
+				})).Return(nil, except.NotFoundError("attachment not found")).Once()
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"status_code":404,"message":"attachment not found","error":"DownloadAttachment: attachment not found"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	downloadAttachment := mocks.NewMockusecaseDownloadAttachment(t)
+	app.Get("/expenses/:expense_id/attachments/:attachment_id", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		return c.Next()
+	}, controller.NewDownloadAttachment(downloadAttachment.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(downloadAttachment)
+
+			resp, err := app.Test(httptest.NewRequest("GET", tc.url, nil))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedBody, string(respBody))
+			if tc.expectedDisposition != "" {
+				assert.Equal(t, tc.expectedDisposition, resp.Header.Get("Content-Disposition"))
+				assert.Equal(t, "application/pdf", resp.Header.Get("Content-Type"))
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package expense
+
+import "time"
+
+// DuplicateWindow is how far apart two expenses with the same amount and a similar name may have
+// happened to still be suspected of being the same purchase entered twice.
+const DuplicateWindow = 3 * 24 * time.Hour
@@ -7,4 +7,6 @@ import (
 var (
 	ErrInvalidSplitRatio   = errors.New("invalid split ratio")
 	ErrInvalidRefundAmount = errors.New("invalid refund amount, must be less than the amount of the expense")
+	ErrInvalidInstallment  = errors.New("invalid installment")
+	ErrInvalidRecurrence   = errors.New("invalid recurrence")
 )
@@ -3,12 +3,15 @@ package expense
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 type ID struct{ Value int }
@@ -25,6 +28,10 @@ type Expense struct {
 	SplitType    SplitType
 	PayerID      user.ID
 	ReceiverID   user.ID
+	Installment  *Installment
+	TagIDs       []tag.ID
+	// UpdatedBy is the user who wrote this version, nil when the system did.
+	UpdatedBy *user.ID
 }
 
 type Attributes struct {
@@ -38,7 +45,10 @@ type Attributes struct {
 	SplitType   SplitType
 	PayerID     user.ID
 	ReceiverID  user.ID
+	Installment *Installment
+	TagIDs      []tag.ID
 	CreatedAt   *time.Time
+	CreatedBy   *user.ID
 }
 
 type UpdateAttributes struct {
@@ -51,7 +61,9 @@ type UpdateAttributes struct {
 	SplitType    *SplitType
 	PayerID      *user.ID
 	ReceiverID   *user.ID
+	TagIDs       *[]tag.ID
 	CreatedAt    *time.Time
+	UpdatedBy    *user.ID
 }
 
 func New(attr Attributes) (*Expense, error) {
@@ -75,6 +87,9 @@ func New(attr Attributes) (*Expense, error) {
 		SplitType:   attr.SplitType,
 		PayerID:     attr.PayerID,
 		ReceiverID:  attr.ReceiverID,
+		Installment: attr.Installment,
+		TagIDs:      normalizeTagIDs(attr.TagIDs),
+		UpdatedBy:   attr.CreatedBy,
 	}
 
 	if err := expense.validate(); err != nil {
@@ -112,10 +127,14 @@ func (e *Expense) Update(p UpdateAttributes) error {
 	if p.ReceiverID != nil {
 		e.ReceiverID = *p.ReceiverID
 	}
+	if p.TagIDs != nil {
+		e.TagIDs = normalizeTagIDs(*p.TagIDs)
+	}
 	if p.CreatedAt != nil {
 		e.CreatedAt = *p.CreatedAt
 	}
 	e.UpdatedAt = time.Now()
+	e.UpdatedBy = p.UpdatedBy
 	e.Version++
 
 	if err := e.validate(); err != nil {
@@ -125,31 +144,131 @@ func (e *Expense) Update(p UpdateAttributes) error {
 	return nil
 }
 
-func (e *Expense) Delete() {
+func (e *Expense) Delete(by *user.ID) {
 	now := time.Now()
 	e.DeletedAt = &now
 	e.UpdatedAt = now
+	e.UpdatedBy = by
+	e.Version++
+}
+
+// Restore brings a deleted expense back, writing a new version without the deletion mark.
+func (e *Expense) Restore(by *user.ID) {
+	e.DeletedAt = nil
+	e.UpdatedAt = time.Now()
+	e.UpdatedBy = by
+	e.Version++
+}
+
+// Revert writes a new version with the contents of an older one. The identity of the expense,
+// its group and installment are kept.
+func (e *Expense) Revert(to Expense, by *user.ID) error {
+	e.Name = to.Name
+	e.Amount = to.Amount
+	e.RefundAmount = to.RefundAmount
+	e.Description = to.Description
+	e.CategoryID = to.CategoryID
+	e.SplitRatio = to.SplitRatio
+	e.SplitType = to.SplitType
+	e.PayerID = to.PayerID
+	e.ReceiverID = to.ReceiverID
+	e.TagIDs = to.TagIDs
+	e.CreatedAt = to.CreatedAt
+	e.UpdatedAt = time.Now()
+	e.UpdatedBy = by
 	e.Version++
+
+	if err := e.validate(); err != nil {
+		return fmt.Errorf("expense.Validate: %w", err)
+	}
+
+	return nil
+}
+
+// Participants returns the users who share the expense.
+func (e *Expense) Participants() []user.ID {
+	return e.SplitRatio.Participants()
+}
+
+// normalizeTagIDs sorts the tags and drops the repeated ones, so versions with the same tags
+// compare equal.
+func normalizeTagIDs(ids []tag.ID) []tag.ID {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	normalized := slices.Clone(ids)
+	slices.SortFunc(normalized, func(a, b tag.ID) int { return a.Value - b.Value })
+	return slices.Compact(normalized)
 }
 
 func (e *Expense) validate() error {
-	if e.SplitRatio.Payer+e.SplitRatio.Receiver != 100 || e.SplitRatio.Payer > 99 {
+	if err := e.SplitRatio.validate(); err != nil {
+		return err
+	}
+
+	if !e.SplitRatio.Has(e.ReceiverID) {
 		return ErrInvalidSplitRatio
 	}
 
-	if e.SplitType == SplitTypes.Equal && e.SplitRatio.Payer != 50 {
+	if e.SplitType.IsCustom() && e.SplitRatio.amountOf(e.PayerID) >= e.Amount {
 		return ErrInvalidSplitRatio
 	}
 
+	if !e.SplitType.IsCustom() && e.SplitRatio.ShareOf(e.PayerID) > 99 {
+		return ErrInvalidSplitRatio
+	}
+
+	if e.SplitType == SplitTypes.Equal && !e.SplitRatio.isEqual() {
+		return ErrInvalidSplitRatio
+	}
+
+	if e.SplitType == SplitTypes.Exact {
+		if err := e.SplitRatio.validateExact(e.Amount); err != nil {
+			return err
+		}
+	}
+
+	if e.SplitType == SplitTypes.Weighted {
+		if err := e.SplitRatio.validateWeighted(e.Amount); err != nil {
+			return err
+		}
+	}
+
 	if e.RefundAmount != nil && *e.RefundAmount > e.Amount {
 		return ErrInvalidRefundAmount
 	}
 
+	if e.Installment != nil {
+		if err := e.Installment.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 type Repository interface {
 	ddd.Repository[ID, Expense]
 	GetByGroupDate(ctx context.Context, groupId group.ID, date time.Time) ([]Expense, error)
-	BulkStore(ctx context.Context, expenses []Expense) error
+	GetByPurchaseID(ctx context.Context, purchaseID string) ([]Expense, error)
+	// GetByTagIDs returns the expenses of the group tagged with any of the tags, deleted ones
+	// included, so none keeps pointing to a tag that is removed.
+	GetByTagIDs(ctx context.Context, groupID group.ID, tagIDs []tag.ID) ([]Expense, error)
+	// GetVersions returns every version of the expense, deleted ones included, oldest first.
+	GetVersions(ctx context.Context, id ID) ([]Expense, error)
+	// BulkStore persists the expenses and writes the events to the outbox atomically.
+	BulkStore(ctx context.Context, expenses []Expense, events ...outbox.Event) error
+	// StoreWithEvents persists the expense and writes the events to the outbox atomically.
+	StoreWithEvents(ctx context.Context, expense *Expense, events ...outbox.Event) error
+	// GetPossibleDuplicates returns the expenses of the group that look like the given one: the
+	// same amount, a similar name and created within DuplicateWindow of it.
+	GetPossibleDuplicates(ctx context.Context, entity Expense) ([]Expense, error)
+	// DismissDuplicates records that none of the expenses is a duplicate of the others, so they
+	// are not suspected again.
+	DismissDuplicates(ctx context.Context, groupID group.ID, ids []ID) error
+	// PurgeDeleted permanently removes every version of the expenses deleted before the given
+	// time, along with their attachments, and returns how many expenses were removed. The
+	// attachments removed are returned so their files can be deleted from the storage.
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, []Attachment, error)
 }
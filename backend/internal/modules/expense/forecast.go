@@ -0,0 +1,79 @@
+package expense
+
+import (
+	"math"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+)
+
+// ForecastTrailingMonths is how many full months before the current one make the average of the
+// discretionary spending.
+const ForecastTrailingMonths = 3
+
+// CategoryGroupForecast projects how much a category group will have spent by the end of the month.
+type CategoryGroupForecast struct {
+	CategoryGroupID category.GroupID
+	// Spent is what the group spent in the month, including the expenses recorded for the days ahead.
+	Spent int
+	// Scheduled is what scheduled expenses still have to charge until the end of the month.
+	Scheduled int
+	// Discretionary is what the rest of the month is expected to add in the categories without
+	// scheduled expenses, given how much they spent on average in the trailing months.
+	Discretionary int
+	Projected     int
+}
+
+// SpendingForecast is the month-end projection of the spending of a group.
+type SpendingForecast struct {
+	Month          time.Time
+	CategoryGroups []CategoryGroupForecast
+	Incomes        int
+}
+
+// ProjectCategoryGroup adds to what was spent the scheduled expenses still due and the share of
+// the trailing discretionary spending for the part of the month that has not elapsed yet.
+// trailingDiscretionary is the sum over all the ForecastTrailingMonths.
+func ProjectCategoryGroup(id category.GroupID, spent, scheduled, trailingDiscretionary int, elapsed float64) CategoryGroupForecast {
+	average := float64(trailingDiscretionary) / ForecastTrailingMonths
+	discretionary := int(math.Round(average * (1 - elapsed)))
+
+	return CategoryGroupForecast{
+		CategoryGroupID: id,
+		Spent:           spent,
+		Scheduled:       scheduled,
+		Discretionary:   discretionary,
+		Projected:       spent + scheduled + discretionary,
+	}
+}
+
+// MonthElapsed is the fraction of the month of t, in UTC, that has passed, counting its day as over.
+func MonthElapsed(t time.Time) float64 {
+	t = t.UTC()
+	days := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	return float64(t.Day()) / float64(days)
+}
+
+func (f SpendingForecast) Spent() int {
+	total := 0
+	for _, group := range f.CategoryGroups {
+		total += group.Spent
+	}
+
+	return total
+}
+
+func (f SpendingForecast) Projected() int {
+	total := 0
+	for _, group := range f.CategoryGroups {
+		total += group.Projected
+	}
+
+	return total
+}
+
+// ProjectedSavings is what is left of the incomes of the month once the projected spending is paid.
+func (f SpendingForecast) ProjectedSavings() int {
+	return f.Incomes - f.Projected()
+}
@@ -0,0 +1,46 @@
+package expense
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+)
+
+func TestProjectCategoryGroup(t *testing.T) {
+	id := category.GroupID{Value: 1}
+
+	// 90000 over three months is 30000 a month, two thirds of it still to come
+	forecast := ProjectCategoryGroup(id, 12000, 5000, 90000, 1.0/3)
+	assert.Equal(t, id, forecast.CategoryGroupID)
+	assert.Equal(t, 20000, forecast.Discretionary)
+	assert.Equal(t, 37000, forecast.Projected)
+
+	forecast = ProjectCategoryGroup(id, 12000, 0, 90000, 1)
+	assert.Equal(t, 0, forecast.Discretionary)
+	assert.Equal(t, 12000, forecast.Projected)
+}
+
+func TestMonthElapsed(t *testing.T) {
+	assert.Equal(t, 0.5, MonthElapsed(time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1.0, MonthElapsed(time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC)))
+
+	saoPaulo := time.FixedZone("America/Sao_Paulo", -3*60*60)
+	assert.Equal(t, 1.0/30, MonthElapsed(time.Date(2026, 5, 31, 22, 0, 0, 0, saoPaulo)))
+}
+
+func TestSpendingForecast(t *testing.T) {
+	forecast := SpendingForecast{
+		CategoryGroups: []CategoryGroupForecast{
+			{Spent: 10000, Projected: 25000},
+			{Spent: 5000, Projected: 8000},
+		},
+		Incomes: 50000,
+	}
+
+	assert.Equal(t, 15000, forecast.Spent())
+	assert.Equal(t, 33000, forecast.Projected())
+	assert.Equal(t, 17000, forecast.ProjectedSavings())
+}
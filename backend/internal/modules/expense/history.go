@@ -0,0 +1,87 @@
+package expense
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+)
+
+// Change is a field whose value differs between two versions of an expense.
+type Change struct {
+	Field string
+	From  any
+	To    any
+}
+
+// Revision is a version of an expense together with what changed from the previous one.
+type Revision struct {
+	Version   int
+	UpdatedAt time.Time
+	UpdatedBy *user.ID
+	Changes   []Change
+}
+
+// History describes the versions of an expense, oldest first. The first revision has no
+// changes since there is nothing to compare it to.
+func History(versions []Expense) []Revision {
+	revisions := make([]Revision, 0, len(versions))
+	for i, version := range versions {
+		revision := Revision{
+			Version:   version.Version,
+			UpdatedAt: version.UpdatedAt,
+			UpdatedBy: version.UpdatedBy,
+		}
+		if i > 0 {
+			revision.Changes = Diff(versions[i-1], version)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions
+}
+
+// Diff lists the fields that changed from one version of an expense to another.
+func Diff(from, to Expense) []Change {
+	fields := []struct {
+		name     string
+		from, to any
+	}{
+		{"name", from.Name, to.Name},
+		{"amount", from.Amount, to.Amount},
+		{"refund_amount", from.RefundAmount, to.RefundAmount},
+		{"description", from.Description, to.Description},
+		{"category_id", from.CategoryID, to.CategoryID},
+		{"split_type", from.SplitType, to.SplitType},
+		{"split_ratio", from.SplitRatio, to.SplitRatio},
+		{"payer_id", from.PayerID, to.PayerID},
+		{"receiver_id", from.ReceiverID, to.ReceiverID},
+		{"tag_ids", from.TagIDs, to.TagIDs},
+		{"created_at", from.CreatedAt, to.CreatedAt},
+		{"deleted_at", from.DeletedAt, to.DeletedAt},
+	}
+
+	var changes []Change
+	for _, field := range fields {
+		if !same(field.from, field.to) {
+			changes = append(changes, Change{Field: field.name, From: field.from, To: field.to})
+		}
+	}
+
+	return changes
+}
+
+func same(a, b any) bool {
+	switch a := a.(type) {
+	case time.Time:
+		return a.Equal(b.(time.Time))
+	case *time.Time:
+		b := b.(*time.Time)
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Equal(*b)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
@@ -0,0 +1,107 @@
+package expense
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+)
+
+func newTestExpense(t *testing.T, author *user.ID) *Expense {
+	t.Helper()
+	createdAt := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	expns, err := New(Attributes{
+		ID:         ID{Value: 1},
+		Name:       "Mercado",
+		Amount:     10000,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 1},
+		SplitRatio: NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
+		SplitType:  SplitTypes.Equal,
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+		CreatedAt:  &createdAt,
+		CreatedBy:  author,
+	})
+	assert.NoError(t, err)
+
+	return expns
+}
+
+func TestHistory(t *testing.T) {
+	alice, bob := user.ID{Value: 1}, user.ID{Value: 2}
+
+	v0 := *newTestExpense(t, &alice)
+
+	v1 := v0
+	name, amount := "Feira", 12000
+	assert.NoError(t, v1.Update(UpdateAttributes{Name: &name, Amount: &amount, UpdatedBy: &bob}))
+
+	v2 := v1
+	v2.Delete(&alice)
+
+	revisions := History([]Expense{v0, v1, v2})
+
+	assert.Len(t, revisions, 3)
+
+	assert.Equal(t, 0, revisions[0].Version)
+	assert.Equal(t, &alice, revisions[0].UpdatedBy)
+	assert.Empty(t, revisions[0].Changes)
+
+	assert.Equal(t, 1, revisions[1].Version)
+	assert.Equal(t, &bob, revisions[1].UpdatedBy)
+	assert.Equal(t, []Change{
+		{Field: "name", From: "Mercado", To: "Feira"},
+		{Field: "amount", From: 10000, To: 12000},
+	}, revisions[1].Changes)
+
+	assert.Equal(t, 2, revisions[2].Version)
+	assert.Len(t, revisions[2].Changes, 1)
+	assert.Equal(t, "deleted_at", revisions[2].Changes[0].Field)
+	assert.Nil(t, revisions[2].Changes[0].From)
+}
+
+func TestDiff_ignoresTimeRepresentation(t *testing.T) {
+	from := *newTestExpense(t, nil)
+	to := from
+	to.CreatedAt = from.CreatedAt.In(time.FixedZone("BRT", -3*60*60))
+
+	assert.Empty(t, Diff(from, to))
+}
+
+func TestExpense_Revert(t *testing.T) {
+	alice := user.ID{Value: 1}
+	original := *newTestExpense(t, nil)
+
+	current := original
+	name, amount := "Feira", 12000
+	split := NewTransferRatio(user.ID{Value: 1}, user.ID{Value: 2})
+	assert.NoError(t, current.Update(UpdateAttributes{Name: &name, Amount: &amount, SplitRatio: &split, SplitType: &SplitTypes.Transfer}))
+
+	assert.NoError(t, current.Revert(original, &alice))
+
+	assert.Equal(t, 2, current.Version)
+	assert.Equal(t, &alice, current.UpdatedBy)
+	assert.Empty(t, Diff(original, current))
+}
+
+func TestDiff_tags(t *testing.T) {
+	from := *newTestExpense(t, nil)
+
+	to := from
+	tagIDs := []tag.ID{{Value: 3}, {Value: 1}, {Value: 3}}
+	assert.NoError(t, to.Update(UpdateAttributes{TagIDs: &tagIDs}))
+	assert.Equal(t, []Change{
+		{Field: "tag_ids", From: []tag.ID(nil), To: []tag.ID{{Value: 1}, {Value: 3}}},
+	}, Diff(from, to))
+
+	same := to
+	reordered := []tag.ID{{Value: 1}, {Value: 3}}
+	assert.NoError(t, same.Update(UpdateAttributes{TagIDs: &reordered}))
+	assert.Empty(t, Diff(to, same))
+}
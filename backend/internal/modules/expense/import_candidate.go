@@ -0,0 +1,178 @@
+package expense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+var ErrImportCandidateReviewed = errors.New("import candidate was already reviewed")
+
+type ImportCandidateID struct{ Value int }
+
+type ImportCandidateStatus string
+
+var ImportCandidateStatuses = struct {
+	Pending   ImportCandidateStatus
+	Confirmed ImportCandidateStatus
+	Discarded ImportCandidateStatus
+}{
+	Pending:   "pending",
+	Confirmed: "confirmed",
+	Discarded: "discarded",
+}
+
+// ImportCandidate is a transaction read from a bank statement, waiting in the review queue of the
+// group until a member confirms it as an expense or discards it.
+type ImportCandidate struct {
+	ddd.Entity[ImportCandidateID]
+	GroupID group.ID
+	// Source is the layout of the statement it came from, and ExternalID identifies the
+	// transaction in it, so importing the same statement twice does not queue it again.
+	Source     string
+	ExternalID string
+	Name       string
+	Amount     int
+	Date       civil.Date
+	// CategoryID starts as the category suggested by the prediction service, if any.
+	CategoryID *category.ID
+	Status     ImportCandidateStatus
+	ExpenseID  *ID
+	ImportedBy user.ID
+}
+
+type ImportCandidateAttributes struct {
+	ID         ImportCandidateID
+	GroupID    group.ID
+	Source     string
+	ExternalID string
+	Name       string
+	Amount     int
+	Date       civil.Date
+	CategoryID *category.ID
+	ImportedBy user.ID
+}
+
+type ImportCandidateUpdateAttributes struct {
+	Name       *string
+	Amount     *int
+	Date       *civil.Date
+	CategoryID *category.ID
+}
+
+func NewImportCandidate(attr ImportCandidateAttributes) (*ImportCandidate, error) {
+	candidate := &ImportCandidate{
+		Entity: ddd.Entity[ImportCandidateID]{
+			ID:        attr.ID,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			Version:   0,
+		},
+		GroupID:    attr.GroupID,
+		Source:     attr.Source,
+		ExternalID: attr.ExternalID,
+		Name:       attr.Name,
+		Amount:     attr.Amount,
+		Date:       attr.Date,
+		CategoryID: attr.CategoryID,
+		Status:     ImportCandidateStatuses.Pending,
+		ImportedBy: attr.ImportedBy,
+	}
+
+	if err := candidate.validate(); err != nil {
+		return nil, fmt.Errorf("import candidate validation failed: %w", err)
+	}
+
+	return candidate, nil
+}
+
+func (c *ImportCandidate) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if c.Amount <= 0 {
+		return fmt.Errorf("amount must be greater than zero")
+	}
+
+	if !c.Date.IsValid() {
+		return fmt.Errorf("invalid date")
+	}
+
+	return nil
+}
+
+// Update edits the candidate before it is confirmed.
+func (c *ImportCandidate) Update(attr ImportCandidateUpdateAttributes) error {
+	if c.Status != ImportCandidateStatuses.Pending {
+		return ErrImportCandidateReviewed
+	}
+
+	if attr.Name != nil {
+		c.Name = *attr.Name
+	}
+
+	if attr.Amount != nil {
+		c.Amount = *attr.Amount
+	}
+
+	if attr.Date != nil {
+		c.Date = *attr.Date
+	}
+
+	if attr.CategoryID != nil {
+		c.CategoryID = attr.CategoryID
+	}
+
+	c.UpdatedAt = time.Now()
+
+	return c.validate()
+}
+
+// Confirm takes the candidate out of the queue, recording the expense created from it.
+func (c *ImportCandidate) Confirm(expenseID ID) error {
+	if c.Status != ImportCandidateStatuses.Pending {
+		return ErrImportCandidateReviewed
+	}
+
+	c.Status = ImportCandidateStatuses.Confirmed
+	c.ExpenseID = &expenseID
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Discard takes the candidate out of the queue without creating an expense. It is kept so the
+// transaction is not queued again by a later import of the same statement.
+func (c *ImportCandidate) Discard() error {
+	if c.Status != ImportCandidateStatuses.Pending {
+		return ErrImportCandidateReviewed
+	}
+
+	c.Status = ImportCandidateStatuses.Discarded
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// ExpenseCreatedAt is when the expense of the candidate happened: noon UTC of the transaction
+// date, like the expenses generated from scheduled ones.
+func (c *ImportCandidate) ExpenseCreatedAt() time.Time {
+	return time.Date(c.Date.Year, c.Date.Month, c.Date.Day, 12, 0, 0, 0, time.UTC)
+}
+
+type ImportCandidateRepository interface {
+	ddd.Repository[ImportCandidateID, ImportCandidate]
+	GetByGroupID(ctx context.Context, groupID group.ID, status ImportCandidateStatus) ([]ImportCandidate, error)
+	// StoreNew inserts the candidates whose transaction was never imported into their group,
+	// returning the ones it inserted.
+	StoreNew(ctx context.Context, candidates []ImportCandidate) ([]ImportCandidate, error)
+}
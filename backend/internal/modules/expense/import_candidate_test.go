@@ -0,0 +1,68 @@
+package expense
+
+import (
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+)
+
+func newTestImportCandidate(t *testing.T) *ImportCandidate {
+	candidate, err := NewImportCandidate(ImportCandidateAttributes{
+		ID:         ImportCandidateID{Value: 1},
+		GroupID:    group.ID{Value: 1},
+		Source:     "ofx",
+		ExternalID: "1",
+		Name:       "Padaria",
+		Amount:     5290,
+		Date:       civil.Date{Year: 2025, Month: 1, Day: 7},
+		ImportedBy: user.ID{Value: 1},
+	})
+	assert.NoError(t, err)
+
+	return candidate
+}
+
+func TestNewImportCandidate(t *testing.T) {
+	candidate := newTestImportCandidate(t)
+	assert.Equal(t, ImportCandidateStatuses.Pending, candidate.Status)
+
+	_, err := NewImportCandidate(ImportCandidateAttributes{Name: "Padaria", Amount: 0, Date: civil.Date{Year: 2025, Month: 1, Day: 7}})
+	assert.EqualError(t, err, "import candidate validation failed: amount must be greater than zero")
+}
+
+func TestImportCandidate_Review(t *testing.T) {
+	t.Run("edits and confirms a pending candidate", func(t *testing.T) {
+		candidate := newTestImportCandidate(t)
+
+		name, categoryID := "Padaria do bairro", category.ID{Value: 3}
+		assert.NoError(t, candidate.Update(ImportCandidateUpdateAttributes{Name: &name, CategoryID: &categoryID}))
+		assert.Equal(t, name, candidate.Name)
+		assert.Equal(t, &categoryID, candidate.CategoryID)
+
+		assert.NoError(t, candidate.Confirm(ID{Value: 10}))
+		assert.Equal(t, ImportCandidateStatuses.Confirmed, candidate.Status)
+		assert.Equal(t, &ID{Value: 10}, candidate.ExpenseID)
+	})
+
+	t.Run("rejects invalid edits", func(t *testing.T) {
+		candidate := newTestImportCandidate(t)
+
+		empty := ""
+		assert.EqualError(t, candidate.Update(ImportCandidateUpdateAttributes{Name: &empty}), "name is required")
+	})
+
+	t.Run("cannot review a candidate twice", func(t *testing.T) {
+		candidate := newTestImportCandidate(t)
+		assert.NoError(t, candidate.Discard())
+
+		name := "Padaria"
+		assert.ErrorIs(t, candidate.Update(ImportCandidateUpdateAttributes{Name: &name}), ErrImportCandidateReviewed)
+		assert.ErrorIs(t, candidate.Confirm(ID{Value: 10}), ErrImportCandidateReviewed)
+		assert.ErrorIs(t, candidate.Discard(), ErrImportCandidateReviewed)
+	})
+}
@@ -0,0 +1,48 @@
+package expense
+
+import (
+	"fmt"
+	"time"
+)
+
+// Installment links an expense to the purchase it is part of, when the purchase was
+// paid in installments ("parcelado").
+type Installment struct {
+	PurchaseID string
+	Number     int
+	Total      int
+}
+
+// String returns the installment index, e.g. "3/10".
+func (i Installment) String() string {
+	return fmt.Sprintf("%d/%d", i.Number, i.Total)
+}
+
+func (i Installment) validate() error {
+	if i.PurchaseID == "" || i.Total < 2 || i.Number < 1 || i.Number > i.Total {
+		return ErrInvalidInstallment
+	}
+
+	return nil
+}
+
+// SplitInstallments divides amount into total installments. Cents that can not be evenly
+// divided go to the first installments.
+func SplitInstallments(amount, total int) []int {
+	weights := make([]int, total)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return distribute(amount, weights)
+}
+
+// InstallmentDate returns the date of the given installment, one month after the other
+// starting at date. When the month is shorter the last day of the month is used.
+func InstallmentDate(date time.Time, number int) time.Time {
+	year, month, day := date.Date()
+	first := time.Date(year, month+time.Month(number-1), 1, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+
+	return first.AddDate(0, 0, min(day, lastDay)-1)
+}
@@ -3,6 +3,7 @@ package expense
 import (
 	"context"
 
+	nossasdespesas "github.com/Beigelman/nossas-despesas"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/controller"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
@@ -10,37 +11,136 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/pkg/eon"
 )
 
+const defaultGenerateScheduledExpensesSchedule = "CRON_TZ=America/Sao_Paulo 0 6 * * *"
+
 var Module = eon.NewModule("Expense", func(ctx context.Context, c *di.Container, lc eon.LifeCycleManager, info eon.Info) {
 	// expense
 	di.Provide(c, postgres.NewExpenseRepository)
 	di.Provide(c, postgres.NewScheduledExpenseRepository)
+	di.Provide(c, postgres.NewImportCandidateRepository)
+	di.Provide(c, postgres.NewAttachmentRepository)
+	di.Provide(c, postgres.NewBudgetRepository)
 	di.Provide(c, usecase.NewCreateExpense)
 	di.Provide(c, usecase.NewUpdateExpense)
 	di.Provide(c, usecase.NewDeleteExpense)
 	di.Provide(c, usecase.NewRecalculateExpensesSplitRatio)
 	di.Provide(c, usecase.NewCreateScheduledExpense)
+	di.Provide(c, usecase.NewGetScheduledExpenses)
+	di.Provide(c, usecase.NewGetScheduledExpense)
+	di.Provide(c, usecase.NewUpdateScheduledExpense)
+	di.Provide(c, usecase.NewPauseScheduledExpense)
+	di.Provide(c, usecase.NewResumeScheduledExpense)
+	di.Provide(c, usecase.NewDeleteScheduledExpense)
+	di.Provide(c, usecase.NewPreviewScheduledExpense)
 	di.Provide(c, usecase.NewGenerateExpensesFromScheduledUseCase)
 	di.Provide(c, usecase.NewPredictExpenseCategory)
+	di.Provide(c, usecase.NewGetExpenseHistory)
+	di.Provide(c, usecase.NewRevertExpense)
+	di.Provide(c, usecase.NewRestoreExpense)
+	di.Provide(c, usecase.NewPurgeDeletedExpenses)
+	di.Provide(c, usecase.NewImportStatement)
+	di.Provide(c, usecase.NewGetImportCandidates)
+	di.Provide(c, usecase.NewUpdateImportCandidate)
+	di.Provide(c, usecase.NewConfirmImportCandidate)
+	di.Provide(c, usecase.NewDiscardImportCandidate)
+	di.Provide(c, usecase.NewDismissDuplicates)
+	di.Provide(c, usecase.NewMergeDuplicates)
+	di.Provide(c, usecase.NewUploadAttachment)
+	di.Provide(c, usecase.NewGetAttachments)
+	di.Provide(c, usecase.NewDownloadAttachment)
+	di.Provide(c, usecase.NewDeleteAttachment)
+	di.Provide(c, usecase.NewCreateBudget)
+	di.Provide(c, usecase.NewGetBudgets)
+	di.Provide(c, usecase.NewUpdateBudget)
+	di.Provide(c, usecase.NewDeleteBudget)
+	di.Provide(c, usecase.NewNotifyBudgetAlert)
+	di.Provide(c, usecase.NewGetSpendingForecast)
 	di.Provide(c, postgres.NewGetExpenses)
+	di.Provide(c, postgres.NewExportExpenses)
 	di.Provide(c, postgres.NewGetExpenseDetails)
 	di.Provide(c, postgres.NewGetExpensesPerPeriod)
 	di.Provide(c, postgres.NewGetExpensesPerCategory)
+	di.Provide(c, postgres.NewGetExpensesPerTag)
+	di.Provide(c, postgres.NewGetCashFlow)
+	di.Provide(c, postgres.NewGetExpensesPerMember)
+	di.Provide(c, postgres.NewGetDuplicateClusters)
 	di.Provide(c, controller.NewGetExpenses)
+	di.Provide(c, controller.NewExportExpenses)
 	di.Provide(c, controller.NewCreateExpense)
 	di.Provide(c, controller.NewUpdateExpense)
 	di.Provide(c, controller.NewDeleteExpense)
 	di.Provide(c, controller.NewGetExpenseDetails)
 	di.Provide(c, controller.NewGetExpensesPerPeriod)
 	di.Provide(c, controller.NewGetExpensesPerCategory)
+	di.Provide(c, controller.NewGetExpensesPerTag)
 	di.Provide(c, controller.NewRecalculateExpensesSplitRatio)
-	di.Provide(c, controller.NewGenerateExpensesFromScheduled)
+	di.Provide(c, controller.NewGenerateExpensesFromScheduledJob)
 	di.Provide(c, controller.NewCreateScheduledExpense)
+	di.Provide(c, controller.NewGetScheduledExpenses)
+	di.Provide(c, controller.NewGetScheduledExpense)
+	di.Provide(c, controller.NewUpdateScheduledExpense)
+	di.Provide(c, controller.NewPauseScheduledExpense)
+	di.Provide(c, controller.NewResumeScheduledExpense)
+	di.Provide(c, controller.NewDeleteScheduledExpense)
+	di.Provide(c, controller.NewPreviewScheduledExpense)
 	di.Provide(c, controller.NewCreateExpenseFromScheduled)
 	di.Provide(c, controller.NewPredictExpenseCategory)
+	di.Provide(c, controller.NewGetExpenseHistory)
+	di.Provide(c, controller.NewRevertExpense)
+	di.Provide(c, controller.NewRestoreExpense)
+	di.Provide(c, controller.NewPurgeDeletedExpensesJob)
+	di.Provide(c, controller.NewImportStatement)
+	di.Provide(c, controller.NewGetImportCandidates)
+	di.Provide(c, controller.NewUpdateImportCandidate)
+	di.Provide(c, controller.NewConfirmImportCandidate)
+	di.Provide(c, controller.NewDiscardImportCandidate)
+	di.Provide(c, controller.NewGetDuplicateClusters)
+	di.Provide(c, controller.NewDismissDuplicates)
+	di.Provide(c, controller.NewMergeDuplicates)
+	di.Provide(c, controller.NewUploadAttachment)
+	di.Provide(c, controller.NewGetAttachments)
+	di.Provide(c, controller.NewDownloadAttachment)
+	di.Provide(c, controller.NewDeleteAttachment)
+	di.Provide(c, controller.NewCreateBudget)
+	di.Provide(c, controller.NewGetBudgets)
+	di.Provide(c, controller.NewUpdateBudget)
+	di.Provide(c, controller.NewDeleteBudget)
+	di.Provide(c, controller.NewNotifyBudgetAlerts)
+	di.Provide(c, controller.NewGetSpendingForecast)
+	di.Provide(c, controller.NewGetCashFlow)
+	di.Provide(c, controller.NewGetExpensesPerMember)
+	di.Provide(c, controller.NewGetExpensesComparison)
 	// Register routes
 	lc.OnBooted(eon.HookOrders.APPEND, func() error {
 		return di.Call(c, controller.Router)
 	})
+	// Generate the expenses of the scheduled ones in background
+	lc.OnBooted(eon.HookOrders.APPEND, func() error {
+		cfg := di.Resolve[*nossasdespesas.Config](c)
+		schedule := cfg.Jobs.GenerateScheduledExpenses
+		if schedule == "" {
+			schedule = defaultGenerateScheduledExpensesSchedule
+		}
+
+		lc.Schedule(eon.Job{
+			Name:     "generate-scheduled-expenses",
+			Schedule: schedule,
+			Locker:   di.Resolve[eon.Locker](c),
+			Run:      eon.JobFn(di.Resolve[controller.GenerateExpensesFromScheduledJob](c)),
+		})
+		return nil
+	})
+	// Purge the expenses that stayed in the trash longer than the retention period
+	lc.OnBooted(eon.HookOrders.APPEND, func() error {
+		cfg := di.Resolve[*nossasdespesas.Config](c)
+		lc.Schedule(eon.Job{
+			Name:     "purge-expenses-trash",
+			Schedule: cfg.PurgeTrashSchedule(),
+			Locker:   di.Resolve[eon.Locker](c),
+			Run:      eon.JobFn(di.Resolve[controller.PurgeDeletedExpensesJob](c)),
+		})
+		return nil
+	})
 	// Listen to subscriber
 	lc.OnRunning(eon.HookOrders.APPEND, func() error {
 		recalculate := di.Resolve[controller.RecalculateExpensesSplitRatio](c)
@@ -52,4 +152,10 @@ var Module = eon.NewModule("Expense", func(ctx context.Context, c *di.Container,
 		createExpenseFromScheduled := di.Resolve[controller.CreateExpenseFromScheduled](c)
 		return createExpenseFromScheduled(ctx)
 	})
+
+	// Email the group when an expense takes a budget to an alert threshold
+	lc.OnRunning(eon.HookOrders.APPEND, func() error {
+		notifyBudgetAlerts := di.Resolve[controller.NotifyBudgetAlerts](c)
+		return notifyBudgetAlerts(ctx)
+	})
 })
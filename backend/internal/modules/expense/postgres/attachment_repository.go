@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+const attachmentColumns = `
+	id,
+	expense_id,
+	group_id,
+	file_name,
+	content_type,
+	size_bytes,
+	storage_key,
+	thumbnail_key,
+	uploaded_by,
+	created_at,
+	updated_at,
+	version
+`
+
+type AttachmentRepository struct {
+	db *db.Client
+}
+
+func (repo *AttachmentRepository) GetNextID() expense.AttachmentID {
+	var nextValue int
+
+	conn := repo.db.Conn()
+
+	if err := conn.QueryRowx("SELECT NEXTVAL('expense_attachments_id_seq');").Scan(&nextValue); err != nil {
+		panic(fmt.Errorf("db.QueryRow: %w", err))
+	}
+
+	return expense.AttachmentID{Value: nextValue}
+}
+
+func (repo *AttachmentRepository) GetByID(ctx context.Context, id expense.AttachmentID) (*expense.Attachment, error) {
+	var model AttachmentModel
+
+	conn := repo.db.Executor(ctx)
+
+	if err := conn.QueryRowxContext(ctx, `
+		SELECT `+attachmentColumns+`
+		FROM expense_attachments
+		WHERE id = $1
+	`, id.Value).StructScan(&model); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	entity := ToAttachmentEntity(model)
+
+	return &entity, nil
+}
+
+func (repo *AttachmentRepository) GetByExpenseID(ctx context.Context, expenseID expense.ID) ([]expense.Attachment, error) {
+	conn := repo.db.Executor(ctx)
+	var models []AttachmentModel
+
+	if err := conn.SelectContext(ctx, &models, `
+		SELECT `+attachmentColumns+`
+		FROM expense_attachments
+		WHERE expense_id = $1
+		ORDER BY created_at, id
+	`, expenseID.Value); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	entities := make([]expense.Attachment, 0, len(models))
+	for _, model := range models {
+		entities = append(entities, ToAttachmentEntity(model))
+	}
+
+	return entities, nil
+}
+
+func (repo *AttachmentRepository) Store(ctx context.Context, entity *expense.Attachment) error {
+	return repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		model := ToAttachmentModel(*entity)
+
+		created, err := repo.create(ctx, tx, model)
+		if err != nil {
+			return fmt.Errorf("repo.create: %w", err)
+		}
+
+		if !created {
+			if err := repo.update(ctx, tx, model); err != nil {
+				return fmt.Errorf("repo.update: %w", err)
+			}
+			entity.Version = model.Version + 1
+		}
+
+		return nil
+	})
+}
+
+func (repo *AttachmentRepository) Delete(ctx context.Context, id expense.AttachmentID) error {
+	conn := repo.db.Executor(ctx)
+
+	if _, err := conn.ExecContext(ctx, `DELETE FROM expense_attachments WHERE id = $1`, id.Value); err != nil {
+		return fmt.Errorf("db.Delete: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *AttachmentRepository) create(ctx context.Context, tx *sqlx.Tx, model AttachmentModel) (bool, error) {
+	result, err := tx.NamedExecContext(ctx, `
+		INSERT INTO expense_attachments (`+attachmentColumns+`)
+		VALUES (:id, :expense_id, :group_id, :file_name, :content_type, :size_bytes, :storage_key, :thumbnail_key, :uploaded_by, :created_at, :updated_at, :version)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// update only renames the file and records its thumbnail: the content of an attachment never
+// changes, a new file is uploaded instead.
+func (repo *AttachmentRepository) update(ctx context.Context, tx *sqlx.Tx, model AttachmentModel) error {
+	result, err := tx.NamedExecContext(ctx, `
+		UPDATE expense_attachments SET
+			file_name = :file_name,
+			thumbnail_key = :thumbnail_key,
+			updated_at = :updated_at,
+			version = version + 1
+		WHERE id = :id AND version = :version
+	`, model)
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "expense_attachments", model.ID))
+	}
+
+	return nil
+}
+
+func NewAttachmentRepository(db *db.Client) expense.AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
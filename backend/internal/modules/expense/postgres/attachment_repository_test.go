@@ -0,0 +1,95 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+)
+
+type AttachmentRepositoryTestSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	attachmentRepo expense.AttachmentRepository
+
+	db *db.Client
+}
+
+func TestAttachmentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentRepositoryTestSuite))
+}
+
+func (s *AttachmentRepositoryTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+	s.attachmentRepo = postgres.NewAttachmentRepository(s.db)
+}
+
+func (s *AttachmentRepositoryTestSuite) TearDownTest() {
+	s.NoError(s.db.Clean("expense_attachments"))
+}
+
+func (s *AttachmentRepositoryTestSuite) newAttachment(expenseID int, contentType string) *expense.Attachment {
+	attachment, err := expense.NewAttachment(expense.AttachmentAttributes{
+		ID:          s.attachmentRepo.GetNextID(),
+		ExpenseID:   expense.ID{Value: expenseID},
+		GroupID:     group.ID{Value: 1},
+		FileName:    "recibo",
+		ContentType: contentType,
+		Size:        2048,
+		UploadedBy:  user.ID{Value: 1},
+	})
+	s.NoError(err)
+
+	return attachment
+}
+
+func (s *AttachmentRepositoryTestSuite) TestPgAttachmentRepo_Store() {
+	attachment := s.newAttachment(1, "image/png")
+	s.NoError(s.attachmentRepo.Store(s.ctx, attachment))
+
+	attachment.SetThumbnail()
+	s.NoError(s.attachmentRepo.Store(s.ctx, attachment))
+	s.Equal(1, attachment.Version)
+
+	stored, err := s.attachmentRepo.GetByID(s.ctx, attachment.ID)
+	s.NoError(err)
+	s.Equal(attachment.StorageKey, stored.StorageKey)
+	s.Equal(attachment.ThumbnailKey, stored.ThumbnailKey)
+	s.Equal(2048, stored.Size)
+	s.Equal(1, stored.Version)
+}
+
+func (s *AttachmentRepositoryTestSuite) TestPgAttachmentRepo_GetByExpenseID() {
+	first := s.newAttachment(1, "image/jpeg")
+	second := s.newAttachment(1, "application/pdf")
+	other := s.newAttachment(2, "application/pdf")
+	for _, attachment := range []*expense.Attachment{first, second, other} {
+		s.NoError(s.attachmentRepo.Store(s.ctx, attachment))
+	}
+
+	attachments, err := s.attachmentRepo.GetByExpenseID(s.ctx, expense.ID{Value: 1})
+	s.NoError(err)
+	s.Len(attachments, 2)
+	s.Equal(first.ID, attachments[0].ID)
+	s.Nil(attachments[1].ThumbnailKey)
+}
+
+func (s *AttachmentRepositoryTestSuite) TestPgAttachmentRepo_Delete() {
+	attachment := s.newAttachment(1, "application/pdf")
+	s.NoError(s.attachmentRepo.Store(s.ctx, attachment))
+
+	s.NoError(s.attachmentRepo.Delete(s.ctx, attachment.ID))
+
+	stored, err := s.attachmentRepo.GetByID(s.ctx, attachment.ID)
+	s.NoError(err)
+	s.Nil(stored)
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+const budgetColumns = `
+	id,
+	group_id,
+	category_id,
+	category_group_id,
+	amount_cents,
+	carry_over,
+	created_at,
+	updated_at,
+	version
+`
+
+type BudgetRepository struct {
+	db *db.Client
+}
+
+func (repo *BudgetRepository) GetNextID() expense.BudgetID {
+	var nextValue int
+
+	conn := repo.db.Conn()
+
+	if err := conn.QueryRowx("SELECT NEXTVAL('budgets_id_seq');").Scan(&nextValue); err != nil {
+		panic(fmt.Errorf("db.QueryRow: %w", err))
+	}
+
+	return expense.BudgetID{Value: nextValue}
+}
+
+func (repo *BudgetRepository) GetByID(ctx context.Context, id expense.BudgetID) (*expense.Budget, error) {
+	var model BudgetModel
+
+	conn := repo.db.Executor(ctx)
+
+	if err := conn.QueryRowxContext(ctx, `
+		SELECT `+budgetColumns+`
+		FROM budgets
+		WHERE id = $1
+	`, id.Value).StructScan(&model); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	entity := ToBudgetEntity(model)
+
+	return &entity, nil
+}
+
+func (repo *BudgetRepository) GetByGroupID(ctx context.Context, groupID group.ID) ([]expense.Budget, error) {
+	conn := repo.db.Executor(ctx)
+	var models []BudgetModel
+
+	if err := conn.SelectContext(ctx, &models, `
+		SELECT `+budgetColumns+`
+		FROM budgets
+		WHERE group_id = $1
+		ORDER BY id
+	`, groupID.Value); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	entities := make([]expense.Budget, 0, len(models))
+	for _, model := range models {
+		entities = append(entities, ToBudgetEntity(model))
+	}
+
+	return entities, nil
+}
+
+func (repo *BudgetRepository) GetMonthlySpending(ctx context.Context, budget expense.Budget, until time.Time) ([]int, error) {
+	var categoryID, categoryGroupID sql.NullInt64
+	if budget.CategoryID != nil {
+		categoryID = sql.NullInt64{Int64: int64(budget.CategoryID.Value), Valid: true}
+	}
+	if budget.CategoryGroupID != nil {
+		categoryGroupID = sql.NullInt64{Int64: int64(budget.CategoryGroupID.Value), Valid: true}
+	}
+
+	// Months without expenses still take a place in the result, as zero, for the carry over
+	var spending []int
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &spending, `
+		WITH spending AS (
+			SELECT
+				DATE_TRUNC('month', ex.created_at AT TIME ZONE 'UTC') AS month,
+				SUM(ex.amount_cents) AS amount
+			FROM expenses_latest ex
+			INNER JOIN categories cat ON ex.category_id = cat.id
+			WHERE ex.group_id = $1
+			AND ex.created_at >= $2::timestamptz
+			AND ex.created_at < $3::timestamptz + INTERVAL '1 month'
+			AND ex.deleted_at IS NULL
+			AND cat.deleted_at IS NULL
+			AND (ex.category_id = $4 OR cat.category_group_id = $5)
+			GROUP BY 1
+		)
+		SELECT COALESCE(s.amount, 0)
+		FROM GENERATE_SERIES($2::timestamptz AT TIME ZONE 'UTC', $3::timestamptz AT TIME ZONE 'UTC', INTERVAL '1 month') AS m(month)
+		LEFT JOIN spending s ON s.month = m.month
+		ORDER BY m.month
+	`, budget.GroupID.Value, budget.StartMonth(), expense.MonthOf(until), categoryID, categoryGroupID); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	return spending, nil
+}
+
+func (repo *BudgetRepository) Store(ctx context.Context, entity *expense.Budget) error {
+	return repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		model := ToBudgetModel(*entity)
+
+		created, err := repo.create(ctx, tx, model)
+		if err != nil {
+			return fmt.Errorf("repo.create: %w", err)
+		}
+
+		if !created {
+			if err := repo.update(ctx, tx, model); err != nil {
+				return fmt.Errorf("repo.update: %w", err)
+			}
+			entity.Version = model.Version + 1
+		}
+
+		return nil
+	})
+}
+
+func (repo *BudgetRepository) Delete(ctx context.Context, id expense.BudgetID) error {
+	if _, err := repo.db.Executor(ctx).ExecContext(ctx, `DELETE FROM budgets WHERE id = $1`, id.Value); err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// create inserts the budget unless it already exists, reporting whether it did. A second budget
+// for the same category or category group is still refused by the unique indexes.
+func (repo *BudgetRepository) create(ctx context.Context, tx *sqlx.Tx, model BudgetModel) (bool, error) {
+	result, err := tx.NamedExecContext(ctx, `
+		INSERT INTO budgets (`+budgetColumns+`)
+		VALUES (:id, :group_id, :category_id, :category_group_id, :amount_cents, :carry_over, :created_at, :updated_at, :version)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repo *BudgetRepository) update(ctx context.Context, tx *sqlx.Tx, model BudgetModel) error {
+	result, err := tx.NamedExecContext(ctx, `
+		UPDATE budgets SET
+			amount_cents = :amount_cents,
+			carry_over = :carry_over,
+			updated_at = :updated_at,
+			version = version + 1
+		WHERE id = :id AND version = :version
+	`, model)
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "budgets", model.ID))
+	}
+
+	return nil
+}
+
+func NewBudgetRepository(db *db.Client) expense.BudgetRepository {
+	return &BudgetRepository{db: db}
+}
@@ -0,0 +1,135 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/fixture"
+)
+
+type BudgetRepositoryTestSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	budgetRepo expense.BudgetRepository
+
+	db *db.Client
+}
+
+func TestBudgetRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetRepositoryTestSuite))
+}
+
+func (s *BudgetRepositoryTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+	s.budgetRepo = postgres.NewBudgetRepository(s.db)
+}
+
+func (s *BudgetRepositoryTestSuite) TearDownTest() {
+	s.NoError(s.db.Clean())
+}
+
+func (s *BudgetRepositoryTestSuite) newBudget(attr expense.BudgetAttributes) *expense.Budget {
+	attr.ID = s.budgetRepo.GetNextID()
+	budget, err := expense.NewBudget(attr)
+	s.NoError(err)
+
+	return budget
+}
+
+func (s *BudgetRepositoryTestSuite) TestPgBudgetRepo_Store() {
+	budget := s.newBudget(expense.BudgetAttributes{
+		GroupID:    group.ID{Value: 1},
+		CategoryID: &category.ID{Value: 1},
+		Amount:     50000,
+	})
+	s.NoError(s.budgetRepo.Store(s.ctx, budget))
+
+	amount, carryOver := 60000, true
+	s.NoError(budget.Update(expense.UpdateBudgetAttributes{Amount: &amount, CarryOver: &carryOver}))
+	s.NoError(s.budgetRepo.Store(s.ctx, budget))
+
+	stored, err := s.budgetRepo.GetByID(s.ctx, budget.ID)
+	s.NoError(err)
+	s.Equal(60000, stored.Amount)
+	s.True(stored.CarryOver)
+	s.Equal(&category.ID{Value: 1}, stored.CategoryID)
+	s.Nil(stored.CategoryGroupID)
+	s.Equal(1, stored.Version)
+
+	// Stale versions are refused
+	budget.Version = 0
+	s.Error(s.budgetRepo.Store(s.ctx, budget))
+}
+
+func (s *BudgetRepositoryTestSuite) TestPgBudgetRepo_OneBudgetPerScope() {
+	attr := expense.BudgetAttributes{
+		GroupID:         group.ID{Value: 1},
+		CategoryGroupID: &category.GroupID{Value: 1},
+		Amount:          50000,
+	}
+	s.NoError(s.budgetRepo.Store(s.ctx, s.newBudget(attr)))
+	s.Error(s.budgetRepo.Store(s.ctx, s.newBudget(attr)))
+
+	attr.GroupID = group.ID{Value: 2}
+	s.NoError(s.budgetRepo.Store(s.ctx, s.newBudget(attr)))
+}
+
+func (s *BudgetRepositoryTestSuite) TestPgBudgetRepo_GetByGroupIDAndDelete() {
+	first := s.newBudget(expense.BudgetAttributes{GroupID: group.ID{Value: 1}, CategoryID: &category.ID{Value: 1}, Amount: 10000})
+	second := s.newBudget(expense.BudgetAttributes{GroupID: group.ID{Value: 1}, CategoryGroupID: &category.GroupID{Value: 1}, Amount: 20000})
+	other := s.newBudget(expense.BudgetAttributes{GroupID: group.ID{Value: 2}, CategoryID: &category.ID{Value: 1}, Amount: 30000})
+	for _, budget := range []*expense.Budget{first, second, other} {
+		s.NoError(s.budgetRepo.Store(s.ctx, budget))
+	}
+
+	budgets, err := s.budgetRepo.GetByGroupID(s.ctx, group.ID{Value: 1})
+	s.NoError(err)
+	s.Len(budgets, 2)
+	s.Equal(first.ID, budgets[0].ID)
+	s.Equal(second.ID, budgets[1].ID)
+
+	s.NoError(s.budgetRepo.Delete(s.ctx, first.ID))
+
+	deleted, err := s.budgetRepo.GetByID(s.ctx, first.ID)
+	s.NoError(err)
+	s.Nil(deleted)
+}
+
+func (s *BudgetRepositoryTestSuite) TestPgBudgetRepo_GetMonthlySpending() {
+	s.NoError(fixture.ExecuteSQLFiles(s.db, []string{
+		"./fixtures/basic_setup.sql",
+		"./fixtures/budgets.sql",
+	}))
+
+	groupBudget := s.newBudget(expense.BudgetAttributes{GroupID: group.ID{Value: 100}, CategoryGroupID: &category.GroupID{Value: 100}, Amount: 10000})
+	groupBudget.CreatedAt = time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	// March, April without expenses and May; the deleted expense, the ride and the other group are
+	// left out
+	spending, err := s.budgetRepo.GetMonthlySpending(s.ctx, *groupBudget, time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC))
+	s.NoError(err)
+	s.Equal([]int{8000, 0, 9000}, spending)
+
+	categoryBudget := s.newBudget(expense.BudgetAttributes{GroupID: group.ID{Value: 100}, CategoryID: &category.ID{Value: 100}, Amount: 10000})
+	categoryBudget.CreatedAt = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	spending, err = s.budgetRepo.GetMonthlySpending(s.ctx, *categoryBudget, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC))
+	s.NoError(err)
+	s.Equal([]int{3000, 0}, spending)
+
+	// Months before the budget existed have nothing to report
+	spending, err = s.budgetRepo.GetMonthlySpending(s.ctx, *categoryBudget, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	s.NoError(err)
+	s.Empty(spending)
+}
@@ -11,32 +11,34 @@ import (
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 type ExpenseRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
-func (repo *ExpenseRepository) BulkStore(ctx context.Context, expenses []expense.Expense) error {
-	var models []ExpenseModel
-	for _, expns := range expenses {
-		models = append(models, ToModel(&expns))
-	}
+func (repo *ExpenseRepository) BulkStore(ctx context.Context, expenses []expense.Expense, events ...outbox.Event) error {
+	return repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		for _, expns := range expenses {
+			if err := repo.insert(ctx, ToModel(&expns)); err != nil {
+				return err
+			}
+		}
 
-	if _, err := repo.db.NamedExecContext(ctx, `
-		INSERT INTO expenses (id, name, amount_cents, refund_amount_cents, description, group_id, category_id, split_ratio, split_type, payer_id, receiver_id, created_at, updated_at, deleted_at, version)
-    VALUES (:id, :name, :amount_cents, :refund_amount_cents, :description, :group_id, :category_id, :split_ratio, :split_type, :payer_id, :receiver_id, :created_at, :updated_at, :deleted_at, :version)
-	`, models); err != nil {
-		return fmt.Errorf("db.ExecContext: %w", err)
-	}
+		if err := outbox.Write(ctx, tx, events...); err != nil {
+			return fmt.Errorf("outbox.Write: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (repo *ExpenseRepository) GetByGroupDate(ctx context.Context, groupId group.ID, date time.Time) ([]expense.Expense, error) {
 	var models []ExpenseModel
-	if err := repo.db.SelectContext(ctx, &models, ` 
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &models, ` 
 		SELECT
 			id,
 			name, 
@@ -49,10 +51,15 @@ func (repo *ExpenseRepository) GetByGroupDate(ctx context.Context, groupId group
 			receiver_id, 
 			split_ratio, 
 			split_type, 
+			purchase_id,
+			installment_number,
+			installment_total,
+			tag_ids,
 			created_at, 
 			updated_at, 
 			deleted_at, 
-			version
+			version,
+			updated_by
 		FROM expenses_latest
 		WHERE group_id = $1
 		AND EXTRACT(MONTH FROM created_at) = $2
@@ -75,10 +82,177 @@ func (repo *ExpenseRepository) GetByGroupDate(ctx context.Context, groupId group
 	return expenses, nil
 }
 
+func (repo *ExpenseRepository) GetByPurchaseID(ctx context.Context, purchaseID string) ([]expense.Expense, error) {
+	var models []ExpenseModel
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &models, `
+		SELECT
+			id,
+			name,
+			amount_cents,
+			refund_amount_cents,
+			description,
+			group_id,
+			category_id,
+			payer_id,
+			receiver_id,
+			split_ratio,
+			split_type,
+			purchase_id,
+			installment_number,
+			installment_total,
+			tag_ids,
+			created_at,
+			updated_at,
+			deleted_at,
+			version,
+			updated_by
+		FROM expenses_latest
+		WHERE purchase_id = $1
+		AND deleted_at IS NULL
+		ORDER BY installment_number
+	`, purchaseID); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	var expenses []expense.Expense
+	for _, model := range models {
+		expenses = append(expenses, *ToEntity(model))
+	}
+
+	return expenses, nil
+}
+
+func (repo *ExpenseRepository) GetByTagIDs(ctx context.Context, groupID group.ID, tagIDs []tag.ID) ([]expense.Expense, error) {
+	ids := make([]int, len(tagIDs))
+	for i, tagID := range tagIDs {
+		ids[i] = tagID.Value
+	}
+
+	var models []ExpenseModel
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &models, `
+		SELECT
+			id,
+			name,
+			amount_cents,
+			refund_amount_cents,
+			description,
+			group_id,
+			category_id,
+			payer_id,
+			receiver_id,
+			split_ratio,
+			split_type,
+			purchase_id,
+			installment_number,
+			installment_total,
+			tag_ids,
+			created_at,
+			updated_at,
+			deleted_at,
+			version,
+			updated_by
+		FROM expenses_latest
+		WHERE group_id = $1
+		AND tag_ids && $2::bigint[]
+		ORDER BY id
+	`, groupID.Value, ids); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	var expenses []expense.Expense
+	for _, model := range models {
+		expenses = append(expenses, *ToEntity(model))
+	}
+
+	return expenses, nil
+}
+
+func (repo *ExpenseRepository) GetPossibleDuplicates(ctx context.Context, entity expense.Expense) ([]expense.Expense, error) {
+	var purchaseID *string
+	if entity.Installment != nil {
+		purchaseID = &entity.Installment.PurchaseID
+	}
+
+	var models []ExpenseModel
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &models, `
+		SELECT
+			id,
+			name,
+			amount_cents,
+			refund_amount_cents,
+			description,
+			group_id,
+			category_id,
+			payer_id,
+			receiver_id,
+			split_ratio,
+			split_type,
+			purchase_id,
+			installment_number,
+			installment_total,
+			tag_ids,
+			created_at,
+			updated_at,
+			deleted_at,
+			version,
+			updated_by
+		FROM expenses_latest ex
+		WHERE group_id = $1
+		AND id <> $2
+		AND amount_cents = $3
+		AND created_at BETWEEN $4 AND $5
+		AND deleted_at IS NULL
+		AND (purchase_id IS NULL OR purchase_id IS DISTINCT FROM $6)
+		AND `+similarNames("ex.name", nameLexemes("ex"), "$7::text", "tsvector_to_array(to_tsvector('portuguese', $7::text))")+`
+		ORDER BY created_at DESC, id DESC
+	`,
+		entity.GroupID.Value,
+		entity.ID.Value,
+		entity.Amount,
+		entity.CreatedAt.Add(-expense.DuplicateWindow),
+		entity.CreatedAt.Add(expense.DuplicateWindow),
+		purchaseID,
+		entity.Name,
+	); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	expenses := make([]expense.Expense, 0, len(models))
+	for _, model := range models {
+		expenses = append(expenses, *ToEntity(model))
+	}
+
+	return expenses, nil
+}
+
+func (repo *ExpenseRepository) DismissDuplicates(ctx context.Context, groupID group.ID, ids []expense.ID) error {
+	return repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		for i := range ids {
+			for j := i + 1; j < len(ids); j++ {
+				// Pairs are stored lowest id first, so each one is recorded once
+				first, second := min(ids[i].Value, ids[j].Value), max(ids[i].Value, ids[j].Value)
+				if first == second {
+					continue
+				}
+
+				if _, err := tx.ExecContext(ctx, `
+					INSERT INTO expense_duplicate_dismissals (expense_id, other_expense_id, group_id, created_at)
+					VALUES ($1, $2, $3, $4)
+					ON CONFLICT DO NOTHING
+				`, first, second, groupID.Value, time.Now()); err != nil {
+					return fmt.Errorf("db.ExecContext: %w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
 func (repo *ExpenseRepository) GetNextID() expense.ID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT nextval('expenses_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT nextval('expenses_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.QueryRow: %w", err))
 	}
 
@@ -88,7 +262,7 @@ func (repo *ExpenseRepository) GetNextID() expense.ID {
 func (repo *ExpenseRepository) GetByID(ctx context.Context, id expense.ID) (*expense.Expense, error) {
 	var model ExpenseModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT 
 			id, 
 			name, 
@@ -101,10 +275,15 @@ func (repo *ExpenseRepository) GetByID(ctx context.Context, id expense.ID) (*exp
 			receiver_id, 
 			split_ratio, 
 			split_type, 
+			purchase_id,
+			installment_number,
+			installment_total,
+			tag_ids,
 			created_at, 
 			updated_at, 
 			deleted_at, 
-			version
+			version,
+			updated_by
 		FROM expenses_latest 
 		WHERE id = $1 AND deleted_at IS NULL
 	`, id.Value).StructScan(&model); err != nil {
@@ -117,19 +296,128 @@ func (repo *ExpenseRepository) GetByID(ctx context.Context, id expense.ID) (*exp
 	return ToEntity(model), nil
 }
 
+func (repo *ExpenseRepository) GetVersions(ctx context.Context, id expense.ID) ([]expense.Expense, error) {
+	var models []ExpenseModel
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &models, `
+		SELECT
+			id,
+			name,
+			amount_cents,
+			refund_amount_cents,
+			description,
+			group_id,
+			category_id,
+			payer_id,
+			receiver_id,
+			split_ratio,
+			split_type,
+			purchase_id,
+			installment_number,
+			installment_total,
+			tag_ids,
+			created_at,
+			updated_at,
+			deleted_at,
+			version,
+			updated_by
+		FROM expenses
+		WHERE id = $1
+		ORDER BY version
+	`, id.Value); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	expenses := make([]expense.Expense, 0, len(models))
+	for _, model := range models {
+		expenses = append(expenses, *ToEntity(model))
+	}
+
+	return expenses, nil
+}
+
+func (repo *ExpenseRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, []expense.Attachment, error) {
+	var (
+		purged      []int
+		attachments []AttachmentModel
+	)
+	if err := repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if err := tx.SelectContext(ctx, &purged, `
+			WITH purged AS (
+				DELETE FROM expenses
+				WHERE id IN (
+					SELECT id FROM expenses_latest
+					WHERE deleted_at IS NOT NULL AND deleted_at < $1
+				)
+				RETURNING id
+			)
+			SELECT DISTINCT id FROM purged
+		`, deletedBefore); err != nil {
+			return fmt.Errorf("tx.SelectContext: %w", err)
+		}
+
+		if len(purged) == 0 {
+			return nil
+		}
+
+		if err := tx.SelectContext(ctx, &attachments, `
+			DELETE FROM expense_attachments
+			WHERE expense_id = ANY($1)
+			RETURNING `+attachmentColumns, purged); err != nil {
+			return fmt.Errorf("tx.SelectContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM expense_duplicate_dismissals
+			WHERE expense_id = ANY($1) OR other_expense_id = ANY($1)
+		`, purged); err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	entities := make([]expense.Attachment, 0, len(attachments))
+	for _, model := range attachments {
+		entities = append(entities, ToAttachmentEntity(model))
+	}
+
+	return len(purged), entities, nil
+}
+
 func (repo *ExpenseRepository) Store(ctx context.Context, entity *expense.Expense) error {
-	model := ToModel(entity)
+	return repo.insert(ctx, ToModel(entity))
+}
+
+func (repo *ExpenseRepository) StoreWithEvents(ctx context.Context, entity *expense.Expense, events ...outbox.Event) error {
+	return repo.BulkStore(ctx, []expense.Expense{*entity}, events...)
+}
+
+// insert appends a new version of the expense. Versions are written once, so finding the version
+// already taken means the expense changed since it was read.
+func (repo *ExpenseRepository) insert(ctx context.Context, model ExpenseModel) error {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
+		INSERT INTO expenses (id, name, amount_cents, refund_amount_cents, description, group_id, category_id, split_ratio, split_type, payer_id, receiver_id, purchase_id, installment_number, installment_total, tag_ids, created_at, updated_at, deleted_at, version, updated_by)
+    VALUES (:id, :name, :amount_cents, :refund_amount_cents, :description, :group_id, :category_id, :split_ratio, :split_type, :payer_id, :receiver_id, :purchase_id, :installment_number, :installment_total, :tag_ids, :created_at, :updated_at, :deleted_at, :version, :updated_by)
+		ON CONFLICT (id, version) DO NOTHING
+	`, &model)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
 
-	if _, err := repo.db.NamedExecContext(ctx, `
-		INSERT INTO expenses (id, name, amount_cents, refund_amount_cents, description, group_id, category_id, split_ratio, split_type, payer_id, receiver_id, created_at, updated_at, deleted_at, version)
-    VALUES (:id, :name, :amount_cents, :refund_amount_cents, :description, :group_id, :category_id, :split_ratio, :split_type, :payer_id, :receiver_id, :created_at, :updated_at, :deleted_at, :version)
-	`, &model); err != nil {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
 		return fmt.Errorf("db.ExecContext: %w", err)
 	}
 
+	if rowsAffected == 0 {
+		return fmt.Errorf("db.ExecContext: %w", repo.db.VersionConflict(ctx, "expenses", model.ID))
+	}
+
 	return nil
 }
 
 func NewExpenseRepository(db *db.Client) expense.Repository {
-	return &ExpenseRepository{db: db.Conn()}
+	return &ExpenseRepository{db: db}
 }
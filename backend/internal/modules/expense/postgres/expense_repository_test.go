@@ -13,10 +13,12 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	grouprepo "github.com/Beigelman/nossas-despesas/internal/modules/group/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	userrepo "github.com/Beigelman/nossas-despesas/internal/modules/user/postgres"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 )
 
 type ExpenseRepositoryTestSuite struct {
@@ -102,19 +104,161 @@ func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_Store() {
 		Description: "My Description",
 		PayerID:     s.payer.ID,
 		ReceiverID:  s.receiver.ID,
-		SplitRatio: expense.SplitRatio{
-			Payer:    50,
-			Receiver: 50,
-		},
-		SplitType:  expense.SplitTypes.Equal,
-		CategoryID: s.category.ID,
-		GroupID:    s.group.ID,
+		SplitRatio:  expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+		SplitType:   expense.SplitTypes.Equal,
+		CategoryID:  s.category.ID,
+		GroupID:     s.group.ID,
 	})
 	s.NoError(err)
 
 	s.NoError(s.expenseRepo.Store(s.ctx, expected))
 }
 
+func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_StoreVersionConflict() {
+	expns, err := expense.New(expense.Attributes{
+		ID:          s.expenseRepo.GetNextID(),
+		Name:        "my first expense",
+		Amount:      100,
+		Description: "My Description",
+		PayerID:     s.payer.ID,
+		ReceiverID:  s.receiver.ID,
+		SplitRatio:  expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+		SplitType:   expense.SplitTypes.Equal,
+		CategoryID:  s.category.ID,
+		GroupID:     s.group.ID,
+	})
+	s.NoError(err)
+	s.NoError(s.expenseRepo.Store(s.ctx, expns))
+
+	first, err := s.expenseRepo.GetByID(s.ctx, expns.ID)
+	s.NoError(err)
+	second, err := s.expenseRepo.GetByID(s.ctx, expns.ID)
+	s.NoError(err)
+
+	name := "renamed"
+	s.NoError(first.Update(expense.UpdateAttributes{Name: &name}))
+	s.NoError(s.expenseRepo.Store(s.ctx, first))
+
+	amount := 200
+	s.NoError(second.Update(expense.UpdateAttributes{Amount: &amount}))
+	err = s.expenseRepo.BulkStore(s.ctx, []expense.Expense{*second})
+	var conflict *ddd.VersionConflictError
+	s.ErrorAs(err, &conflict)
+	s.Equal(1, conflict.CurrentVersion)
+
+	actual, err := s.expenseRepo.GetByID(s.ctx, expns.ID)
+	s.NoError(err)
+	s.Equal(name, actual.Name)
+	s.Equal(100, actual.Amount)
+}
+
+func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetVersions() {
+	expns, err := expense.New(expense.Attributes{
+		ID:          s.expenseRepo.GetNextID(),
+		Name:        "my first expense",
+		Amount:      100,
+		Description: "My Description",
+		PayerID:     s.payer.ID,
+		ReceiverID:  s.receiver.ID,
+		SplitRatio:  expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+		SplitType:   expense.SplitTypes.Equal,
+		CategoryID:  s.category.ID,
+		GroupID:     s.group.ID,
+		CreatedBy:   &s.payer.ID,
+	})
+	s.NoError(err)
+	s.NoError(s.expenseRepo.Store(s.ctx, expns))
+
+	name := "renamed"
+	s.NoError(expns.Update(expense.UpdateAttributes{Name: &name, UpdatedBy: &s.receiver.ID}))
+	s.NoError(s.expenseRepo.Store(s.ctx, expns))
+
+	expns.Delete(nil)
+	s.NoError(s.expenseRepo.Store(s.ctx, expns))
+
+	versions, err := s.expenseRepo.GetVersions(s.ctx, expns.ID)
+	s.NoError(err)
+	s.Len(versions, 3)
+	s.Equal(&s.payer.ID, versions[0].UpdatedBy)
+	s.Equal("renamed", versions[1].Name)
+	s.Equal(&s.receiver.ID, versions[1].UpdatedBy)
+	s.NotNil(versions[2].DeletedAt)
+	s.Nil(versions[2].UpdatedBy)
+}
+
+func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_PurgeDeleted() {
+	newExpense := func(name string) *expense.Expense {
+		expns, err := expense.New(expense.Attributes{
+			ID:         s.expenseRepo.GetNextID(),
+			Name:       name,
+			Amount:     100,
+			PayerID:    s.payer.ID,
+			ReceiverID: s.receiver.ID,
+			SplitRatio: expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+			SplitType:  expense.SplitTypes.Equal,
+			CategoryID: s.category.ID,
+			GroupID:    s.group.ID,
+		})
+		s.NoError(err)
+		s.NoError(s.expenseRepo.Store(s.ctx, expns))
+		return expns
+	}
+
+	kept := newExpense("kept")
+	restored := newExpense("restored")
+	purged := newExpense("purged")
+
+	restored.Delete(nil)
+	s.NoError(s.expenseRepo.Store(s.ctx, restored))
+	restored.Restore(nil)
+	s.NoError(s.expenseRepo.Store(s.ctx, restored))
+
+	purged.Delete(nil)
+	s.NoError(s.expenseRepo.Store(s.ctx, purged))
+
+	attachmentRepo := postgres.NewAttachmentRepository(s.db)
+	attachment, err := expense.NewAttachment(expense.AttachmentAttributes{
+		ID:          attachmentRepo.GetNextID(),
+		ExpenseID:   purged.ID,
+		GroupID:     s.group.ID,
+		FileName:    "recibo",
+		ContentType: "image/png",
+		Size:        2048,
+		UploadedBy:  s.payer.ID,
+	})
+	s.NoError(err)
+	attachment.SetThumbnail()
+	s.NoError(attachmentRepo.Store(s.ctx, attachment))
+	s.NoError(s.expenseRepo.DismissDuplicates(s.ctx, s.group.ID, []expense.ID{kept.ID, restored.ID, purged.ID}))
+
+	count, attachments, err := s.expenseRepo.PurgeDeleted(s.ctx, time.Now())
+	s.NoError(err)
+	// Expenses deleted by other tests of the suite are purged as well
+	s.GreaterOrEqual(count, 1)
+	s.Contains(attachments, *attachment)
+
+	versions, err := s.expenseRepo.GetVersions(s.ctx, purged.ID)
+	s.NoError(err)
+	s.Empty(versions)
+
+	remaining, err := attachmentRepo.GetByExpenseID(s.ctx, purged.ID)
+	s.NoError(err)
+	s.Empty(remaining)
+
+	// Only the dismissal between the expenses kept is left
+	var dismissals []int
+	s.NoError(s.db.Conn().Select(&dismissals, `
+		SELECT other_expense_id FROM expense_duplicate_dismissals WHERE expense_id = ANY($1) ORDER BY other_expense_id
+	`, []int{kept.ID.Value, restored.ID.Value, purged.ID.Value}))
+	s.Equal([]int{restored.ID.Value}, dismissals)
+
+	for _, id := range []expense.ID{kept.ID, restored.ID} {
+		expns, err := s.expenseRepo.GetByID(s.ctx, id)
+		s.NoError(err)
+		s.NotNil(expns)
+	}
+}
+
 func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetByID() {
 	id := s.expenseRepo.GetNextID()
 	expected, err := expense.New(expense.Attributes{
@@ -124,13 +268,10 @@ func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetByID() {
 		Description: "My Description",
 		PayerID:     s.payer.ID,
 		ReceiverID:  s.receiver.ID,
-		SplitRatio: expense.SplitRatio{
-			Payer:    50,
-			Receiver: 50,
-		},
-		SplitType:  expense.SplitTypes.Equal,
-		CategoryID: s.category.ID,
-		GroupID:    s.group.ID,
+		SplitRatio:  expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+		SplitType:   expense.SplitTypes.Equal,
+		CategoryID:  s.category.ID,
+		GroupID:     s.group.ID,
 	})
 	s.NoError(err)
 
@@ -154,13 +295,10 @@ func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetByGroupDate() {
 			Description: "My Description",
 			PayerID:     s.payer.ID,
 			ReceiverID:  s.receiver.ID,
-			SplitRatio: expense.SplitRatio{
-				Payer:    50,
-				Receiver: 50,
-			},
-			SplitType:  expense.SplitTypes.Equal,
-			CategoryID: s.category.ID,
-			GroupID:    s.group.ID,
+			SplitRatio:  expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+			SplitType:   expense.SplitTypes.Equal,
+			CategoryID:  s.category.ID,
+			GroupID:     s.group.ID,
 		})
 		s.NoError(err)
 		entities = append(entities, *entity)
@@ -171,3 +309,128 @@ func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetByGroupDate() {
 	s.NoError(err)
 	s.Len(expected, 3)
 }
+
+func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetByPurchaseID() {
+	purchaseID := "5f0b6ad1-4f8e-4d83-9d1c-2f0a3d6f5a10"
+	var entities []expense.Expense
+	for number := 3; number >= 1; number-- {
+		entity, err := expense.New(expense.Attributes{
+			ID:          s.expenseRepo.GetNextID(),
+			Name:        "installment",
+			Amount:      100,
+			Description: "My Description",
+			PayerID:     s.payer.ID,
+			ReceiverID:  s.receiver.ID,
+			SplitRatio:  expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+			SplitType:   expense.SplitTypes.Equal,
+			CategoryID:  s.category.ID,
+			GroupID:     s.group.ID,
+			Installment: &expense.Installment{PurchaseID: purchaseID, Number: number, Total: 3},
+		})
+		s.NoError(err)
+		entities = append(entities, *entity)
+	}
+	s.NoError(s.expenseRepo.BulkStore(s.ctx, entities))
+
+	installments, err := s.expenseRepo.GetByPurchaseID(s.ctx, purchaseID)
+	s.NoError(err)
+	s.Len(installments, 3)
+	for i, installment := range installments {
+		s.Equal(expense.Installment{PurchaseID: purchaseID, Number: i + 1, Total: 3}, *installment.Installment)
+	}
+}
+
+func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetByTagIDs() {
+	newExpense := func(tagIDs ...tag.ID) *expense.Expense {
+		expns, err := expense.New(expense.Attributes{
+			ID:         s.expenseRepo.GetNextID(),
+			Name:       "tagged",
+			Amount:     100,
+			PayerID:    s.payer.ID,
+			ReceiverID: s.receiver.ID,
+			SplitRatio: expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+			SplitType:  expense.SplitTypes.Equal,
+			CategoryID: s.category.ID,
+			GroupID:    s.group.ID,
+			TagIDs:     tagIDs,
+		})
+		s.NoError(err)
+		s.NoError(s.expenseRepo.Store(s.ctx, expns))
+		return expns
+	}
+
+	japao, viagem, casamento := tag.ID{Value: 901}, tag.ID{Value: 902}, tag.ID{Value: 903}
+	both := newExpense(japao, casamento)
+	deleted := newExpense(viagem)
+	newExpense(casamento)
+
+	// Deleted expenses are retagged as well, so restoring them brings no removed tag back
+	deleted.Delete(nil)
+	s.NoError(s.expenseRepo.Store(s.ctx, deleted))
+
+	expenses, err := s.expenseRepo.GetByTagIDs(s.ctx, s.group.ID, []tag.ID{japao, viagem})
+	s.NoError(err)
+	s.Len(expenses, 2)
+	s.Equal(both.ID, expenses[0].ID)
+	s.Equal(deleted.ID, expenses[1].ID)
+	s.Equal(1, expenses[1].Version)
+}
+
+func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_GetPossibleDuplicates() {
+	newExpense := func(name string, createdAt time.Time) *expense.Expense {
+		expns, err := expense.New(expense.Attributes{
+			ID:         s.expenseRepo.GetNextID(),
+			Name:       name,
+			Amount:     15890,
+			PayerID:    s.payer.ID,
+			ReceiverID: s.receiver.ID,
+			SplitRatio: expense.NewEqualSplitRatio(s.payer.ID, s.receiver.ID),
+			SplitType:  expense.SplitTypes.Equal,
+			CategoryID: s.category.ID,
+			GroupID:    s.group.ID,
+			CreatedAt:  &createdAt,
+		})
+		s.NoError(err)
+		return expns
+	}
+
+	now := time.Now()
+	entered := newExpense("Mercado Extra", now.Add(-24*time.Hour))
+	imported := newExpense("COMPRA MERCADOS EXTRA", now.Add(-48*time.Hour))
+	otherPlace := newExpense("Farmácia", now)
+	monthBefore := newExpense("Mercado Extra", now.AddDate(0, -1, 0))
+	for _, expns := range []*expense.Expense{entered, imported, otherPlace, monthBefore} {
+		s.NoError(s.expenseRepo.Store(s.ctx, expns))
+	}
+
+	duplicates, err := s.expenseRepo.GetPossibleDuplicates(s.ctx, *newExpense("mercado extra", now))
+	s.NoError(err)
+	s.Len(duplicates, 2)
+	s.Equal(entered.ID, duplicates[0].ID)
+	s.Equal(imported.ID, duplicates[1].ID)
+
+	// The expense itself is never its own duplicate
+	duplicates, err = s.expenseRepo.GetPossibleDuplicates(s.ctx, *otherPlace)
+	s.NoError(err)
+	s.Empty(duplicates)
+}
+
+func (s *ExpenseRepositoryTestSuite) TestPgExpenseRepo_DismissDuplicates() {
+	ids := []expense.ID{{Value: 3}, {Value: 1}, {Value: 2}}
+	s.NoError(s.expenseRepo.DismissDuplicates(s.ctx, s.group.ID, ids))
+	// Dismissing them again is a no-op
+	s.NoError(s.expenseRepo.DismissDuplicates(s.ctx, s.group.ID, ids[:2]))
+
+	var pairs [][2]int
+	rows, err := s.db.Conn().QueryContext(s.ctx, `
+		SELECT expense_id, other_expense_id FROM expense_duplicate_dismissals ORDER BY 1, 2
+	`)
+	s.NoError(err)
+	defer rows.Close()
+	for rows.Next() {
+		var pair [2]int
+		s.NoError(rows.Scan(&pair[0], &pair[1]))
+		pairs = append(pairs, pair)
+	}
+	s.Equal([][2]int{{1, 2}, {1, 3}, {2, 3}}, pairs)
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	ExpenseExportRow struct {
+		ID                int          `db:"id"`
+		CreatedAt         time.Time    `db:"created_at"`
+		Name              string       `db:"name"`
+		Description       string       `db:"description"`
+		CategoryName      string       `db:"category_name"`
+		CategoryGroupName string       `db:"category_group_name"`
+		AmountCents       int          `db:"amount_cents"`
+		RefundAmountCents *int         `db:"refund_amount_cents"`
+		PayerName         string       `db:"payer_name"`
+		ReceiverName      string       `db:"receiver_name"`
+		SplitType         string       `db:"split_type"`
+		Shares            ExportShares `db:"shares"`
+		InstallmentNumber *int         `db:"installment_number"`
+		InstallmentTotal  *int         `db:"installment_total"`
+	}
+
+	// ExportShare is how much of the expense falls on a participant.
+	ExportShare struct {
+		Name        string `json:"name"`
+		Percent     int    `json:"percent"`
+		AmountCents int    `json:"amount"`
+	}
+
+	ExportShares []ExportShare
+
+	// ExportExpenses calls each for every expense matching the filters of the input, in its sort
+	// order, as rows are read from the database. Pagination fields of the input are ignored.
+	ExportExpenses func(ctx context.Context, input GetExpensesInput, each func(row ExpenseExportRow) error) error
+)
+
+func (s *ExportShares) Scan(value any) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, s)
+}
+
+const exportExpensesQuery = `
+	SELECT
+		ex.id,
+		ex.created_at,
+		ex.name,
+		ex.description,
+		cat.name AS category_name,
+		cg.name AS category_group_name,
+		ex.amount_cents,
+		ex.refund_amount_cents,
+		payer.name AS payer_name,
+		receiver.name AS receiver_name,
+		ex.split_type,
+		ex.installment_number,
+		ex.installment_total,
+		COALESCE((
+			SELECT jsonb_agg(jsonb_build_object(
+				'name', u.name,
+				'percent', COALESCE((s.share->>'percent')::int, 0),
+				'amount', CASE
+					WHEN ex.split_type IN ('exact', 'weighted') THEN COALESCE((s.share->>'amount')::int, 0)
+					ELSE ROUND(ex.amount_cents * COALESCE((s.share->>'percent')::numeric, 0) / 100)::int
+				END
+			) ORDER BY s.position)
+			FROM jsonb_array_elements(ex.split_ratio->'shares') WITH ORDINALITY AS s(share, position)
+			JOIN users u ON u.id = (s.share->>'user_id')::bigint
+		), '[]'::jsonb) AS shares
+	FROM expenses_latest ex
+	INNER JOIN categories cat ON ex.category_id = cat.id
+	INNER JOIN category_groups cg ON cat.category_group_id = cg.id
+	INNER JOIN users payer ON ex.payer_id = payer.id
+	INNER JOIN users receiver ON ex.receiver_id = receiver.id
+	WHERE %s
+	ORDER BY %s
+`
+
+func NewExportExpenses(db *db.Client) ExportExpenses {
+	dbClient := db.Conn()
+	return func(ctx context.Context, input GetExpensesInput, each func(row ExpenseExportRow) error) error {
+		var args []any
+		arg := func(value any) string {
+			args = append(args, value)
+			return fmt.Sprintf("$%d", len(args))
+		}
+
+		conditions := expensesConditions(input, arg)
+		query := fmt.Sprintf(exportExpensesQuery, strings.Join(conditions, "\n\tAND "), expensesOrderBy(input))
+
+		rows, err := dbClient.QueryxContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("db.QueryxContext: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var row ExpenseExportRow
+			if err := rows.StructScan(&row); err != nil {
+				return fmt.Errorf("rows.StructScan: %w", err)
+			}
+
+			if err := each(row); err != nil {
+				return err
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("rows.Err: %w", err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,10 @@
+-- Expenses spread over months for the budget tests
+
+INSERT INTO expenses (id, name, amount_cents, description, group_id, category_id, payer_id, receiver_id, split_ratio, split_type, created_at, updated_at, deleted_at, version) VALUES
+(70, 'Restaurante', 3000, 'Jantar em março', 100, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-03-10 12:00:00', '2024-03-10 12:00:00', NULL, 0),
+(71, 'Mercado', 5000, 'Compras de março', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-03-31 23:00:00', '2024-03-31 23:00:00', NULL, 0),
+(72, 'Mercado', 9000, 'Compras de maio', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-05-05 12:00:00', '2024-05-05 12:00:00', NULL, 0),
+(73, 'Mercado', 4000, 'Compra removida', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-05-06 12:00:00', '2024-05-06 12:00:00', '2024-05-07 12:00:00', 0),
+(74, 'Uber', 2000, 'Corrida de maio', 100, 102, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-05-08 12:00:00', '2024-05-08 12:00:00', NULL, 0),
+(75, 'Mercado', 6000, 'Outro grupo', 101, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-05-05 12:00:00', '2024-05-05 12:00:00', NULL, 0),
+(76, 'Mercado', 7000, 'Compras de junho', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-06-05 12:00:00', '2024-06-05 12:00:00', NULL, 0);
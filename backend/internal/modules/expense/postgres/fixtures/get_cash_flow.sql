@@ -0,0 +1,16 @@
+-- Incomes and expenses for get_cash_flow tests
+
+UPDATE users SET group_id = 100 WHERE id IN (100, 101);
+
+INSERT INTO incomes (id, user_id, amount_cents, type, created_at, updated_at, deleted_at, version) VALUES
+(60, 100, 500000, 'salary', '2024-06-05 10:00:00', '2024-06-05 10:00:00', NULL, 0),
+(61, 101, 300000, 'salary', '2024-06-05 10:00:00', '2024-06-05 10:00:00', NULL, 0),
+(62, 101, 99999, 'other', '2024-06-10 10:00:00', '2024-06-10 10:00:00', '2024-06-11 10:00:00', 0),
+(63, 100, 400000, 'salary', '2024-07-05 10:00:00', '2024-07-05 10:00:00', NULL, 0);
+
+INSERT INTO expenses (id, name, amount_cents, refund_amount_cents, description, group_id, category_id, payer_id, receiver_id, split_ratio, split_type, created_at, updated_at, deleted_at, version) VALUES
+(60, 'Mercado', 10000, 2000, 'Compra com devolução', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-06-02 12:00:00', '2024-06-02 12:00:00', NULL, 0),
+(61, 'Aluguel', 30000, NULL, 'Aluguel de junho', 100, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 67, "amount": 20000}, {"user_id": 101, "percent": 33, "amount": 10000}]}', 'exact', '2024-06-10 12:00:00', '2024-06-10 12:00:00', NULL, 0),
+(62, 'Cinema', 5000, NULL, 'Despesa apagada', 100, 103, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-06-12 12:00:00', '2024-06-12 12:00:00', '2024-06-13 12:00:00', 0),
+(63, 'Outro grupo', 7000, NULL, 'Despesa de outro grupo', 101, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-06-02 12:00:00', '2024-06-02 12:00:00', NULL, 0),
+(64, 'Uber', 6000, NULL, 'Despesa de agosto', 100, 102, 101, 100, '{"shares": [{"user_id": 100, "percent": 60}, {"user_id": 101, "percent": 40}]}', 'proportional', '2024-08-03 12:00:00', '2024-08-03 12:00:00', NULL, 0);
@@ -0,0 +1,26 @@
+-- Expenses entered more than once for get_duplicate_clusters tests
+
+INSERT INTO expenses (id, name, amount_cents, description, group_id, category_id, payer_id, receiver_id, split_ratio, split_type, created_at, updated_at, version) VALUES
+-- The same market purchase entered three times, by both members and by a statement import
+(50, 'Mercado Extra', 15890, '', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-01 10:00:00', '2024-02-01 10:00:00', 0),
+(51, 'mercado extra', 15890, 'Compras do mês', 100, 101, 101, 100, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-01 18:00:00', '2024-02-01 18:00:00', 0),
+(52, 'COMPRA MERCADOS EXTRA', 15890, '', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-03 12:00:00', '2024-02-03 12:00:00', 0),
+-- Same amount and name, but weeks apart
+(53, 'Mercado Extra', 15890, '', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-03-01 10:00:00', '2024-03-01 10:00:00', 0),
+-- Same amount and day, but another place
+(54, 'Farmácia', 15890, '', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-01 11:00:00', '2024-02-01 11:00:00', 0),
+-- A pair already dismissed as different purchases
+(55, 'Cinema', 4000, '', 100, 103, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-10 19:00:00', '2024-02-10 19:00:00', 0),
+(56, 'Cinema', 4000, '', 100, 103, 101, 100, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-10 21:00:00', '2024-02-10 21:00:00', 0),
+-- A pair where one of them is in the trash
+(57, 'Uber', 2500, '', 100, 102, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-12 08:00:00', '2024-02-12 08:00:00', 0),
+(58, 'Uber', 2500, '', 100, 102, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-12 08:05:00', '2024-02-12 08:05:00', 0),
+-- A pair in another group
+(59, 'Padaria', 1200, '', 101, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-15 08:00:00', '2024-02-15 08:00:00', 0),
+(60, 'Padaria', 1200, '', 101, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-15 09:00:00', '2024-02-15 09:00:00', 0);
+
+INSERT INTO expenses (id, name, amount_cents, description, group_id, category_id, payer_id, receiver_id, split_ratio, split_type, created_at, updated_at, deleted_at, version) VALUES
+(58, 'Uber', 2500, '', 100, 102, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-02-12 08:05:00', '2024-02-12 09:00:00', '2024-02-12 09:00:00', 1);
+
+INSERT INTO expense_duplicate_dismissals (expense_id, other_expense_id, group_id, created_at) VALUES
+(55, 56, 100, '2024-02-11 10:00:00');
@@ -0,0 +1,8 @@
+-- Expenses paid and shared by the members for get_expenses_per_member tests
+
+INSERT INTO expenses (id, name, amount_cents, refund_amount_cents, description, group_id, category_id, payer_id, receiver_id, split_ratio, split_type, created_at, updated_at, deleted_at, version) VALUES
+(60, 'Mercado', 10000, 2000, 'Compra com devolução', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-06-02 12:00:00', '2024-06-02 12:00:00', NULL, 0),
+(61, 'Jantar', 30000, NULL, 'Jantar de aniversário', 100, 100, 101, 100, '{"shares": [{"user_id": 100, "percent": 67, "amount": 20000}, {"user_id": 101, "percent": 33, "amount": 10000}]}', 'exact', '2024-06-10 20:00:00', '2024-06-10 20:00:00', NULL, 0),
+(62, 'Feira', 6000, NULL, 'Feira de julho', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 60}, {"user_id": 101, "percent": 40}]}', 'proportional', '2024-07-06 09:00:00', '2024-07-06 09:00:00', NULL, 0),
+(63, 'Cinema', 5000, NULL, 'Despesa apagada', 100, 103, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-06-12 12:00:00', '2024-06-12 12:00:00', '2024-06-13 12:00:00', 0),
+(64, 'Outro grupo', 7000, NULL, 'Despesa de outro grupo', 101, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '2024-06-02 12:00:00', '2024-06-02 12:00:00', NULL, 0);
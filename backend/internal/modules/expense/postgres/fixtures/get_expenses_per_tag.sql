@@ -0,0 +1,14 @@
+-- Tagged expenses for get_expenses_per_tag tests
+
+INSERT INTO tags (id, group_id, name, created_at, updated_at, version) VALUES
+(100, 100, 'viagem', '2024-06-01 00:00:00', '2024-06-01 00:00:00', 0),
+(101, 100, 'praia', '2024-06-01 00:00:00', '2024-06-01 00:00:00', 0),
+(102, 101, 'viagem', '2024-06-01 00:00:00', '2024-06-01 00:00:00', 0);
+
+INSERT INTO expenses (id, name, amount_cents, description, group_id, category_id, payer_id, receiver_id, split_ratio, split_type, tag_ids, created_at, updated_at, deleted_at, version) VALUES
+(60, 'Passagem', 50000, 'Passagem de avião', 100, 102, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '{100}', '2024-06-01 12:00:00', '2024-06-01 12:00:00', NULL, 0),
+(61, 'Quiosque', 8000, 'Almoço na praia', 100, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '{100,101}', '2024-06-02 12:00:00', '2024-06-02 12:00:00', NULL, 0),
+(62, 'Mercado', 12000, 'Compras sem tag', 100, 101, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '{}', '2024-06-03 10:00:00', '2024-06-03 10:00:00', NULL, 0),
+(63, 'Hotel', 30000, 'Reserva cancelada', 100, 103, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '{100}', '2024-06-04 10:00:00', '2024-06-04 10:00:00', '2024-06-05 10:00:00', 0),
+(64, 'Passeio', 4000, 'Passeio em maio', 100, 103, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '{101}', '2024-05-20 10:00:00', '2024-05-20 10:00:00', NULL, 0),
+(65, 'Outro grupo', 7000, 'Despesa de outro grupo', 101, 100, 100, 101, '{"shares": [{"user_id": 100, "percent": 50}, {"user_id": 101, "percent": 50}]}', 'equal', '{102}', '2024-06-02 12:00:00', '2024-06-02 12:00:00', NULL, 0);
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+// shareAmountCents is the part of the expense, net of refunds, owed by the member of the
+// `share` element of its split ratio. Exact and weighted shares hold amounts of the gross value,
+// so they are scaled down along with the refund.
+const shareAmountCents = `
+	CASE
+		WHEN ex.split_type IN ('exact', 'weighted') THEN COALESCE((share->>'amount')::numeric, 0) * (ex.amount_cents - COALESCE(ex.refund_amount_cents, 0)) / ex.amount_cents
+		ELSE (ex.amount_cents - COALESCE(ex.refund_amount_cents, 0)) * (share->>'percent')::numeric / 100
+	END
+`
+
+type (
+	MemberCashFlow struct {
+		UserID  int `db:"user_id" json:"user_id"`
+		Expense int `db:"expense" json:"expense"`
+	}
+
+	CashFlow struct {
+		Date       string `db:"date" json:"date"`
+		Income     int    `db:"income" json:"income"`
+		Expense    int    `db:"expense" json:"expense"`
+		NetSavings int    `db:"-" json:"net_savings"`
+		// SavingsRate is the percentage of the income that was saved, nil without income.
+		SavingsRate *float64         `db:"-" json:"savings_rate"`
+		Members     []MemberCashFlow `db:"-" json:"members"`
+	}
+
+	GetCashFlowInput struct {
+		GroupID   int       `json:"group_id"`
+		Aggregate string    `json:"aggregate"`
+		StartDate time.Time `json:"start_date"`
+		EndDate   time.Time `json:"end_date"`
+	}
+
+	GetCashFlow func(ctx context.Context, params GetCashFlowInput) ([]CashFlow, error)
+)
+
+// NewGetCashFlow puts side by side, per period, what the group members earned and what the group
+// spent net of refunds, along with the share of the expenses of each member.
+func NewGetCashFlow(db *db.Client) GetCashFlow {
+	dbClient := db.Conn()
+	return func(ctx context.Context, params GetCashFlowInput) ([]CashFlow, error) {
+		trunc, format := "day", "YYYY-MM-DD"
+		if params.Aggregate == "month" {
+			trunc, format = "month", "YYYY-MM"
+		}
+
+		var cashFlows []CashFlow
+		if err := dbClient.SelectContext(ctx, &cashFlows, fmt.Sprintf(`
+			WITH incomes_per_period AS (
+				SELECT
+					to_char(date_trunc('%[1]s', inc.created_at), '%[2]s') AS date,
+					SUM(inc.amount_cents) AS amount
+				FROM incomes inc
+				WHERE inc.user_id IN (SELECT id FROM users WHERE group_id = $1)
+				AND inc.created_at >= $2
+				AND inc.created_at <= $3
+				AND inc.deleted_at IS NULL
+				GROUP BY 1
+			), expenses_per_period AS (
+				SELECT
+					to_char(date_trunc('%[1]s', ex.created_at), '%[2]s') AS date,
+					SUM(ex.amount_cents - COALESCE(ex.refund_amount_cents, 0)) AS amount
+				FROM expenses_latest ex
+				WHERE ex.group_id = $1
+				AND ex.created_at >= $2
+				AND ex.created_at <= $3
+				AND ex.deleted_at IS NULL
+				GROUP BY 1
+			)
+			SELECT
+				COALESCE(i.date, e.date) AS date,
+				COALESCE(i.amount, 0) AS income,
+				COALESCE(e.amount, 0) AS expense
+			FROM incomes_per_period i
+			FULL OUTER JOIN expenses_per_period e ON e.date = i.date
+			ORDER BY 1;
+		`, trunc, format), params.GroupID, params.StartDate, params.EndDate); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		var members []struct {
+			Date string `db:"date"`
+			MemberCashFlow
+		}
+		if err := dbClient.SelectContext(ctx, &members, fmt.Sprintf(`
+			SELECT
+				to_char(date_trunc('%s', ex.created_at), '%s') AS date,
+				(share->>'user_id')::int AS user_id,
+				ROUND(SUM(%s)) AS expense
+			FROM expenses_latest ex, jsonb_array_elements(ex.split_ratio->'shares') AS share
+			WHERE ex.group_id = $1
+			AND ex.created_at >= $2
+			AND ex.created_at <= $3
+			AND ex.deleted_at IS NULL
+			GROUP BY 1, 2
+			ORDER BY 1, 2;
+		`, trunc, format, shareAmountCents), params.GroupID, params.StartDate, params.EndDate); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		for i := range cashFlows {
+			cashFlow := &cashFlows[i]
+			cashFlow.NetSavings = cashFlow.Income - cashFlow.Expense
+			if cashFlow.Income > 0 {
+				rate := math.Round(float64(cashFlow.NetSavings)*10000/float64(cashFlow.Income)) / 100
+				cashFlow.SavingsRate = &rate
+			}
+
+			cashFlow.Members = []MemberCashFlow{}
+			for _, member := range members {
+				if member.Date == cashFlow.Date {
+					cashFlow.Members = append(cashFlow.Members, member.MemberCashFlow)
+				}
+			}
+		}
+
+		return cashFlows, nil
+	}
+}
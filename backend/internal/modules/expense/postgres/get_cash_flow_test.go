@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/fixture"
+)
+
+type GetCashFlowTestSuite struct {
+	suite.Suite
+	db          *db.Client
+	ctx         context.Context
+	getCashFlow GetCashFlow
+}
+
+func TestGetCashFlowTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetCashFlowTestSuite))
+}
+
+func (s *GetCashFlowTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+
+	err := fixture.ExecuteSQLFiles(s.db, []string{
+		"./fixtures/basic_setup.sql",
+		"./fixtures/get_cash_flow.sql",
+	})
+	s.NoError(err)
+
+	s.getCashFlow = NewGetCashFlow(s.db)
+}
+
+func (s *GetCashFlowTestSuite) TestGetCashFlow_MonthlyAggregation() {
+	result, err := s.getCashFlow(s.ctx, GetCashFlowInput{
+		GroupID:   100,
+		Aggregate: "month",
+		StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 8, 31, 23, 59, 59, 0, time.UTC),
+	})
+	s.NoError(err)
+	s.Len(result, 3)
+
+	// The refund is taken out of the market, the deleted income and expense are left out
+	june := result[0]
+	s.Equal("2024-06", june.Date)
+	s.Equal(800000, june.Income)
+	s.Equal(38000, june.Expense) // 8000 + 30000
+	s.Equal(762000, june.NetSavings)
+	s.Equal(95.25, *june.SavingsRate)
+	s.Equal([]MemberCashFlow{{UserID: 100, Expense: 24000}, {UserID: 101, Expense: 14000}}, june.Members)
+
+	july := result[1]
+	s.Equal("2024-07", july.Date)
+	s.Equal(0, july.Expense)
+	s.Equal(100.0, *july.SavingsRate)
+	s.Empty(july.Members)
+
+	august := result[2]
+	s.Equal("2024-08", august.Date)
+	s.Equal(-6000, august.NetSavings)
+	s.Nil(august.SavingsRate)
+	s.Equal([]MemberCashFlow{{UserID: 100, Expense: 3600}, {UserID: 101, Expense: 2400}}, august.Members)
+}
+
+func (s *GetCashFlowTestSuite) TestGetCashFlow_DailyAggregation() {
+	result, err := s.getCashFlow(s.ctx, GetCashFlowInput{
+		GroupID:   100,
+		Aggregate: "day",
+		StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC),
+	})
+	s.NoError(err)
+
+	dates := make([]string, 0, len(result))
+	for _, cashFlow := range result {
+		dates = append(dates, cashFlow.Date)
+	}
+	s.Equal([]string{"2024-06-02", "2024-06-05", "2024-06-10"}, dates)
+	s.Equal(8000, result[0].Expense)
+	s.Equal(800000, result[1].Income)
+}
+
+func (s *GetCashFlowTestSuite) TestGetCashFlow_EmptyResult() {
+	result, err := s.getCashFlow(s.ctx, GetCashFlowInput{
+		GroupID:   100,
+		Aggregate: "month",
+		StartDate: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 9, 30, 23, 59, 59, 0, time.UTC),
+	})
+	s.NoError(err)
+	s.Empty(result)
+}
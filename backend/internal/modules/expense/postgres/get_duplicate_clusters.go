@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	// DuplicateCluster is a group of expenses suspected of being the same purchase entered more
+	// than once, newest first.
+	DuplicateCluster struct {
+		Expenses []ExpenseDetails `json:"expenses"`
+	}
+
+	GetDuplicateClusters func(ctx context.Context, groupID int) ([]DuplicateCluster, error)
+
+	duplicatePair struct {
+		ExpenseID      int `db:"expense_id"`
+		OtherExpenseID int `db:"other_expense_id"`
+	}
+)
+
+func NewGetDuplicateClusters(db *db.Client) GetDuplicateClusters {
+	dbClient := db.Conn()
+	return func(ctx context.Context, groupID int) ([]DuplicateCluster, error) {
+		var pairs []duplicatePair
+		if err := dbClient.SelectContext(ctx, &pairs, `
+			SELECT
+				ex.id AS expense_id,
+				other.id AS other_expense_id
+			FROM expenses_latest ex
+			INNER JOIN expenses_latest other
+				ON other.group_id = ex.group_id
+				AND other.amount_cents = ex.amount_cents
+				AND other.id > ex.id
+			WHERE ex.group_id = $1
+			AND ex.deleted_at IS NULL
+			AND other.deleted_at IS NULL
+			AND other.created_at BETWEEN ex.created_at - make_interval(secs => $2) AND ex.created_at + make_interval(secs => $2)
+			AND (ex.purchase_id IS NULL OR ex.purchase_id IS DISTINCT FROM other.purchase_id)
+			AND `+similarNames("ex.name", nameLexemes("ex"), "other.name", nameLexemes("other"))+`
+			AND NOT EXISTS (
+				SELECT 1 FROM expense_duplicate_dismissals dismissal
+				WHERE dismissal.expense_id = ex.id AND dismissal.other_expense_id = other.id
+			)
+		`, groupID, expense.DuplicateWindow.Seconds()); err != nil {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		if len(pairs) == 0 {
+			return []DuplicateCluster{}, nil
+		}
+
+		ids := make([]int, 0, len(pairs)*2)
+		for _, pair := range pairs {
+			ids = append(ids, pair.ExpenseID, pair.OtherExpenseID)
+		}
+
+		var expenses []ExpenseDetails
+		if err := dbClient.SelectContext(ctx, &expenses,
+			fmt.Sprintf(expensesQuery, "ex.id = ANY($1)", "ex.created_at DESC, ex.id DESC", "ALL"),
+			ids,
+		); err != nil {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		return clusterDuplicates(pairs, expenses), nil
+	}
+}
+
+// clusterDuplicates joins the pairs sharing an expense into a single cluster, so a purchase
+// entered three times is reviewed once. Clusters keep the order of the expenses given, ordered by
+// the first expense of each.
+func clusterDuplicates(pairs []duplicatePair, expenses []ExpenseDetails) []DuplicateCluster {
+	parent := make(map[int]int)
+	var find func(id int) int
+	find = func(id int) int {
+		if p, ok := parent[id]; ok && p != id {
+			parent[id] = find(p)
+			return parent[id]
+		}
+		parent[id] = id
+		return id
+	}
+
+	for _, pair := range pairs {
+		parent[find(pair.OtherExpenseID)] = find(pair.ExpenseID)
+	}
+
+	clusters := []DuplicateCluster{}
+	indexes := make(map[int]int)
+	for _, expns := range expenses {
+		if _, ok := parent[expns.ID]; !ok {
+			continue
+		}
+
+		root := find(expns.ID)
+		i, ok := indexes[root]
+		if !ok {
+			i = len(clusters)
+			indexes[root] = i
+			clusters = append(clusters, DuplicateCluster{})
+		}
+		clusters[i].Expenses = append(clusters[i].Expenses, expns)
+	}
+
+	// An expense may have been deleted between the two queries, leaving nothing to compare
+	return slices.DeleteFunc(clusters, func(cluster DuplicateCluster) bool {
+		return len(cluster.Expenses) < 2
+	})
+}
+
+// nameLexemes is the array of the words of the name of an expense as stemmed into its
+// document_search, where they have weight A.
+func nameLexemes(table string) string {
+	return "tsvector_to_array(ts_filter(" + table + ".document_search, '{a}'))"
+}
+
+// similarNames is the condition telling two names are alike: equal but for the case, or sharing at
+// least half of the words of the shorter one. Comparing lexemes makes inflections of a word count
+// as the same word, so "Mercado Extra" is alike "COMPRA MERCADOS EXTRA".
+func similarNames(name, lexemes, otherName, otherLexemes string) string {
+	return fmt.Sprintf(`(
+		lower(%[1]s) = lower(%[3]s)
+		OR (SELECT COUNT(*) FROM unnest(%[2]s) AS lexeme WHERE lexeme = ANY(%[4]s))
+			>= GREATEST(LEAST(cardinality(%[2]s), cardinality(%[4]s)), 1) / 2.0
+	)`, name, lexemes, otherName, otherLexemes)
+}
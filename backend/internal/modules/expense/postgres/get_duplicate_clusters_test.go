@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/fixture"
+)
+
+type GetDuplicateClustersTestSuite struct {
+	suite.Suite
+	db                   *db.Client
+	ctx                  context.Context
+	getDuplicateClusters GetDuplicateClusters
+}
+
+func TestGetDuplicateClustersTestSuite(t *testing.T) {
+	suite.Run(t, new(GetDuplicateClustersTestSuite))
+}
+
+func (s *GetDuplicateClustersTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+
+	err := fixture.ExecuteSQLFiles(s.db, []string{
+		"./fixtures/basic_setup.sql",
+		"./fixtures/get_duplicate_clusters.sql",
+	})
+	s.NoError(err)
+
+	s.getDuplicateClusters = NewGetDuplicateClusters(s.db)
+}
+
+func (s *GetDuplicateClustersTestSuite) TestGetDuplicateClusters() {
+	clusters, err := s.getDuplicateClusters(s.ctx, 100)
+	s.NoError(err)
+	s.Len(clusters, 1)
+
+	var ids []int
+	for _, expns := range clusters[0].Expenses {
+		ids = append(ids, expns.ID)
+	}
+	s.Equal([]int{52, 51, 50}, ids)
+}
+
+func (s *GetDuplicateClustersTestSuite) TestGetDuplicateClusters_EmptyResult() {
+	clusters, err := s.getDuplicateClusters(s.ctx, 999)
+	s.NoError(err)
+	s.Empty(clusters)
+}
+
+func TestClusterDuplicates(t *testing.T) {
+	expenses := func(ids ...int) []ExpenseDetails {
+		result := make([]ExpenseDetails, len(ids))
+		for i, id := range ids {
+			result[i] = ExpenseDetails{ID: id}
+		}
+		return result
+	}
+
+	t.Run("joins pairs sharing an expense", func(t *testing.T) {
+		pairs := []duplicatePair{{1, 2}, {5, 6}, {2, 3}}
+
+		clusters := clusterDuplicates(pairs, expenses(6, 5, 3, 2, 1))
+		assert.Equal(t, []DuplicateCluster{
+			{Expenses: expenses(6, 5)},
+			{Expenses: expenses(3, 2, 1)},
+		}, clusters)
+	})
+
+	t.Run("drops clusters left with a single expense", func(t *testing.T) {
+		clusters := clusterDuplicates([]duplicatePair{{1, 2}}, expenses(2))
+		assert.Empty(t, clusters)
+	})
+}
@@ -12,20 +12,27 @@ import (
 
 type (
 	ExpenseDetails struct {
-		ID           int        `db:"id" json:"id"`
-		Name         string     `db:"name" json:"name"`
-		Amount       float32    `db:"amount" json:"amount"`
-		RefundAmount *float32   `db:"refund_amount" json:"refund_amount"`
-		Description  string     `db:"description" json:"description"`
-		CategoryID   int        `db:"category_id" json:"category_id"`
-		PayerID      int        `db:"payer_id" json:"payer_id"`
-		ReceiverID   int        `db:"receiver_id" json:"receiver_id"`
-		GroupID      int        `db:"group_id" json:"group_id"`
-		SplitRatio   SplitRatio `db:"split_ratio" json:"split_ratio"`
-		SplitType    string     `db:"split_type" json:"split_type"`
-		CreatedAt    time.Time  `db:"created_at" json:"created_at"`
-		UpdatedAt    time.Time  `db:"updated_at" json:"updated_at"`
-		DeletedAt    *time.Time `db:"deleted_at" json:"deleted_at"`
+		ID     int     `db:"id" json:"id"`
+		Name   string  `db:"name" json:"name"`
+		Amount float32 `db:"amount" json:"amount"`
+		// AmountCents is the exact amount, used to build pagination cursors.
+		AmountCents       int        `db:"amount_cents" json:"-"`
+		RefundAmount      *float32   `db:"refund_amount" json:"refund_amount"`
+		Description       string     `db:"description" json:"description"`
+		CategoryID        int        `db:"category_id" json:"category_id"`
+		PayerID           int        `db:"payer_id" json:"payer_id"`
+		ReceiverID        int        `db:"receiver_id" json:"receiver_id"`
+		GroupID           int        `db:"group_id" json:"group_id"`
+		SplitRatio        SplitRatio `db:"split_ratio" json:"split_ratio"`
+		SplitType         string     `db:"split_type" json:"split_type"`
+		PurchaseID        *string    `db:"purchase_id" json:"purchase_id"`
+		InstallmentNumber *int       `db:"installment_number" json:"installment_number"`
+		InstallmentTotal  *int       `db:"installment_total" json:"installment_total"`
+		TagIDs            TagIDs     `db:"tag_ids" json:"tag_ids"`
+		CreatedAt         time.Time  `db:"created_at" json:"created_at"`
+		UpdatedAt         time.Time  `db:"updated_at" json:"updated_at"`
+		DeletedAt         *time.Time `db:"deleted_at" json:"deleted_at"`
+		Version           int        `db:"version" json:"version"`
 	}
 
 	GetExpenseDetails func(ctx context.Context, expenseID int) ([]ExpenseDetails, error)
@@ -48,11 +55,17 @@ func NewGetExpenseDetails(db *db.Client) GetExpenseDetails {
   					category_id,
     				split_ratio,
             split_type,
+            purchase_id,
+            installment_number,
+            installment_total,
+            tag_ids,
 	  				created_at,
 		  			updated_at,
-			  		deleted_at
+			  		deleted_at,
+			  		version
 			    FROM expenses
 				  WHERE id = $1
+				  ORDER BY version
 		`, expenseID); err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("db.SelectContext: %w", err)
 		}
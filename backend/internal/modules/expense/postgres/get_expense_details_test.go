@@ -55,8 +55,7 @@ func (s *GetExpenseDetailsTestSuite) TestGetExpenseDetails_Success() {
 	s.Equal(101, expense.ReceiverID)
 	s.Equal(100, expense.GroupID)
 	s.Equal("equal", expense.SplitType)
-	s.Equal(50, expense.SplitRatio.Payer)
-	s.Equal(50, expense.SplitRatio.Receiver)
+	s.Equal([]Share{{UserID: 100, Percent: 50}, {UserID: 101, Percent: 50}}, expense.SplitRatio.Shares)
 	s.Nil(expense.DeletedAt)
 }
 
@@ -95,8 +94,7 @@ func (s *GetExpenseDetailsTestSuite) TestGetExpenseDetails_AllFields() {
 	s.Equal(101, expense.ReceiverID)
 	s.Equal(100, expense.GroupID)
 	s.Equal("proportional", expense.SplitType)
-	s.Equal(60, expense.SplitRatio.Payer)
-	s.Equal(40, expense.SplitRatio.Receiver)
+	s.Equal([]Share{{UserID: 100, Percent: 60}, {UserID: 101, Percent: 40}}, expense.SplitRatio.Shares)
 	s.NotNil(expense.CreatedAt)
 	s.NotNil(expense.UpdatedAt)
 	s.Nil(expense.DeletedAt)
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
@@ -11,77 +12,75 @@ import (
 type (
 	GetExpenses func(ctx context.Context, input GetExpensesInput) ([]ExpenseDetails, error)
 
+	ExpensesSort string
+
 	GetExpensesInput struct {
-		GroupID         int
-		LastExpenseDate time.Time
-		LastExpenseID   int
-		Limit           int
-		Search          string
+		GroupID int
+		// The cursor is the sort key and id of the last expense of the previous page. It is only
+		// applied when LastExpenseID is set; the sort key used is the one matching Sort.
+		LastExpenseDate   time.Time
+		LastExpenseAmount int
+		LastExpenseID     int
+		Limit             int
+		Search            string
+		CategoryIDs       []int
+		CategoryGroupIDs  []int
+		PayerID           int
+		ReceiverID        int
+		SplitTypes        []string
+		// TagIDs keeps the expenses tagged with any of the tags.
+		TagIDs    []int
+		MinAmount *int
+		MaxAmount *int
+		// StartDate is inclusive and EndDate exclusive.
+		StartDate *time.Time
+		EndDate   *time.Time
+		Sort      ExpensesSort
+		Ascending bool
 	}
 )
 
-var (
-	expensesQueryWithSearch = `
-		SELECT
-			ex.id AS id,
-			ex.name AS name,
-			ex.amount_cents amount,
-			ex.refund_amount_cents AS refund_amount,
-			ex.description AS description,
-			ex.group_id AS group_id,
-			cat.id AS category_id,
-			ex.payer_id AS payer_id,
-			ex.receiver_id AS receiver_id,
-			ex.split_ratio AS split_ratio,
-			ex.split_type AS split_type,
-			ex.created_at AS created_at,
-			ex.updated_at AS updated_at,
-			ex.deleted_at AS deleted_at
-		FROM expenses_latest ex INNER JOIN categories cat ON ex.category_id = cat.id
-		WHERE ex.group_id = $1
-		AND (ex.created_at < $2 OR (ex.created_at = $2 AND ex.id < $3))
-		AND ex.document_search @@ websearch_to_tsquery('portuguese', $5)
-		AND ex.deleted_at IS NULL
-		ORDER BY ex.created_at DESC, ex.id DESC
-		LIMIT $4
-		`
-	expensesQuery = `
-		SELECT
-			ex.id AS id,
-			ex.name AS name,
-			ex.amount_cents amount,
-			ex.refund_amount_cents AS refund_amount,
-			ex.description AS description,
-			ex.group_id AS group_id,
-			cat.id AS category_id,
-			ex.payer_id AS payer_id,
-			ex.receiver_id AS receiver_id,
-			ex.split_ratio AS split_ratio,
-			ex.split_type AS split_type,
-			ex.created_at AS created_at,
-			ex.updated_at AS updated_at,
-			ex.deleted_at AS deleted_at
-		FROM expenses_latest ex INNER JOIN categories cat ON ex.category_id = cat.id
-		WHERE ex.group_id = $1
-		AND (ex.created_at < $2 OR (ex.created_at = $2 AND ex.id < $3))
-		AND ex.deleted_at IS NULL
-		ORDER BY ex.created_at DESC, ex.id DESC
-		LIMIT $4
-  `
-)
+var ExpensesSorts = struct {
+	Date   ExpensesSort
+	Amount ExpensesSort
+}{
+	Date:   "date",
+	Amount: "amount",
+}
+
+const expensesQuery = `
+	SELECT
+		ex.id AS id,
+		ex.name AS name,
+		ex.amount_cents amount,
+		ex.amount_cents AS amount_cents,
+		ex.refund_amount_cents AS refund_amount,
+		ex.description AS description,
+		ex.group_id AS group_id,
+		cat.id AS category_id,
+		ex.payer_id AS payer_id,
+		ex.receiver_id AS receiver_id,
+		ex.split_ratio AS split_ratio,
+		ex.split_type AS split_type,
+		ex.purchase_id AS purchase_id,
+		ex.installment_number AS installment_number,
+		ex.installment_total AS installment_total,
+		ex.tag_ids AS tag_ids,
+		ex.created_at AS created_at,
+		ex.updated_at AS updated_at,
+		ex.deleted_at AS deleted_at
+	FROM expenses_latest ex INNER JOIN categories cat ON ex.category_id = cat.id
+	WHERE %s
+	ORDER BY %s
+	LIMIT %s
+`
 
 func NewGetExpenses(db *db.Client) GetExpenses {
 	dbClient := db.Conn()
 	return func(ctx context.Context, input GetExpensesInput) ([]ExpenseDetails, error) {
 		var expenses []ExpenseDetails
 
-		query := expensesQuery
-		args := []any{input.GroupID, input.LastExpenseDate, input.LastExpenseID, input.Limit}
-
-		if input.Search != "" {
-			query = expensesQueryWithSearch
-			args = append(args, input.Search)
-		}
+		query, args := buildExpensesQuery(input)
 		if err := dbClient.SelectContext(ctx, &expenses, query, args...); err != nil {
 			return nil, fmt.Errorf("db.SelectContext: %w", err)
 		}
@@ -89,3 +88,92 @@ func NewGetExpenses(db *db.Client) GetExpenses {
 		return expenses, nil
 	}
 }
+
+// buildExpensesQuery only compares plain columns of expenses_latest so the planner can use their
+// indexes, and paginates with a row comparison on the sort key and id.
+func buildExpensesQuery(input GetExpensesInput) (string, []any) {
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := expensesConditions(input, arg)
+
+	column, last := expensesSortColumn(input.Sort), any(input.LastExpenseDate)
+	if input.Sort == ExpensesSorts.Amount {
+		last = input.LastExpenseAmount
+	}
+
+	comparison := "<"
+	if input.Ascending {
+		comparison = ">"
+	}
+
+	if input.LastExpenseID != 0 {
+		conditions = append(conditions, fmt.Sprintf("(%s, ex.id) %s (%s, %s)", column, comparison, arg(last), arg(input.LastExpenseID)))
+	}
+
+	return fmt.Sprintf(expensesQuery, strings.Join(conditions, "\n\tAND "), expensesOrderBy(input), arg(input.Limit)), args
+}
+
+// expensesConditions translates the filters of the input into conditions on the ex (expenses_latest)
+// and cat (categories) aliases, registering their values through arg.
+func expensesConditions(input GetExpensesInput, arg func(value any) string) []string {
+	conditions := []string{
+		"ex.group_id = " + arg(input.GroupID),
+		"ex.deleted_at IS NULL",
+	}
+
+	if input.Search != "" {
+		conditions = append(conditions, "ex.document_search @@ websearch_to_tsquery('portuguese', "+arg(input.Search)+")")
+	}
+	if len(input.CategoryIDs) > 0 {
+		conditions = append(conditions, "ex.category_id = ANY("+arg(input.CategoryIDs)+")")
+	}
+	if len(input.CategoryGroupIDs) > 0 {
+		conditions = append(conditions, "cat.category_group_id = ANY("+arg(input.CategoryGroupIDs)+")")
+	}
+	if input.PayerID != 0 {
+		conditions = append(conditions, "ex.payer_id = "+arg(input.PayerID))
+	}
+	if input.ReceiverID != 0 {
+		conditions = append(conditions, "ex.receiver_id = "+arg(input.ReceiverID))
+	}
+	if len(input.SplitTypes) > 0 {
+		conditions = append(conditions, "ex.split_type = ANY("+arg(input.SplitTypes)+")")
+	}
+	if len(input.TagIDs) > 0 {
+		conditions = append(conditions, "ex.tag_ids && "+arg(input.TagIDs)+"::bigint[]")
+	}
+	if input.MinAmount != nil {
+		conditions = append(conditions, "ex.amount_cents >= "+arg(*input.MinAmount))
+	}
+	if input.MaxAmount != nil {
+		conditions = append(conditions, "ex.amount_cents <= "+arg(*input.MaxAmount))
+	}
+	if input.StartDate != nil {
+		conditions = append(conditions, "ex.created_at >= "+arg(*input.StartDate))
+	}
+	if input.EndDate != nil {
+		conditions = append(conditions, "ex.created_at < "+arg(*input.EndDate))
+	}
+
+	return conditions
+}
+
+func expensesSortColumn(sort ExpensesSort) string {
+	if sort == ExpensesSorts.Amount {
+		return "ex.amount_cents"
+	}
+	return "ex.created_at"
+}
+
+func expensesOrderBy(input GetExpensesInput) string {
+	direction := "DESC"
+	if input.Ascending {
+		direction = "ASC"
+	}
+
+	return fmt.Sprintf("%s %s, ex.id %s", expensesSortColumn(input.Sort), direction, direction)
+}
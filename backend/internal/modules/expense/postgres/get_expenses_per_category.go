@@ -12,20 +12,24 @@ import (
 
 type (
 	expensesPerCategoryInfo struct {
-		CategoryGroup string `db:"category_group_name"`
-		Category      string `db:"category_name" `
-		Amount        int    `db:"amount"`
+		CategoryGroupID int    `db:"category_group_id"`
+		CategoryGroup   string `db:"category_group_name"`
+		CategoryID      int    `db:"category_id"`
+		Category        string `db:"category_name" `
+		Amount          int    `db:"amount"`
 	}
 
-	expensesPerInnerCategory struct {
+	ExpensesPerInnerCategory struct {
+		ID       int    `json:"id"`
 		Category string `json:"name"`
 		Amount   int    `json:"amount"`
 	}
 
 	ExpensesPerCategory struct {
+		ID            int                        `json:"id"`
 		CategoryGroup string                     `json:"name"`
 		Amount        int                        `json:"amount"`
-		Categories    []expensesPerInnerCategory `json:"categories"`
+		Categories    []ExpensesPerInnerCategory `json:"categories"`
 	}
 
 	GetExpensesPerCategoryInput struct {
@@ -43,7 +47,9 @@ func NewGetExpensesPerCategory(db *db.Client) GetExpensesPerCategory {
 		var info []expensesPerCategoryInfo
 		if err := dbClient.SelectContext(ctx, &info, `
 			SELECT 
+				cat.id AS category_id,
 				cat.name AS category_name, 
+				cg.id AS category_group_id,
 				cg.name AS category_group_name, 
 				SUM(ex.amount_cents) AS amount 
 			FROM expenses_latest ex
@@ -55,25 +61,28 @@ func NewGetExpensesPerCategory(db *db.Client) GetExpensesPerCategory {
 			AND ex.deleted_at IS NULL
 			AND cg.deleted_at IS NULL
 			AND cat.deleted_at IS NULL
-			GROUP BY 1, 2 
-			ORDER BY 2 DESC;
+			GROUP BY 1, 2, 3, 4
+			ORDER BY 4 DESC;
 		`, params.GroupID, params.StartDate, params.EndDate); err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("db.SelectContext: %w", err)
 		}
 
-		var categoriesGroups = make(map[string]ExpensesPerCategory)
+		var categoriesGroups = make(map[int]ExpensesPerCategory)
 		for _, e := range info {
-			if _, ok := categoriesGroups[e.CategoryGroup]; !ok {
-				categoriesGroups[e.CategoryGroup] = ExpensesPerCategory{
+			if _, ok := categoriesGroups[e.CategoryGroupID]; !ok {
+				categoriesGroups[e.CategoryGroupID] = ExpensesPerCategory{
+					ID:            e.CategoryGroupID,
 					CategoryGroup: e.CategoryGroup,
 					Amount:        e.Amount,
-					Categories:    []expensesPerInnerCategory{{Category: e.Category, Amount: e.Amount}},
+					Categories:    []ExpensesPerInnerCategory{{ID: e.CategoryID, Category: e.Category, Amount: e.Amount}},
 				}
 			} else {
-				categoriesGroups[e.CategoryGroup] = ExpensesPerCategory{
+				categoriesGroups[e.CategoryGroupID] = ExpensesPerCategory{
+					ID:            e.CategoryGroupID,
 					CategoryGroup: e.CategoryGroup,
-					Amount:        categoriesGroups[e.CategoryGroup].Amount + e.Amount,
-					Categories: append(categoriesGroups[e.CategoryGroup].Categories, expensesPerInnerCategory{
+					Amount:        categoriesGroups[e.CategoryGroupID].Amount + e.Amount,
+					Categories: append(categoriesGroups[e.CategoryGroupID].Categories, ExpensesPerInnerCategory{
+						ID:       e.CategoryID,
 						Category: e.Category,
 						Amount:   e.Amount,
 					}),
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	MemberExpensesPerMonth struct {
+		Date     string `json:"date"`
+		Paid     int    `json:"paid"`
+		Consumed int    `json:"consumed"`
+	}
+
+	MemberExpensesPerCategory struct {
+		CategoryID int    `json:"category_id"`
+		Category   string `json:"category"`
+		Paid       int    `json:"paid"`
+		Consumed   int    `json:"consumed"`
+	}
+
+	// ExpensesPerMember tells apart what a member paid for, as the payer, from what they consumed,
+	// their share of the split of each expense.
+	ExpensesPerMember struct {
+		UserID   int    `json:"user_id"`
+		Name     string `json:"name"`
+		Paid     int    `json:"paid"`
+		Consumed int    `json:"consumed"`
+		// Balance is how much more the member paid than consumed, negative when they paid less.
+		Balance    int                         `json:"balance"`
+		Months     []MemberExpensesPerMonth    `json:"months"`
+		Categories []MemberExpensesPerCategory `json:"categories"`
+	}
+
+	GetExpensesPerMemberInput struct {
+		GroupID   int       `json:"group_id"`
+		StartDate time.Time `json:"start_date"`
+		EndDate   time.Time `json:"end_date"`
+	}
+
+	GetExpensesPerMember func(ctx context.Context, params GetExpensesPerMemberInput) ([]ExpensesPerMember, error)
+)
+
+// NewGetExpensesPerMember sums, net of refunds, what each member paid and consumed, in total, per
+// month and per category.
+func NewGetExpensesPerMember(db *db.Client) GetExpensesPerMember {
+	dbClient := db.Conn()
+	return func(ctx context.Context, params GetExpensesPerMemberInput) ([]ExpensesPerMember, error) {
+		var rows []struct {
+			UserID     int    `db:"user_id"`
+			Name       string `db:"name"`
+			Date       string `db:"date"`
+			CategoryID int    `db:"category_id"`
+			Category   string `db:"category"`
+			Paid       int    `db:"paid"`
+			Consumed   int    `db:"consumed"`
+		}
+		if err := dbClient.SelectContext(ctx, &rows, `
+			WITH base AS (
+				SELECT
+					to_char(date_trunc('month', ex.created_at), 'YYYY-MM') AS date,
+					ex.category_id,
+					ex.payer_id,
+					ex.amount_cents,
+					ex.refund_amount_cents,
+					ex.split_ratio,
+					ex.split_type
+				FROM expenses_latest ex
+				WHERE ex.group_id = $1
+				AND ex.created_at >= $2
+				AND ex.created_at <= $3
+				AND ex.deleted_at IS NULL
+			), member_amounts AS (
+				SELECT ex.date, ex.category_id, ex.payer_id AS user_id, ex.amount_cents - COALESCE(ex.refund_amount_cents, 0) AS paid, 0 AS consumed
+				FROM base ex
+
+				UNION ALL
+
+				SELECT ex.date, ex.category_id, (share->>'user_id')::int AS user_id, 0 AS paid, `+shareAmountCents+` AS consumed
+				FROM base ex, jsonb_array_elements(ex.split_ratio->'shares') AS share
+			)
+			SELECT
+				ma.user_id,
+				u.name,
+				ma.date,
+				ma.category_id,
+				cat.name AS category,
+				SUM(ma.paid) AS paid,
+				ROUND(SUM(ma.consumed)) AS consumed
+			FROM member_amounts ma
+			INNER JOIN users u ON u.id = ma.user_id
+			INNER JOIN categories cat ON cat.id = ma.category_id
+			GROUP BY 1, 2, 3, 4, 5
+			ORDER BY 1, 3, 4;
+		`, params.GroupID, params.StartDate, params.EndDate); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		var members []ExpensesPerMember
+		for _, row := range rows {
+			if len(members) == 0 || members[len(members)-1].UserID != row.UserID {
+				members = append(members, ExpensesPerMember{
+					UserID:     row.UserID,
+					Name:       row.Name,
+					Months:     []MemberExpensesPerMonth{},
+					Categories: []MemberExpensesPerCategory{},
+				})
+			}
+
+			member := &members[len(members)-1]
+			member.Paid += row.Paid
+			member.Consumed += row.Consumed
+			member.Balance = member.Paid - member.Consumed
+
+			if len(member.Months) == 0 || member.Months[len(member.Months)-1].Date != row.Date {
+				member.Months = append(member.Months, MemberExpensesPerMonth{Date: row.Date})
+			}
+			month := &member.Months[len(member.Months)-1]
+			month.Paid += row.Paid
+			month.Consumed += row.Consumed
+
+			i := slices.IndexFunc(member.Categories, func(c MemberExpensesPerCategory) bool { return c.CategoryID == row.CategoryID })
+			if i < 0 {
+				member.Categories = append(member.Categories, MemberExpensesPerCategory{CategoryID: row.CategoryID, Category: row.Category})
+				i = len(member.Categories) - 1
+			}
+			member.Categories[i].Paid += row.Paid
+			member.Categories[i].Consumed += row.Consumed
+		}
+
+		for _, member := range members {
+			slices.SortStableFunc(member.Categories, func(a, b MemberExpensesPerCategory) int { return b.Consumed - a.Consumed })
+		}
+
+		return members, nil
+	}
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/fixture"
+)
+
+type GetExpensesPerMemberTestSuite struct {
+	suite.Suite
+	db                   *db.Client
+	ctx                  context.Context
+	getExpensesPerMember GetExpensesPerMember
+}
+
+func TestGetExpensesPerMemberTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetExpensesPerMemberTestSuite))
+}
+
+func (s *GetExpensesPerMemberTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+
+	err := fixture.ExecuteSQLFiles(s.db, []string{
+		"./fixtures/basic_setup.sql",
+		"./fixtures/get_expenses_per_member.sql",
+	})
+	s.NoError(err)
+
+	s.getExpensesPerMember = NewGetExpensesPerMember(s.db)
+}
+
+func (s *GetExpensesPerMemberTestSuite) TestGetExpensesPerMember_Success() {
+	result, err := s.getExpensesPerMember(s.ctx, GetExpensesPerMemberInput{
+		GroupID:   100,
+		StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 7, 31, 23, 59, 59, 0, time.UTC),
+	})
+	s.NoError(err)
+
+	// The refund is taken out of the market, the deleted cinema and the other group are left out
+	s.Equal([]ExpensesPerMember{
+		{
+			UserID:   100,
+			Name:     "Payer User",
+			Paid:     14000, // 8000 + 6000
+			Consumed: 27600, // 4000 + 20000 + 3600
+			Balance:  -13600,
+			Months: []MemberExpensesPerMonth{
+				{Date: "2024-06", Paid: 8000, Consumed: 24000},
+				{Date: "2024-07", Paid: 6000, Consumed: 3600},
+			},
+			Categories: []MemberExpensesPerCategory{
+				{CategoryID: 100, Category: "Restaurante", Paid: 0, Consumed: 20000},
+				{CategoryID: 101, Category: "Supermercado", Paid: 14000, Consumed: 7600},
+			},
+		},
+		{
+			UserID:   101,
+			Name:     "Receiver User",
+			Paid:     30000,
+			Consumed: 16400, // 4000 + 10000 + 2400
+			Balance:  13600,
+			Months: []MemberExpensesPerMonth{
+				{Date: "2024-06", Paid: 30000, Consumed: 14000},
+				{Date: "2024-07", Paid: 0, Consumed: 2400},
+			},
+			Categories: []MemberExpensesPerCategory{
+				{CategoryID: 100, Category: "Restaurante", Paid: 30000, Consumed: 10000},
+				{CategoryID: 101, Category: "Supermercado", Paid: 0, Consumed: 6400},
+			},
+		},
+	}, result)
+}
+
+func (s *GetExpensesPerMemberTestSuite) TestGetExpensesPerMember_EmptyResult() {
+	result, err := s.getExpensesPerMember(s.ctx, GetExpensesPerMemberInput{
+		GroupID:   100,
+		StartDate: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 9, 30, 23, 59, 59, 0, time.UTC),
+	})
+	s.NoError(err)
+	s.Empty(result)
+}
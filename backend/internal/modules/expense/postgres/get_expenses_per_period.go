@@ -12,9 +12,11 @@ import (
 
 type (
 	ExpensesPerPeriod struct {
-		Date   string `db:"date" json:"date"`
-		Amount int    `db:"amount" json:"amount"`
-		Count  int    `db:"quantity" json:"quantity"`
+		Date            string `db:"date" json:"date"`
+		CategoryID      int    `db:"category_id" json:"category_id,omitempty"`
+		CategoryGroupID int    `db:"category_group_id" json:"category_group_id,omitempty"`
+		Amount          int    `db:"amount" json:"amount"`
+		Count           int    `db:"quantity" json:"quantity"`
 	}
 
 	GetExpensesPerPeriodInput struct {
@@ -22,6 +24,8 @@ type (
 		Aggregate string    `json:"aggregate"`
 		StartDate time.Time `json:"start_date"`
 		EndDate   time.Time `json:"end_date"`
+		// PerCategory breaks each period down by category.
+		PerCategory bool `json:"per_category"`
 	}
 
 	GetExpensesPerPeriod func(ctx context.Context, params GetExpensesPerPeriodInput) ([]ExpensesPerPeriod, error)
@@ -42,19 +46,28 @@ func NewGetExpensesPerPeriod(db *db.Client) GetExpensesPerPeriod {
 			format = "YYYY-MM-DD"
 		}
 
+		columns, join, groupBy := "", "", "1"
+		if params.PerCategory {
+			columns = "ex.category_id, cat.category_group_id,"
+			join = "INNER JOIN categories cat ON ex.category_id = cat.id"
+			groupBy = "1, 2, 3"
+		}
+
 		query := fmt.Sprintf(`
 			SELECT 
 				to_char(date_trunc('%s', ex.created_at), '%s') AS date, 
+				%s
 				SUM(ex.amount_cents) AS amount, 
 				COUNT(1) AS quantity 
 			FROM expenses_latest ex
+			%s
 			WHERE ex.group_id = $1
 			AND ex.created_at >= $2
 			AND ex.created_at <= $3
 			AND ex.deleted_at IS NULL
-			GROUP BY 1
-			ORDER BY 1;
-		`, trunc, format)
+			GROUP BY %s
+			ORDER BY %s;
+		`, trunc, format, columns, join, groupBy, groupBy)
 
 		if err := dbClient.SelectContext(ctx, &expensesPerPeriod, query, params.GroupID, params.StartDate, params.EndDate); err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("db.SelectContext: %w", err)
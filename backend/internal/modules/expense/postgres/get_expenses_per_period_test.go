@@ -120,6 +120,25 @@ func (s *GetExpensesPerPeriodTestSuite) TestGetExpensesPerPeriod_MonthlyAggregat
 	s.Equal(1, august.Count)
 }
 
+func (s *GetExpensesPerPeriodTestSuite) TestGetExpensesPerPeriod_PerCategory() {
+	input := GetExpensesPerPeriodInput{
+		GroupID:     100,
+		Aggregate:   "month",
+		StartDate:   time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC),
+		PerCategory: true,
+	}
+
+	result, err := s.getExpensesPerPeriod(s.ctx, input)
+	s.NoError(err)
+	s.Equal([]ExpensesPerPeriod{
+		{Date: "2024-06", CategoryID: 100, CategoryGroupID: 100, Amount: 2500, Count: 2},
+		{Date: "2024-06", CategoryID: 101, CategoryGroupID: 100, Amount: 2000, Count: 1},
+		{Date: "2024-06", CategoryID: 102, CategoryGroupID: 101, Amount: 3000, Count: 1},
+		{Date: "2024-06", CategoryID: 103, CategoryGroupID: 102, Amount: 2500, Count: 1},
+	}, result)
+}
+
 func (s *GetExpensesPerPeriodTestSuite) TestGetExpensesPerPeriod_EmptyResult() {
 	input := GetExpensesPerPeriodInput{
 		GroupID:   999, // Non-existent group
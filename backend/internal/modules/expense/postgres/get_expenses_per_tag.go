@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	ExpensesPerTag struct {
+		TagID  int    `db:"tag_id" json:"id"`
+		Tag    string `db:"tag_name" json:"name"`
+		Amount int    `db:"amount" json:"amount"`
+		Count  int    `db:"count" json:"count"`
+	}
+
+	GetExpensesPerTagInput struct {
+		GroupID   int       `json:"group_id"`
+		StartDate time.Time `json:"start_date"`
+		EndDate   time.Time `json:"end_date"`
+	}
+
+	GetExpensesPerTag func(ctx context.Context, params GetExpensesPerTagInput) ([]ExpensesPerTag, error)
+)
+
+// NewGetExpensesPerTag sums the expenses of each tag. An expense with several tags counts in
+// full for every one of them, so the amounts may add up to more than was spent.
+func NewGetExpensesPerTag(db *db.Client) GetExpensesPerTag {
+	dbClient := db.Conn()
+	return func(ctx context.Context, params GetExpensesPerTagInput) ([]ExpensesPerTag, error) {
+		var expensesPerTag []ExpensesPerTag
+		if err := dbClient.SelectContext(ctx, &expensesPerTag, `
+			SELECT
+				t.id AS tag_id,
+				t.name AS tag_name,
+				SUM(ex.amount_cents) AS amount,
+				COUNT(ex.id) AS count
+			FROM expenses_latest ex
+			CROSS JOIN LATERAL unnest(ex.tag_ids) AS ex_tag(tag_id)
+			INNER JOIN tags t ON t.id = ex_tag.tag_id
+			WHERE ex.group_id = $1
+			AND ex.created_at >= $2
+			AND ex.created_at <= $3
+			AND ex.deleted_at IS NULL
+			GROUP BY 1, 2
+			ORDER BY 3 DESC, 2;
+		`, params.GroupID, params.StartDate, params.EndDate); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		return expensesPerTag, nil
+	}
+}
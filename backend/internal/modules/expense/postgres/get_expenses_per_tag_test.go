@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/fixture"
+)
+
+type GetExpensesPerTagTestSuite struct {
+	suite.Suite
+	db                *db.Client
+	ctx               context.Context
+	getExpensesPerTag GetExpensesPerTag
+}
+
+func TestGetExpensesPerTagTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetExpensesPerTagTestSuite))
+}
+
+func (s *GetExpensesPerTagTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+
+	err := fixture.ExecuteSQLFiles(s.db, []string{
+		"./fixtures/basic_setup.sql",
+		"./fixtures/get_expenses_per_tag.sql",
+	})
+	s.NoError(err)
+
+	s.getExpensesPerTag = NewGetExpensesPerTag(s.db)
+}
+
+func (s *GetExpensesPerTagTestSuite) TestGetExpensesPerTag_Success() {
+	result, err := s.getExpensesPerTag(s.ctx, GetExpensesPerTagInput{
+		GroupID:   100,
+		StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC),
+	})
+	s.NoError(err)
+
+	// The deleted hotel, the untagged market, May and the other group are left out
+	s.Equal([]ExpensesPerTag{
+		{TagID: 100, Tag: "viagem", Amount: 58000, Count: 2}, // 50000 + 8000
+		{TagID: 101, Tag: "praia", Amount: 8000, Count: 1},
+	}, result)
+}
+
+func (s *GetExpensesPerTagTestSuite) TestGetExpensesPerTag_EmptyResult() {
+	result, err := s.getExpensesPerTag(s.ctx, GetExpensesPerTagInput{
+		GroupID:   100,
+		StartDate: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 7, 31, 23, 59, 59, 0, time.UTC),
+	})
+	s.NoError(err)
+	s.Empty(result)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
@@ -90,3 +91,88 @@ func (s *GetExpensesTestSuite) TestGetExpenses_EmptyResult() {
 	s.NoError(err)
 	s.Empty(result)
 }
+
+func (s *GetExpensesTestSuite) TestGetExpenses_WithFilters() {
+	minAmount, maxAmount := 2000, 5000
+	startDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)
+
+	result, err := s.getExpenses(s.ctx, GetExpensesInput{
+		GroupID:          100,
+		Limit:            10,
+		CategoryGroupIDs: []int{100},
+		SplitTypes:       []string{"equal"},
+		MinAmount:        &minAmount,
+		MaxAmount:        &maxAmount,
+		StartDate:        &startDate,
+		EndDate:          &endDate,
+	})
+	s.NoError(err)
+
+	var ids []int
+	for _, expense := range result {
+		ids = append(ids, expense.ID)
+	}
+	s.Equal([]int{31, 30, 23, 21}, ids)
+
+	result, err = s.getExpenses(s.ctx, GetExpensesInput{
+		GroupID:     100,
+		Limit:       10,
+		CategoryIDs: []int{102, 103},
+	})
+	s.NoError(err)
+	s.Len(result, 3)
+}
+
+func (s *GetExpensesTestSuite) TestGetExpenses_SortByAmountPagination() {
+	input := GetExpensesInput{
+		GroupID:   100,
+		Limit:     3,
+		Sort:      ExpensesSorts.Amount,
+		Ascending: true,
+	}
+
+	var amounts []int
+	for {
+		page, err := s.getExpenses(s.ctx, input)
+		s.NoError(err)
+		for _, expense := range page {
+			amounts = append(amounts, expense.AmountCents)
+		}
+		if len(page) < input.Limit {
+			break
+		}
+		last := page[len(page)-1]
+		input.LastExpenseID, input.LastExpenseAmount = last.ID, last.AmountCents
+	}
+
+	s.Equal([]int{1000, 1500, 2000, 2500, 3000, 3000, 4000, 4500, 5000, 5000, 8000, 8000, 10000}, amounts)
+}
+
+func TestBuildExpensesQuery(t *testing.T) {
+	minAmount := 100
+	query, args := buildExpensesQuery(GetExpensesInput{
+		GroupID:           1,
+		LastExpenseAmount: 500,
+		LastExpenseID:     7,
+		Limit:             25,
+		CategoryIDs:       []int{2},
+		MinAmount:         &minAmount,
+		Sort:              ExpensesSorts.Amount,
+	})
+
+	assert.Contains(t, query, "ex.category_id = ANY($2)")
+	assert.Contains(t, query, "ex.amount_cents >= $3")
+	assert.Contains(t, query, "(ex.amount_cents, ex.id) < ($4, $5)")
+	assert.Contains(t, query, "ORDER BY ex.amount_cents DESC, ex.id DESC")
+	assert.Contains(t, query, "LIMIT $6")
+	assert.Equal(t, []any{1, []int{2}, 100, 500, 7, 25}, args)
+
+	query, _ = buildExpensesQuery(GetExpensesInput{GroupID: 1, Limit: 25, Ascending: true})
+	assert.NotContains(t, query, "ex.id) >")
+	assert.Contains(t, query, "ORDER BY ex.created_at ASC, ex.id ASC")
+
+	query, args = buildExpensesQuery(GetExpensesInput{GroupID: 1, Limit: 25, TagIDs: []int{3, 4}})
+	assert.Contains(t, query, "ex.tag_ids && $2::bigint[]")
+	assert.Equal(t, []any{1, []int{3, 4}, 25}, args)
+}
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+const importCandidateColumns = `
+	id,
+	group_id,
+	source,
+	external_id,
+	name,
+	amount_cents,
+	date,
+	category_id,
+	status,
+	expense_id,
+	imported_by,
+	created_at,
+	updated_at,
+	version
+`
+
+type ImportCandidateRepository struct {
+	db *db.Client
+}
+
+func (repo *ImportCandidateRepository) GetNextID() expense.ImportCandidateID {
+	var nextValue int
+
+	conn := repo.db.Conn()
+
+	if err := conn.QueryRowx("SELECT NEXTVAL('import_candidates_id_seq');").Scan(&nextValue); err != nil {
+		panic(fmt.Errorf("db.QueryRow: %w", err))
+	}
+
+	return expense.ImportCandidateID{Value: nextValue}
+}
+
+func (repo *ImportCandidateRepository) GetByID(ctx context.Context, id expense.ImportCandidateID) (*expense.ImportCandidate, error) {
+	var model ImportCandidateModel
+
+	conn := repo.db.Executor(ctx)
+
+	if err := conn.QueryRowxContext(ctx, `
+		SELECT `+importCandidateColumns+`
+		FROM import_candidates
+		WHERE id = $1
+	`, id.Value).StructScan(&model); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	entity := ToImportCandidateEntity(model)
+
+	return &entity, nil
+}
+
+func (repo *ImportCandidateRepository) GetByGroupID(ctx context.Context, groupID group.ID, status expense.ImportCandidateStatus) ([]expense.ImportCandidate, error) {
+	conn := repo.db.Executor(ctx)
+	var models []ImportCandidateModel
+
+	if err := conn.SelectContext(ctx, &models, `
+		SELECT `+importCandidateColumns+`
+		FROM import_candidates
+		WHERE group_id = $1 AND status = $2
+		ORDER BY date DESC, id DESC
+	`, groupID.Value, string(status)); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	entities := make([]expense.ImportCandidate, 0, len(models))
+	for _, model := range models {
+		entities = append(entities, ToImportCandidateEntity(model))
+	}
+
+	return entities, nil
+}
+
+func (repo *ImportCandidateRepository) StoreNew(ctx context.Context, entities []expense.ImportCandidate) ([]expense.ImportCandidate, error) {
+	var stored []expense.ImportCandidate
+
+	if err := repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		stored = nil
+		for _, entity := range entities {
+			created, err := repo.create(ctx, tx, ToImportCandidateModel(entity))
+			if err != nil {
+				return fmt.Errorf("repo.create: %w", err)
+			}
+
+			if created {
+				stored = append(stored, entity)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+func (repo *ImportCandidateRepository) Store(ctx context.Context, entity *expense.ImportCandidate) error {
+	return repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		model := ToImportCandidateModel(*entity)
+
+		created, err := repo.create(ctx, tx, model)
+		if err != nil {
+			return fmt.Errorf("repo.create: %w", err)
+		}
+
+		if !created {
+			if err := repo.update(ctx, tx, model); err != nil {
+				return fmt.Errorf("repo.update: %w", err)
+			}
+			entity.Version = model.Version + 1
+		}
+
+		return nil
+	})
+}
+
+// create inserts the candidate unless it already exists or its transaction was already imported,
+// reporting whether it did.
+func (repo *ImportCandidateRepository) create(ctx context.Context, tx *sqlx.Tx, model ImportCandidateModel) (bool, error) {
+	result, err := tx.NamedExecContext(ctx, `
+		INSERT INTO import_candidates (`+importCandidateColumns+`)
+		VALUES (:id, :group_id, :source, :external_id, :name, :amount_cents, :date, :category_id, :status, :expense_id, :imported_by, :created_at, :updated_at, :version)
+		ON CONFLICT DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// update only applies over the version the candidate was read on, so two members reviewing it at
+// the same time cannot both confirm it.
+func (repo *ImportCandidateRepository) update(ctx context.Context, tx *sqlx.Tx, model ImportCandidateModel) error {
+	result, err := tx.NamedExecContext(ctx, `
+		UPDATE import_candidates SET
+			name = :name,
+			amount_cents = :amount_cents,
+			date = :date,
+			category_id = :category_id,
+			status = :status,
+			expense_id = :expense_id,
+			updated_at = :updated_at,
+			version = version + 1
+		WHERE id = :id AND version = :version
+	`, model)
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "import_candidates", model.ID))
+	}
+
+	return nil
+}
+
+func NewImportCandidateRepository(db *db.Client) expense.ImportCandidateRepository {
+	return &ImportCandidateRepository{db: db}
+}
@@ -0,0 +1,95 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+type ImportCandidateRepositoryTestSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	importCandidateRepo expense.ImportCandidateRepository
+
+	db *db.Client
+}
+
+func TestImportCandidateRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportCandidateRepositoryTestSuite))
+}
+
+func (s *ImportCandidateRepositoryTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+	s.importCandidateRepo = postgres.NewImportCandidateRepository(s.db)
+}
+
+func (s *ImportCandidateRepositoryTestSuite) TearDownTest() {
+	s.NoError(s.db.Clean("import_candidates"))
+}
+
+func (s *ImportCandidateRepositoryTestSuite) newCandidate(externalID string) expense.ImportCandidate {
+	candidate, err := expense.NewImportCandidate(expense.ImportCandidateAttributes{
+		ID:         s.importCandidateRepo.GetNextID(),
+		GroupID:    group.ID{Value: 1},
+		Source:     "nubank",
+		ExternalID: externalID,
+		Name:       "Padaria",
+		Amount:     5290,
+		Date:       civil.Date{Year: 2025, Month: 1, Day: 7},
+		CategoryID: &category.ID{Value: 1},
+		ImportedBy: user.ID{Value: 1},
+	})
+	s.NoError(err)
+
+	return *candidate
+}
+
+func (s *ImportCandidateRepositoryTestSuite) TestPgImportCandidateRepo_StoreNew() {
+	stored, err := s.importCandidateRepo.StoreNew(s.ctx, []expense.ImportCandidate{s.newCandidate("a"), s.newCandidate("b")})
+	s.NoError(err)
+	s.Len(stored, 2)
+
+	// Importing the same statement again only queues the new transactions
+	stored, err = s.importCandidateRepo.StoreNew(s.ctx, []expense.ImportCandidate{s.newCandidate("b"), s.newCandidate("c")})
+	s.NoError(err)
+	s.Len(stored, 1)
+	s.Equal("c", stored[0].ExternalID)
+
+	pending, err := s.importCandidateRepo.GetByGroupID(s.ctx, group.ID{Value: 1}, expense.ImportCandidateStatuses.Pending)
+	s.NoError(err)
+	s.Len(pending, 3)
+	s.Equal(civil.Date{Year: 2025, Month: 1, Day: 7}, pending[0].Date)
+	s.Equal(&category.ID{Value: 1}, pending[0].CategoryID)
+}
+
+func (s *ImportCandidateRepositoryTestSuite) TestPgImportCandidateRepo_Store() {
+	candidate := s.newCandidate("a")
+	s.NoError(s.importCandidateRepo.Store(s.ctx, &candidate))
+
+	stale := candidate
+	s.NoError(candidate.Confirm(expense.ID{Value: 10}))
+	s.NoError(s.importCandidateRepo.Store(s.ctx, &candidate))
+
+	found, err := s.importCandidateRepo.GetByID(s.ctx, candidate.ID)
+	s.NoError(err)
+	s.Equal(expense.ImportCandidateStatuses.Confirmed, found.Status)
+	s.Equal(&expense.ID{Value: 10}, found.ExpenseID)
+	s.Equal(1, found.Version)
+
+	// A second review over the version read before the first one is rejected
+	s.NoError(stale.Discard())
+	s.ErrorIs(s.importCandidateRepo.Store(s.ctx, &stale), ddd.ErrVersionConflict)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 )
@@ -25,6 +26,25 @@ func ToEntity(model ExpenseModel) *expense.Expense {
 		refundAmount = &parsedRefundAmount
 	}
 
+	var installment *expense.Installment
+	if model.PurchaseID.Valid {
+		installment = &expense.Installment{
+			PurchaseID: model.PurchaseID.String,
+			Number:     int(model.InstallmentNumber.Int64),
+			Total:      int(model.InstallmentTotal.Int64),
+		}
+	}
+
+	var tagIDs []tag.ID
+	for _, id := range model.TagIDs {
+		tagIDs = append(tagIDs, tag.ID{Value: id})
+	}
+
+	var updatedBy *user.ID
+	if model.UpdatedBy.Valid {
+		updatedBy = &user.ID{Value: int(model.UpdatedBy.Int64)}
+	}
+
 	return &expense.Expense{
 		Entity: ddd.Entity[expense.ID]{
 			ID:        expense.ID{Value: model.ID},
@@ -39,13 +59,13 @@ func ToEntity(model ExpenseModel) *expense.Expense {
 		Description:  model.Description,
 		GroupID:      group.ID{Value: model.GroupID},
 		CategoryID:   category.ID{Value: model.CategoryID},
-		SplitRatio: expense.SplitRatio{
-			Payer:    model.SplitRatio.Payer,
-			Receiver: model.SplitRatio.Receiver,
-		},
-		SplitType:  expense.SplitType(model.SplitType),
-		PayerID:    user.ID{Value: model.PayerID},
-		ReceiverID: user.ID{Value: model.ReceiverID},
+		SplitRatio:   toSplitRatioEntity(model.SplitRatio),
+		SplitType:    expense.SplitType(model.SplitType),
+		PayerID:      user.ID{Value: model.PayerID},
+		ReceiverID:   user.ID{Value: model.ReceiverID},
+		Installment:  installment,
+		TagIDs:       tagIDs,
+		UpdatedBy:    updatedBy,
 	}
 }
 
@@ -60,6 +80,24 @@ func ToModel(entity *expense.Expense) ExpenseModel {
 		refundAmount = sql.NullInt64{Int64: int64(*entity.RefundAmount), Valid: true}
 	}
 
+	var purchaseID sql.NullString
+	var installmentNumber, installmentTotal sql.NullInt64
+	if entity.Installment != nil {
+		purchaseID = sql.NullString{String: entity.Installment.PurchaseID, Valid: true}
+		installmentNumber = sql.NullInt64{Int64: int64(entity.Installment.Number), Valid: true}
+		installmentTotal = sql.NullInt64{Int64: int64(entity.Installment.Total), Valid: true}
+	}
+
+	var tagIDs TagIDs
+	for _, id := range entity.TagIDs {
+		tagIDs = append(tagIDs, id.Value)
+	}
+
+	var updatedBy sql.NullInt64
+	if entity.UpdatedBy != nil {
+		updatedBy = sql.NullInt64{Int64: int64(entity.UpdatedBy.Value), Valid: true}
+	}
+
 	return ExpenseModel{
 		ID:                entity.ID.Value,
 		Name:              entity.Name,
@@ -68,26 +106,66 @@ func ToModel(entity *expense.Expense) ExpenseModel {
 		Description:       entity.Description,
 		GroupID:           entity.GroupID.Value,
 		CategoryID:        entity.CategoryID.Value,
-		SplitRatio: SplitRatio{
-			Payer:    entity.SplitRatio.Payer,
-			Receiver: entity.SplitRatio.Receiver,
-		},
-		SplitType:  entity.SplitType.String(),
-		PayerID:    entity.PayerID.Value,
-		ReceiverID: entity.ReceiverID.Value,
-		CreatedAt:  entity.CreatedAt,
-		UpdatedAt:  entity.UpdatedAt,
-		DeletedAt:  deletedAt,
-		Version:    entity.Version,
+		SplitRatio:        toSplitRatioModel(entity.SplitRatio),
+		SplitType:         entity.SplitType.String(),
+		PayerID:           entity.PayerID.Value,
+		ReceiverID:        entity.ReceiverID.Value,
+		PurchaseID:        purchaseID,
+		InstallmentNumber: installmentNumber,
+		InstallmentTotal:  installmentTotal,
+		TagIDs:            tagIDs,
+		CreatedAt:         entity.CreatedAt,
+		UpdatedAt:         entity.UpdatedAt,
+		DeletedAt:         deletedAt,
+		Version:           entity.Version,
+		UpdatedBy:         updatedBy,
 	}
 }
 
+func toSplitRatioEntity(model SplitRatio) expense.SplitRatio {
+	shares := make([]expense.Share, 0, len(model.Shares))
+	for _, share := range model.Shares {
+		shares = append(shares, expense.Share{
+			UserID:  user.ID{Value: share.UserID},
+			Percent: share.Percent,
+			Amount:  share.Amount,
+			Weight:  share.Weight,
+		})
+	}
+
+	return expense.SplitRatio{Shares: shares}
+}
+
+func toSplitRatioModel(entity expense.SplitRatio) SplitRatio {
+	shares := make([]Share, 0, len(entity.Shares))
+	for _, share := range entity.Shares {
+		shares = append(shares, Share{
+			UserID:  share.UserID.Value,
+			Percent: share.Percent,
+			Amount:  share.Amount,
+			Weight:  share.Weight,
+		})
+	}
+
+	return SplitRatio{Shares: shares}
+}
+
 func ToScheduledExpenseModel(entity expense.ScheduledExpense) ScheduledExpenseModel {
 	var lastGeneratedAt sql.Null[civil.Date]
 	if entity.LastGeneratedAt != nil {
 		lastGeneratedAt = sql.Null[civil.Date]{V: *entity.LastGeneratedAt, Valid: true}
 	}
 
+	var recurrence sql.Null[Recurrence]
+	if entity.Recurrence != nil {
+		recurrence = sql.Null[Recurrence]{V: toRecurrenceModel(*entity.Recurrence), Valid: true}
+	}
+
+	var deletedAt sql.NullTime
+	if entity.DeletedAt != nil {
+		deletedAt = sql.NullTime{Time: *entity.DeletedAt, Valid: true}
+	}
+
 	return ScheduledExpenseModel{
 		ID:              entity.ID.Value,
 		Name:            entity.Name,
@@ -99,10 +177,13 @@ func ToScheduledExpenseModel(entity expense.ScheduledExpense) ScheduledExpenseMo
 		PayerID:         entity.PayerID.Value,
 		ReceiverID:      entity.ReceiverID.Value,
 		FrequencyInDays: entity.FrequencyInDays,
+		Recurrence:      recurrence,
 		LastGeneratedAt: lastGeneratedAt,
+		OccurrenceCount: entity.OccurrenceCount,
 		IsActive:        entity.IsActive,
 		CreatedAt:       entity.CreatedAt,
 		UpdatedAt:       entity.UpdatedAt,
+		DeletedAt:       deletedAt,
 		Version:         entity.Version,
 	}
 }
@@ -113,11 +194,23 @@ func ToScheduledExpenseEntity(model ScheduledExpenseModel) expense.ScheduledExpe
 		lastGeneratedAt = &model.LastGeneratedAt.V
 	}
 
+	var recurrence *expense.Recurrence
+	if model.Recurrence.Valid {
+		r := toRecurrenceEntity(model.Recurrence.V)
+		recurrence = &r
+	}
+
+	var deletedAt *time.Time
+	if model.DeletedAt.Valid {
+		deletedAt = &model.DeletedAt.Time
+	}
+
 	return expense.ScheduledExpense{
 		Entity: ddd.Entity[expense.ScheduledExpenseID]{
 			ID:        expense.ScheduledExpenseID{Value: model.ID},
 			CreatedAt: model.CreatedAt,
 			UpdatedAt: model.UpdatedAt,
+			DeletedAt: deletedAt,
 			Version:   model.Version,
 		},
 		Name:            model.Name,
@@ -129,7 +222,205 @@ func ToScheduledExpenseEntity(model ScheduledExpenseModel) expense.ScheduledExpe
 		PayerID:         user.ID{Value: model.PayerID},
 		ReceiverID:      user.ID{Value: model.ReceiverID},
 		FrequencyInDays: model.FrequencyInDays,
+		Recurrence:      recurrence,
 		LastGeneratedAt: lastGeneratedAt,
+		OccurrenceCount: model.OccurrenceCount,
 		IsActive:        model.IsActive,
 	}
 }
+
+func toRecurrenceModel(entity expense.Recurrence) Recurrence {
+	var weekday *int
+	if entity.Weekday != nil {
+		wd := int(*entity.Weekday)
+		weekday = &wd
+	}
+
+	return Recurrence{
+		Frequency:   entity.Frequency.String(),
+		Interval:    entity.Interval,
+		DayOfMonth:  entity.DayOfMonth,
+		Weekday:     weekday,
+		Month:       int(entity.Month),
+		BusinessDay: entity.BusinessDay.String(),
+		StartDate:   entity.StartDate,
+		EndDate:     entity.EndDate,
+		MaxCount:    entity.MaxCount,
+	}
+}
+
+func toRecurrenceEntity(model Recurrence) expense.Recurrence {
+	var weekday *time.Weekday
+	if model.Weekday != nil {
+		wd := time.Weekday(*model.Weekday)
+		weekday = &wd
+	}
+
+	return expense.Recurrence{
+		Frequency:   expense.Frequency(model.Frequency),
+		Interval:    model.Interval,
+		DayOfMonth:  model.DayOfMonth,
+		Weekday:     weekday,
+		Month:       time.Month(model.Month),
+		BusinessDay: expense.BusinessDayRule(model.BusinessDay),
+		StartDate:   model.StartDate,
+		EndDate:     model.EndDate,
+		MaxCount:    model.MaxCount,
+	}
+}
+
+func ToImportCandidateModel(entity expense.ImportCandidate) ImportCandidateModel {
+	var categoryID sql.NullInt64
+	if entity.CategoryID != nil {
+		categoryID = sql.NullInt64{Int64: int64(entity.CategoryID.Value), Valid: true}
+	}
+
+	var expenseID sql.NullInt64
+	if entity.ExpenseID != nil {
+		expenseID = sql.NullInt64{Int64: int64(entity.ExpenseID.Value), Valid: true}
+	}
+
+	return ImportCandidateModel{
+		ID:          entity.ID.Value,
+		GroupID:     entity.GroupID.Value,
+		Source:      entity.Source,
+		ExternalID:  entity.ExternalID,
+		Name:        entity.Name,
+		AmountCents: entity.Amount,
+		Date:        entity.Date.In(time.UTC),
+		CategoryID:  categoryID,
+		Status:      string(entity.Status),
+		ExpenseID:   expenseID,
+		ImportedBy:  entity.ImportedBy.Value,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+		Version:     entity.Version,
+	}
+}
+
+func ToImportCandidateEntity(model ImportCandidateModel) expense.ImportCandidate {
+	var categoryID *category.ID
+	if model.CategoryID.Valid {
+		categoryID = &category.ID{Value: int(model.CategoryID.Int64)}
+	}
+
+	var expenseID *expense.ID
+	if model.ExpenseID.Valid {
+		expenseID = &expense.ID{Value: int(model.ExpenseID.Int64)}
+	}
+
+	return expense.ImportCandidate{
+		Entity: ddd.Entity[expense.ImportCandidateID]{
+			ID:        expense.ImportCandidateID{Value: model.ID},
+			CreatedAt: model.CreatedAt,
+			UpdatedAt: model.UpdatedAt,
+			Version:   model.Version,
+		},
+		GroupID:    group.ID{Value: model.GroupID},
+		Source:     model.Source,
+		ExternalID: model.ExternalID,
+		Name:       model.Name,
+		Amount:     model.AmountCents,
+		Date:       civil.DateOf(model.Date),
+		CategoryID: categoryID,
+		Status:     expense.ImportCandidateStatus(model.Status),
+		ExpenseID:  expenseID,
+		ImportedBy: user.ID{Value: model.ImportedBy},
+	}
+}
+
+func ToAttachmentModel(entity expense.Attachment) AttachmentModel {
+	var thumbnailKey sql.NullString
+	if entity.ThumbnailKey != nil {
+		thumbnailKey = sql.NullString{String: *entity.ThumbnailKey, Valid: true}
+	}
+
+	return AttachmentModel{
+		ID:           entity.ID.Value,
+		ExpenseID:    entity.ExpenseID.Value,
+		GroupID:      entity.GroupID.Value,
+		FileName:     entity.FileName,
+		ContentType:  entity.ContentType,
+		SizeBytes:    entity.Size,
+		StorageKey:   entity.StorageKey,
+		ThumbnailKey: thumbnailKey,
+		UploadedBy:   entity.UploadedBy.Value,
+		CreatedAt:    entity.CreatedAt,
+		UpdatedAt:    entity.UpdatedAt,
+		Version:      entity.Version,
+	}
+}
+
+func ToAttachmentEntity(model AttachmentModel) expense.Attachment {
+	var thumbnailKey *string
+	if model.ThumbnailKey.Valid {
+		thumbnailKey = &model.ThumbnailKey.String
+	}
+
+	return expense.Attachment{
+		Entity: ddd.Entity[expense.AttachmentID]{
+			ID:        expense.AttachmentID{Value: model.ID},
+			CreatedAt: model.CreatedAt,
+			UpdatedAt: model.UpdatedAt,
+			Version:   model.Version,
+		},
+		ExpenseID:    expense.ID{Value: model.ExpenseID},
+		GroupID:      group.ID{Value: model.GroupID},
+		FileName:     model.FileName,
+		ContentType:  model.ContentType,
+		Size:         model.SizeBytes,
+		StorageKey:   model.StorageKey,
+		ThumbnailKey: thumbnailKey,
+		UploadedBy:   user.ID{Value: model.UploadedBy},
+	}
+}
+
+func ToBudgetModel(entity expense.Budget) BudgetModel {
+	var categoryID sql.NullInt64
+	if entity.CategoryID != nil {
+		categoryID = sql.NullInt64{Int64: int64(entity.CategoryID.Value), Valid: true}
+	}
+
+	var categoryGroupID sql.NullInt64
+	if entity.CategoryGroupID != nil {
+		categoryGroupID = sql.NullInt64{Int64: int64(entity.CategoryGroupID.Value), Valid: true}
+	}
+
+	return BudgetModel{
+		ID:              entity.ID.Value,
+		GroupID:         entity.GroupID.Value,
+		CategoryID:      categoryID,
+		CategoryGroupID: categoryGroupID,
+		AmountCents:     entity.Amount,
+		CarryOver:       entity.CarryOver,
+		CreatedAt:       entity.CreatedAt,
+		UpdatedAt:       entity.UpdatedAt,
+		Version:         entity.Version,
+	}
+}
+
+func ToBudgetEntity(model BudgetModel) expense.Budget {
+	var categoryID *category.ID
+	if model.CategoryID.Valid {
+		categoryID = &category.ID{Value: int(model.CategoryID.Int64)}
+	}
+
+	var categoryGroupID *category.GroupID
+	if model.CategoryGroupID.Valid {
+		categoryGroupID = &category.GroupID{Value: int(model.CategoryGroupID.Int64)}
+	}
+
+	return expense.Budget{
+		Entity: ddd.Entity[expense.BudgetID]{
+			ID:        expense.BudgetID{Value: model.ID},
+			CreatedAt: model.CreatedAt,
+			UpdatedAt: model.UpdatedAt,
+			Version:   model.Version,
+		},
+		GroupID:         group.ID{Value: model.GroupID},
+		CategoryID:      categoryID,
+		CategoryGroupID: categoryGroupID,
+		Amount:          model.AmountCents,
+		CarryOver:       model.CarryOver,
+	}
+}
@@ -27,7 +27,7 @@ func TestToEntity_CompleteModel(t *testing.T) {
 		Description:       "Test Description",
 		GroupID:           1,
 		CategoryID:        1,
-		SplitRatio:        SplitRatio{Payer: 70, Receiver: 30},
+		SplitRatio:        SplitRatio{Shares: []Share{{UserID: 1, Percent: 70}, {UserID: 2, Percent: 30}}},
 		SplitType:         "custom",
 		PayerID:           1,
 		ReceiverID:        2,
@@ -47,8 +47,8 @@ func TestToEntity_CompleteModel(t *testing.T) {
 	assert.Equal(t, "Test Description", entity.Description)
 	assert.Equal(t, group.ID{Value: 1}, entity.GroupID)
 	assert.Equal(t, category.ID{Value: 1}, entity.CategoryID)
-	assert.Equal(t, 70, entity.SplitRatio.Payer)
-	assert.Equal(t, 30, entity.SplitRatio.Receiver)
+	assert.Equal(t, 70, entity.SplitRatio.ShareOf(user.ID{Value: 1}))
+	assert.Equal(t, 30, entity.SplitRatio.ShareOf(user.ID{Value: 2}))
 	assert.Equal(t, expense.SplitType("custom"), entity.SplitType)
 	assert.Equal(t, user.ID{Value: 1}, entity.PayerID)
 	assert.Equal(t, user.ID{Value: 2}, entity.ReceiverID)
@@ -68,7 +68,7 @@ func TestToEntity_WithoutOptionalFields(t *testing.T) {
 		Description:       "Simple Description",
 		GroupID:           1,
 		CategoryID:        1,
-		SplitRatio:        SplitRatio{Payer: 50, Receiver: 50},
+		SplitRatio:        SplitRatio{Shares: []Share{{UserID: 1, Percent: 50}, {UserID: 2, Percent: 50}}},
 		SplitType:         "equal",
 		PayerID:           1,
 		ReceiverID:        2,
@@ -108,7 +108,7 @@ func TestToModel_CompleteEntity(t *testing.T) {
 		Description:  "Test Description",
 		GroupID:      group.ID{Value: 1},
 		CategoryID:   category.ID{Value: 1},
-		SplitRatio:   expense.SplitRatio{Payer: 70, Receiver: 30},
+		SplitRatio:   expense.SplitRatio{Shares: []expense.Share{{UserID: user.ID{Value: 1}, Percent: 70}, {UserID: user.ID{Value: 2}, Percent: 30}}},
 		SplitType:    expense.SplitType("custom"),
 		PayerID:      user.ID{Value: 1},
 		ReceiverID:   user.ID{Value: 2},
@@ -124,8 +124,7 @@ func TestToModel_CompleteEntity(t *testing.T) {
 	assert.Equal(t, "Test Description", model.Description)
 	assert.Equal(t, 1, model.GroupID)
 	assert.Equal(t, 1, model.CategoryID)
-	assert.Equal(t, 70, model.SplitRatio.Payer)
-	assert.Equal(t, 30, model.SplitRatio.Receiver)
+	assert.Equal(t, []Share{{UserID: 1, Percent: 70}, {UserID: 2, Percent: 30}}, model.SplitRatio.Shares)
 	assert.Equal(t, "custom", model.SplitType)
 	assert.Equal(t, 1, model.PayerID)
 	assert.Equal(t, 2, model.ReceiverID)
@@ -151,7 +150,7 @@ func TestToModel_WithoutOptionalFields(t *testing.T) {
 		Description:  "Simple Description",
 		GroupID:      group.ID{Value: 1},
 		CategoryID:   category.ID{Value: 1},
-		SplitRatio:   expense.SplitRatio{Payer: 50, Receiver: 50},
+		SplitRatio:   expense.SplitRatio{Shares: []expense.Share{{UserID: user.ID{Value: 1}, Percent: 50}, {UserID: user.ID{Value: 2}, Percent: 50}}},
 		SplitType:    expense.SplitType("equal"),
 		PayerID:      user.ID{Value: 1},
 		ReceiverID:   user.ID{Value: 2},
@@ -278,3 +277,63 @@ func TestToScheduledExpenseEntity_WithoutLastGeneratedAt(t *testing.T) {
 	assert.True(t, entity.IsActive)
 	assert.Equal(t, 0, entity.Version)
 }
+
+func TestToEntity_WithInstallment(t *testing.T) {
+	model := ExpenseModel{
+		ID:                3,
+		Name:              "Installment",
+		AmountCents:       1000,
+		SplitRatio:        SplitRatio{Shares: []Share{{UserID: 1, Percent: 50}, {UserID: 2, Percent: 50}}},
+		SplitType:         "equal",
+		PayerID:           1,
+		ReceiverID:        2,
+		PurchaseID:        sql.NullString{String: "purchase", Valid: true},
+		InstallmentNumber: sql.NullInt64{Int64: 3, Valid: true},
+		InstallmentTotal:  sql.NullInt64{Int64: 10, Valid: true},
+	}
+
+	entity := ToEntity(model)
+
+	assert.NotNil(t, entity.Installment)
+	assert.Equal(t, "purchase", entity.Installment.PurchaseID)
+	assert.Equal(t, "3/10", entity.Installment.String())
+	assert.Equal(t, model, ToModel(entity))
+}
+
+func TestScheduledExpenseMapper_WithRecurrence(t *testing.T) {
+	weekday := time.Friday
+	start := civil.Date{Year: 2025, Month: time.January, Day: 1}
+	entity := expense.ScheduledExpense{
+		Entity:    ddd.Entity[expense.ScheduledExpenseID]{ID: expense.ScheduledExpenseID{Value: 3}},
+		Name:      "Rent",
+		Amount:    150000,
+		SplitType: expense.SplitTypes.Proportional,
+		IsActive:  true,
+		Recurrence: &expense.Recurrence{
+			Frequency:   expense.Frequencies.Monthly,
+			Interval:    1,
+			DayOfMonth:  expense.LastDayOfMonth,
+			Weekday:     &weekday,
+			BusinessDay: expense.BusinessDayRules.Previous,
+			StartDate:   &start,
+			MaxCount:    12,
+		},
+		OccurrenceCount: 3,
+	}
+
+	model := ToScheduledExpenseModel(entity)
+	assert.True(t, model.Recurrence.Valid)
+	assert.Equal(t, "monthly", model.Recurrence.V.Frequency)
+	assert.Equal(t, 5, *model.Recurrence.V.Weekday)
+	assert.Equal(t, 3, model.OccurrenceCount)
+
+	value, err := model.Recurrence.V.Value()
+	assert.NoError(t, err)
+	var scanned Recurrence
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, model.Recurrence.V, scanned)
+
+	back := ToScheduledExpenseEntity(model)
+	assert.Equal(t, entity.Recurrence, back.Recurrence)
+	assert.Equal(t, 3, back.OccurrenceCount)
+}
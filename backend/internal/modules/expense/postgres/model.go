@@ -8,29 +8,41 @@ import (
 	"time"
 
 	"cloud.google.com/go/civil"
+	"github.com/lib/pq"
 )
 
 type ExpenseModel struct {
-	ID                int           `db:"id"`
-	Name              string        `db:"name"`
-	AmountCents       int           `db:"amount_cents"`
-	RefundAmountCents sql.NullInt64 `db:"refund_amount_cents"`
-	Description       string        `db:"description"`
-	GroupID           int           `db:"group_id"`
-	CategoryID        int           `db:"category_id"`
-	SplitRatio        SplitRatio    `db:"split_ratio"`
-	SplitType         string        `db:"split_type"`
-	PayerID           int           `db:"payer_id"`
-	ReceiverID        int           `db:"receiver_id"`
-	CreatedAt         time.Time     `db:"created_at"`
-	UpdatedAt         time.Time     `db:"updated_at"`
-	DeletedAt         sql.NullTime  `db:"deleted_at"`
-	Version           int           `db:"version"`
+	ID                int            `db:"id"`
+	Name              string         `db:"name"`
+	AmountCents       int            `db:"amount_cents"`
+	RefundAmountCents sql.NullInt64  `db:"refund_amount_cents"`
+	Description       string         `db:"description"`
+	GroupID           int            `db:"group_id"`
+	CategoryID        int            `db:"category_id"`
+	SplitRatio        SplitRatio     `db:"split_ratio"`
+	SplitType         string         `db:"split_type"`
+	PayerID           int            `db:"payer_id"`
+	ReceiverID        int            `db:"receiver_id"`
+	PurchaseID        sql.NullString `db:"purchase_id"`
+	InstallmentNumber sql.NullInt64  `db:"installment_number"`
+	InstallmentTotal  sql.NullInt64  `db:"installment_total"`
+	TagIDs            TagIDs         `db:"tag_ids"`
+	CreatedAt         time.Time      `db:"created_at"`
+	UpdatedAt         time.Time      `db:"updated_at"`
+	DeletedAt         sql.NullTime   `db:"deleted_at"`
+	Version           int            `db:"version"`
+	UpdatedBy         sql.NullInt64  `db:"updated_by"`
 }
 
 type SplitRatio struct {
-	Payer    int `db:"payer" json:"payer"`
-	Receiver int `db:"receiver" json:"receiver"`
+	Shares []Share `db:"shares" json:"shares"`
+}
+
+type Share struct {
+	UserID  int `db:"user_id" json:"user_id"`
+	Percent int `db:"percent" json:"percent"`
+	Amount  int `db:"amount" json:"amount,omitempty"`
+	Weight  int `db:"weight" json:"weight,omitempty"`
 }
 
 func (sr SplitRatio) Value() (driver.Value, error) {
@@ -46,6 +58,33 @@ func (sr *SplitRatio) Scan(value any) error {
 	return json.Unmarshal(b, &sr)
 }
 
+// TagIDs is a bigint[] column. It is never NULL: expenses without tags have an empty array.
+type TagIDs []int
+
+func (t TagIDs) Value() (driver.Value, error) {
+	// A nil pq.Int64Array would be written as NULL
+	ids := make(pq.Int64Array, len(t))
+	for i, id := range t {
+		ids[i] = int64(id)
+	}
+
+	return ids.Value()
+}
+
+func (t *TagIDs) Scan(value any) error {
+	var ids pq.Int64Array
+	if err := ids.Scan(value); err != nil {
+		return err
+	}
+
+	*t = make(TagIDs, len(ids))
+	for i, id := range ids {
+		(*t)[i] = int(id)
+	}
+
+	return nil
+}
+
 type ScheduledExpenseModel struct {
 	ID              int                  `db:"id"`
 	Name            string               `db:"name"`
@@ -57,9 +96,81 @@ type ScheduledExpenseModel struct {
 	PayerID         int                  `db:"payer_id"`
 	ReceiverID      int                  `db:"receiver_id"`
 	FrequencyInDays int                  `db:"frequency_in_days"`
+	Recurrence      sql.Null[Recurrence] `db:"recurrence"`
 	LastGeneratedAt sql.Null[civil.Date] `db:"last_generated_at"`
+	OccurrenceCount int                  `db:"occurrence_count"`
 	IsActive        bool                 `db:"is_active"`
 	CreatedAt       time.Time            `db:"created_at"`
 	UpdatedAt       time.Time            `db:"updated_at"`
+	DeletedAt       sql.NullTime         `db:"deleted_at"`
 	Version         int                  `db:"version"`
 }
+
+type Recurrence struct {
+	Frequency   string      `json:"frequency"`
+	Interval    int         `json:"interval"`
+	DayOfMonth  int         `json:"day_of_month,omitempty"`
+	Weekday     *int        `json:"weekday,omitempty"`
+	Month       int         `json:"month,omitempty"`
+	BusinessDay string      `json:"business_day,omitempty"`
+	StartDate   *civil.Date `json:"start_date,omitempty"`
+	EndDate     *civil.Date `json:"end_date,omitempty"`
+	MaxCount    int         `json:"max_count,omitempty"`
+}
+
+func (r Recurrence) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *Recurrence) Scan(value any) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &r)
+}
+
+type ImportCandidateModel struct {
+	ID          int           `db:"id"`
+	GroupID     int           `db:"group_id"`
+	Source      string        `db:"source"`
+	ExternalID  string        `db:"external_id"`
+	Name        string        `db:"name"`
+	AmountCents int           `db:"amount_cents"`
+	Date        time.Time     `db:"date"`
+	CategoryID  sql.NullInt64 `db:"category_id"`
+	Status      string        `db:"status"`
+	ExpenseID   sql.NullInt64 `db:"expense_id"`
+	ImportedBy  int           `db:"imported_by"`
+	CreatedAt   time.Time     `db:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at"`
+	Version     int           `db:"version"`
+}
+
+type AttachmentModel struct {
+	ID           int            `db:"id"`
+	ExpenseID    int            `db:"expense_id"`
+	GroupID      int            `db:"group_id"`
+	FileName     string         `db:"file_name"`
+	ContentType  string         `db:"content_type"`
+	SizeBytes    int            `db:"size_bytes"`
+	StorageKey   string         `db:"storage_key"`
+	ThumbnailKey sql.NullString `db:"thumbnail_key"`
+	UploadedBy   int            `db:"uploaded_by"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+	Version      int            `db:"version"`
+}
+
+type BudgetModel struct {
+	ID              int           `db:"id"`
+	GroupID         int           `db:"group_id"`
+	CategoryID      sql.NullInt64 `db:"category_id"`
+	CategoryGroupID sql.NullInt64 `db:"category_group_id"`
+	AmountCents     int           `db:"amount_cents"`
+	CarryOver       bool          `db:"carry_over"`
+	CreatedAt       time.Time     `db:"created_at"`
+	UpdatedAt       time.Time     `db:"updated_at"`
+	Version         int           `db:"version"`
+}
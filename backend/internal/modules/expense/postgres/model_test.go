@@ -8,8 +8,7 @@ import (
 
 func TestSplitRatio_Value_Success(t *testing.T) {
 	sr := SplitRatio{
-		Payer:    60,
-		Receiver: 40,
+		Shares: []Share{{UserID: 1, Percent: 60}, {UserID: 2, Percent: 40}},
 	}
 
 	value, err := sr.Value()
@@ -17,18 +16,17 @@ func TestSplitRatio_Value_Success(t *testing.T) {
 	assert.NotNil(t, value)
 
 	// Verify the JSON output
-	expected := `{"payer":60,"receiver":40}`
+	expected := `{"shares":[{"user_id":1,"percent":60},{"user_id":2,"percent":40}]}`
 	assert.JSONEq(t, expected, string(value.([]byte)))
 }
 
 func TestSplitRatio_Scan_Success(t *testing.T) {
 	var sr SplitRatio
-	jsonData := []byte(`{"payer":70,"receiver":30}`)
+	jsonData := []byte(`{"shares":[{"user_id":1,"percent":70},{"user_id":2,"percent":30}]}`)
 
 	err := sr.Scan(jsonData)
 	assert.NoError(t, err)
-	assert.Equal(t, 70, sr.Payer)
-	assert.Equal(t, 30, sr.Receiver)
+	assert.Equal(t, []Share{{UserID: 1, Percent: 70}, {UserID: 2, Percent: 30}}, sr.Shares)
 }
 
 func TestSplitRatio_Scan_InvalidType(t *testing.T) {
@@ -41,7 +39,7 @@ func TestSplitRatio_Scan_InvalidType(t *testing.T) {
 
 func TestSplitRatio_Scan_InvalidJSON(t *testing.T) {
 	var sr SplitRatio
-	invalidJSON := []byte(`{"payer":70,"receiver":}`)
+	invalidJSON := []byte(`{"shares":[{"user_id":1,"percent":}]}`)
 
 	err := sr.Scan(invalidJSON)
 	assert.Error(t, err)
@@ -49,8 +47,7 @@ func TestSplitRatio_Scan_InvalidJSON(t *testing.T) {
 
 func TestSplitRatio_RoundTrip(t *testing.T) {
 	original := SplitRatio{
-		Payer:    80,
-		Receiver: 20,
+		Shares: []Share{{UserID: 1, Percent: 50}, {UserID: 2, Percent: 30}, {UserID: 3, Percent: 20}},
 	}
 
 	// Convert to value (serialize)
@@ -63,8 +60,7 @@ func TestSplitRatio_RoundTrip(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Verify they are equal
-	assert.Equal(t, original.Payer, restored.Payer)
-	assert.Equal(t, original.Receiver, restored.Receiver)
+	assert.Equal(t, original.Shares, restored.Shares)
 }
 
 func TestSplitRatio_Scan_NilValue(t *testing.T) {
@@ -77,14 +73,32 @@ func TestSplitRatio_Scan_NilValue(t *testing.T) {
 
 func TestSplitRatio_Value_ZeroValues(t *testing.T) {
 	sr := SplitRatio{
-		Payer:    0,
-		Receiver: 0,
+		Shares: []Share{{UserID: 1, Percent: 0}, {UserID: 2, Percent: 0}},
 	}
 
 	value, err := sr.Value()
 	assert.NoError(t, err)
 	assert.NotNil(t, value)
 
-	expected := `{"payer":0,"receiver":0}`
+	expected := `{"shares":[{"user_id":1,"percent":0},{"user_id":2,"percent":0}]}`
 	assert.JSONEq(t, expected, string(value.([]byte)))
 }
+
+func TestTagIDs_Value(t *testing.T) {
+	value, err := TagIDs{1, 3}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{1,3}", value)
+
+	value, err = TagIDs(nil).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", value)
+}
+
+func TestTagIDs_Scan(t *testing.T) {
+	var tagIDs TagIDs
+	assert.NoError(t, tagIDs.Scan([]byte("{1,3}")))
+	assert.Equal(t, TagIDs{1, 3}, tagIDs)
+
+	assert.NoError(t, tagIDs.Scan("{}"))
+	assert.Equal(t, TagIDs{}, tagIDs)
+}
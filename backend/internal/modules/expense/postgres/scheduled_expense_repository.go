@@ -5,11 +5,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
+	"cloud.google.com/go/civil"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 type ScheduledExpenseRepository struct {
@@ -31,7 +35,7 @@ func (repo *ScheduledExpenseRepository) GetNextID() expense.ScheduledExpenseID {
 func (repo *ScheduledExpenseRepository) GetByID(ctx context.Context, id expense.ScheduledExpenseID) (*expense.ScheduledExpense, error) {
 	var model ScheduledExpenseModel
 
-	conn := repo.db.Conn()
+	conn := repo.db.Executor(ctx)
 
 	if err := conn.QueryRowxContext(ctx, `
 		SELECT 
@@ -45,13 +49,16 @@ func (repo *ScheduledExpenseRepository) GetByID(ctx context.Context, id expense.
 			payer_id,
 			receiver_id,
 			frequency_in_days,
+			recurrence,
 			last_generated_at,
+			occurrence_count,
 			is_active,
 			created_at,
 			updated_at,
+			deleted_at,
 			version
 		FROM scheduled_expenses 
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`, id.Value).StructScan(&model); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -65,7 +72,7 @@ func (repo *ScheduledExpenseRepository) GetByID(ctx context.Context, id expense.
 }
 
 func (repo *ScheduledExpenseRepository) GetActiveScheduledExpenses(ctx context.Context) ([]expense.ScheduledExpense, error) {
-	conn := repo.db.Conn()
+	conn := repo.db.Executor(ctx)
 	var models []ScheduledExpenseModel
 
 	if err := conn.SelectContext(ctx, &models, `
@@ -80,15 +87,20 @@ func (repo *ScheduledExpenseRepository) GetActiveScheduledExpenses(ctx context.C
 			payer_id,
 			receiver_id,
 			frequency_in_days,
+			recurrence,
 			last_generated_at,
+			occurrence_count,
 			is_active,
 			created_at,
 			updated_at,
+			deleted_at,
 			version
 		FROM scheduled_expenses
 		WHERE is_active = true
+		AND deleted_at IS NULL
 		AND (
-			last_generated_at IS NULL
+			recurrence IS NOT NULL
+			OR last_generated_at IS NULL
 			OR (last_generated_at + INTERVAL '1 day' * frequency_in_days <= CURRENT_DATE)
 		)
 	`); err != nil {
@@ -106,6 +118,46 @@ func (repo *ScheduledExpenseRepository) GetActiveScheduledExpenses(ctx context.C
 	return entities, nil
 }
 
+func (repo *ScheduledExpenseRepository) GetByGroupID(ctx context.Context, groupID group.ID) ([]expense.ScheduledExpense, error) {
+	conn := repo.db.Executor(ctx)
+	var models []ScheduledExpenseModel
+
+	if err := conn.SelectContext(ctx, &models, `
+		SELECT 
+			id,
+			name,
+			amount_cents,
+			description,
+			group_id,
+			category_id,
+			split_type,
+			payer_id,
+			receiver_id,
+			frequency_in_days,
+			recurrence,
+			last_generated_at,
+			occurrence_count,
+			is_active,
+			created_at,
+			updated_at,
+			deleted_at,
+			version
+		FROM scheduled_expenses
+		WHERE group_id = $1
+		AND deleted_at IS NULL
+		ORDER BY is_active DESC, name, id
+	`, groupID.Value); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	entities := make([]expense.ScheduledExpense, 0, len(models))
+	for _, model := range models {
+		entities = append(entities, ToScheduledExpenseEntity(model))
+	}
+
+	return entities, nil
+}
+
 func (repo *ScheduledExpenseRepository) Store(ctx context.Context, entity *expense.ScheduledExpense) error {
 	return repo.BulkStore(ctx, []expense.ScheduledExpense{*entity})
 }
@@ -113,26 +165,8 @@ func (repo *ScheduledExpenseRepository) Store(ctx context.Context, entity *expen
 func (repo *ScheduledExpenseRepository) BulkStore(ctx context.Context, entities []expense.ScheduledExpense) error {
 	return repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
 		for _, entity := range entities {
-			model := ToScheduledExpenseModel(entity)
-
-			if _, err := tx.NamedExecContext(ctx, `
-				INSERT INTO scheduled_expenses (id, name, amount_cents, description, group_id, category_id, split_type, payer_id, receiver_id, frequency_in_days, last_generated_at, is_active, created_at, updated_at, version) 
-				VALUES (:id, :name, :amount_cents, :description, :group_id, :category_id, :split_type, :payer_id, :receiver_id, :frequency_in_days, :last_generated_at, :is_active, :created_at, :updated_at, :version)
-				ON CONFLICT (id) DO UPDATE SET
-					name = :name,
-					amount_cents = :amount_cents,
-					description = :description,
-					category_id = :category_id,
-					split_type = :split_type,
-					payer_id = :payer_id,
-					receiver_id = :receiver_id,
-					frequency_in_days = :frequency_in_days,
-					last_generated_at = :last_generated_at,
-					is_active = :is_active,
-					updated_at = :updated_at,
-					version = :version
-			`, model); err != nil {
-				return fmt.Errorf("db.ExecContext: %w", err)
+			if err := upsertScheduledExpense(ctx, tx, entity); err != nil {
+				return err
 			}
 		}
 
@@ -140,6 +174,127 @@ func (repo *ScheduledExpenseRepository) BulkStore(ctx context.Context, entities
 	})
 }
 
+func (repo *ScheduledExpenseRepository) StoreOccurrences(ctx context.Context, entity *expense.ScheduledExpense, occurrences []expense.ScheduledOccurrence) ([]civil.Date, error) {
+	var (
+		registered []civil.Date
+		updated    bool
+	)
+
+	if err := repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		registered, updated = nil, false
+
+		// The scheduled expense is read before the transaction, so it may have been paused or
+		// deleted since. Locking it keeps it that way until its occurrences are registered.
+		var active bool
+		if err := tx.QueryRowxContext(ctx, `
+			SELECT is_active AND deleted_at IS NULL FROM scheduled_expenses WHERE id = $1 FOR UPDATE
+		`, entity.ID.Value).Scan(&active); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("db.QueryRowxContext: %w", err)
+		}
+
+		if !active {
+			return nil
+		}
+
+		for _, occurrence := range occurrences {
+			result, err := tx.ExecContext(ctx, `
+				INSERT INTO scheduled_expense_occurrences (scheduled_expense_id, due_date, created_at)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (scheduled_expense_id, due_date) DO NOTHING
+			`, entity.ID.Value, occurrence.DueDate.String(), time.Now())
+			if err != nil {
+				return fmt.Errorf("db.ExecContext: %w", err)
+			}
+
+			inserted, err := result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("result.RowsAffected: %w", err)
+			}
+
+			if inserted == 0 {
+				continue
+			}
+
+			if err := outbox.Write(ctx, tx, occurrence.Event); err != nil {
+				return fmt.Errorf("outbox.Write: %w", err)
+			}
+			registered = append(registered, occurrence.DueDate)
+		}
+
+		if err := repo.updateGeneration(ctx, tx, *entity); err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if updated {
+		entity.Version++
+	}
+
+	return registered, nil
+}
+
+// updateGeneration only records the occurrences generated, leaving anything changed since the
+// scheduled expense was read to the version check.
+func (repo *ScheduledExpenseRepository) updateGeneration(ctx context.Context, tx *sqlx.Tx, entity expense.ScheduledExpense) error {
+	model := ToScheduledExpenseModel(entity)
+
+	result, err := tx.NamedExecContext(ctx, `
+		UPDATE scheduled_expenses SET last_generated_at = :last_generated_at, occurrence_count = :occurrence_count, updated_at = :updated_at, version = version + 1
+		WHERE id = :id AND version = :version
+	`, model)
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "scheduled_expenses", model.ID))
+	}
+
+	return nil
+}
+
+func upsertScheduledExpense(ctx context.Context, tx *sqlx.Tx, entity expense.ScheduledExpense) error {
+	model := ToScheduledExpenseModel(entity)
+
+	if _, err := tx.NamedExecContext(ctx, `
+		INSERT INTO scheduled_expenses (id, name, amount_cents, description, group_id, category_id, split_type, payer_id, receiver_id, frequency_in_days, recurrence, last_generated_at, occurrence_count, is_active, created_at, updated_at, deleted_at, version) 
+		VALUES (:id, :name, :amount_cents, :description, :group_id, :category_id, :split_type, :payer_id, :receiver_id, :frequency_in_days, :recurrence, :last_generated_at, :occurrence_count, :is_active, :created_at, :updated_at, :deleted_at, :version)
+		ON CONFLICT (id) DO UPDATE SET
+			name = :name,
+			amount_cents = :amount_cents,
+			description = :description,
+			category_id = :category_id,
+			split_type = :split_type,
+			payer_id = :payer_id,
+			receiver_id = :receiver_id,
+			frequency_in_days = :frequency_in_days,
+			recurrence = :recurrence,
+			last_generated_at = :last_generated_at,
+			occurrence_count = :occurrence_count,
+			is_active = :is_active,
+			updated_at = :updated_at,
+			deleted_at = :deleted_at,
+			version = :version
+	`, model); err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
 func NewScheduledExpenseRepository(db *db.Client) expense.ScheduledExpenseRepository {
 	return &ScheduledExpenseRepository{db: db}
 }
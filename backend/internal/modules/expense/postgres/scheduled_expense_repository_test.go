@@ -16,6 +16,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 type ScheduledExpenseRepositoryTestSuite struct {
@@ -38,7 +39,7 @@ func (s *ScheduledExpenseRepositoryTestSuite) SetupSuite() {
 }
 
 func (s *ScheduledExpenseRepositoryTestSuite) TearDownSubTest() {
-	s.NoError(s.db.Clean("scheduled_expenses"))
+	s.NoError(s.db.Clean("scheduled_expenses", "scheduled_expense_occurrences", "outbox_events"))
 }
 
 func (s *ScheduledExpenseRepositoryTestSuite) TestPgScheduledExpenseRepo_Store() {
@@ -167,3 +168,111 @@ func (s *ScheduledExpenseRepositoryTestSuite) TestPgScheduledExpenseRepo_GetActi
 	s.Equal(scheduledExpense.ReceiverID, activeExpenses[0].ReceiverID)
 	s.Equal(scheduledExpense.FrequencyInDays, activeExpenses[0].FrequencyInDays)
 }
+
+func (s *ScheduledExpenseRepositoryTestSuite) TestPgScheduledExpenseRepo_GetByGroupID() {
+	var ids []expense.ScheduledExpenseID
+	for _, groupID := range []int{1, 1, 2} {
+		scheduledExpense, err := expense.NewScheduledExpense(expense.ScheduledExpenseAttributes{
+			ID:              s.scheduledExpenseRepo.GetNextID(),
+			Name:            "Test Scheduled Expense",
+			Amount:          1000,
+			Description:     "Test Description",
+			GroupID:         group.ID{Value: groupID},
+			CategoryID:      category.ID{Value: 1},
+			SplitType:       expense.SplitTypes.Equal,
+			PayerID:         user.ID{Value: 1},
+			ReceiverID:      user.ID{Value: 2},
+			FrequencyInDays: 7,
+		})
+		s.NoError(err)
+		s.NoError(s.scheduledExpenseRepo.Store(s.ctx, scheduledExpense))
+		ids = append(ids, scheduledExpense.ID)
+	}
+
+	deleted, err := s.scheduledExpenseRepo.GetByID(s.ctx, ids[1])
+	s.NoError(err)
+	deleted.Delete()
+	s.NoError(s.scheduledExpenseRepo.Store(s.ctx, deleted))
+
+	scheduledExpenses, err := s.scheduledExpenseRepo.GetByGroupID(s.ctx, group.ID{Value: 1})
+	s.NoError(err)
+	s.Len(scheduledExpenses, 1)
+	s.Equal(ids[0], scheduledExpenses[0].ID)
+
+	retrieved, err := s.scheduledExpenseRepo.GetByID(s.ctx, ids[1])
+	s.NoError(err)
+	s.Nil(retrieved)
+}
+
+func (s *ScheduledExpenseRepositoryTestSuite) TestPgScheduledExpenseRepo_StoreOccurrences() {
+	lastGeneratedAt := civil.Date{Year: 2025, Month: time.January, Day: 1}
+	scheduledExpense, err := expense.NewScheduledExpense(expense.ScheduledExpenseAttributes{
+		ID:              s.scheduledExpenseRepo.GetNextID(),
+		Name:            "Test Scheduled Expense",
+		Amount:          1000,
+		Description:     "Test Description",
+		GroupID:         group.ID{Value: 1},
+		CategoryID:      category.ID{Value: 1},
+		SplitType:       expense.SplitTypes.Equal,
+		PayerID:         user.ID{Value: 1},
+		ReceiverID:      user.ID{Value: 2},
+		FrequencyInDays: 30,
+		LastGeneratedAt: &lastGeneratedAt,
+	})
+	s.NoError(err)
+	s.NoError(s.scheduledExpenseRepo.Store(s.ctx, scheduledExpense))
+	stale := *scheduledExpense
+
+	dueDates := []civil.Date{lastGeneratedAt.AddDays(30), lastGeneratedAt.AddDays(60)}
+	occurrences := make([]expense.ScheduledOccurrence, 0, len(dueDates))
+	for _, dueDate := range dueDates {
+		scheduledExpense.UpdateLastGeneratedAt(dueDate)
+		occurrences = append(occurrences, expense.ScheduledOccurrence{
+			DueDate: dueDate,
+			Event:   outbox.NewEvent("expenses.topic", map[string]string{"due_date": dueDate.String()}),
+		})
+	}
+
+	registered, err := s.scheduledExpenseRepo.StoreOccurrences(s.ctx, scheduledExpense, occurrences)
+	s.NoError(err)
+	s.Equal(dueDates, registered)
+
+	// Retrying the same occurrences registers nothing new, nor writes their events again.
+	registered, err = s.scheduledExpenseRepo.StoreOccurrences(s.ctx, scheduledExpense, occurrences)
+	s.NoError(err)
+	s.Empty(registered)
+
+	var events int
+	s.NoError(s.db.Conn().Get(&events, "SELECT count(*) FROM outbox_events WHERE topic = 'expenses.topic'"))
+	s.Equal(len(dueDates), events)
+
+	retrieved, err := s.scheduledExpenseRepo.GetByID(s.ctx, scheduledExpense.ID)
+	s.NoError(err)
+	s.Equal(dueDates[1], *retrieved.LastGeneratedAt)
+	s.Equal(2, retrieved.OccurrenceCount)
+	s.Equal(2, retrieved.Version)
+
+	// A run that read the scheduled expense before the others stored their occurrences conflicts.
+	stale.UpdateLastGeneratedAt(dueDates[0])
+	_, err = s.scheduledExpenseRepo.StoreOccurrences(s.ctx, &stale, occurrences[:1])
+	var conflict *ddd.VersionConflictError
+	s.ErrorAs(err, &conflict)
+	s.Equal(2, conflict.CurrentVersion)
+
+	// Nothing is stored once the scheduled expense is paused.
+	retrieved.Deactivate()
+	s.NoError(s.scheduledExpenseRepo.Store(s.ctx, retrieved))
+	paused := *retrieved
+	paused.UpdateLastGeneratedAt(lastGeneratedAt.AddDays(90))
+	registered, err = s.scheduledExpenseRepo.StoreOccurrences(s.ctx, &paused, []expense.ScheduledOccurrence{{
+		DueDate: lastGeneratedAt.AddDays(90),
+		Event:   outbox.NewEvent("expenses.topic", map[string]string{}),
+	}})
+	s.NoError(err)
+	s.Empty(registered)
+
+	retrieved, err = s.scheduledExpenseRepo.GetByID(s.ctx, scheduledExpense.ID)
+	s.NoError(err)
+	s.False(retrieved.IsActive)
+	s.Equal(2, retrieved.OccurrenceCount)
+}
@@ -0,0 +1,210 @@
+package expense
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+)
+
+type Frequency string
+
+func (f Frequency) String() string {
+	return string(f)
+}
+
+var Frequencies = struct {
+	Daily   Frequency
+	Weekly  Frequency
+	Monthly Frequency
+	Yearly  Frequency
+}{
+	Daily:   "daily",
+	Weekly:  "weekly",
+	Monthly: "monthly",
+	Yearly:  "yearly",
+}
+
+// BusinessDayRule says what to do when an occurrence falls on a weekend or holiday.
+type BusinessDayRule string
+
+func (r BusinessDayRule) String() string {
+	return string(r)
+}
+
+var BusinessDayRules = struct {
+	Ignore   BusinessDayRule
+	Previous BusinessDayRule
+	Next     BusinessDayRule
+}{
+	Ignore:   "",
+	Previous: "previous",
+	Next:     "next",
+}
+
+// LastDayOfMonth can be used as Recurrence.DayOfMonth. Combined with
+// BusinessDayRules.Previous it means "every last business day of the month".
+const LastDayOfMonth = -1
+
+const maxOccurrenceLookup = 10_000
+
+// Recurrence is the rule that says when a scheduled expense happens.
+//
+// Daily rules happen Interval days after the last generation. Weekly, monthly and yearly
+// rules are anchored to the calendar: on Weekday, on DayOfMonth (clamped to the length
+// of the month) and on Month/DayOfMonth respectively, every Interval weeks, months or
+// years from StartDate. Zero values for Weekday, DayOfMonth and Month default to the
+// ones of StartDate.
+type Recurrence struct {
+	Frequency   Frequency
+	Interval    int
+	DayOfMonth  int
+	Weekday     *time.Weekday
+	Month       time.Month
+	BusinessDay BusinessDayRule
+	StartDate   *civil.Date
+	EndDate     *civil.Date
+	MaxCount    int
+}
+
+// EveryNDays is the rule of scheduled expenses created with a plain frequency in days.
+func EveryNDays(days int) Recurrence {
+	return Recurrence{Frequency: Frequencies.Daily, Interval: days}
+}
+
+func (r Recurrence) validate() error {
+	switch r.Frequency {
+	case Frequencies.Daily, Frequencies.Weekly, Frequencies.Monthly, Frequencies.Yearly:
+	default:
+		return ErrInvalidRecurrence
+	}
+
+	switch r.BusinessDay {
+	case BusinessDayRules.Ignore, BusinessDayRules.Previous, BusinessDayRules.Next:
+	default:
+		return ErrInvalidRecurrence
+	}
+
+	if r.Interval < 1 || r.MaxCount < 0 {
+		return ErrInvalidRecurrence
+	}
+
+	if r.DayOfMonth < LastDayOfMonth || r.DayOfMonth > 31 || r.Month < 0 || r.Month > time.December {
+		return ErrInvalidRecurrence
+	}
+
+	if r.Weekday != nil && (*r.Weekday < time.Sunday || *r.Weekday > time.Saturday) {
+		return ErrInvalidRecurrence
+	}
+
+	if r.StartDate != nil && r.EndDate != nil && r.EndDate.Before(*r.StartDate) {
+		return ErrInvalidRecurrence
+	}
+
+	return nil
+}
+
+// next returns the first occurrence after the given date, or the first one at all when
+// after is nil. generated is the number of occurrences already generated, used to honor
+// MaxCount. It returns false when the rule has no more occurrences.
+func (r Recurrence) next(after *civil.Date, generated int, cal calendar.Calendar) (civil.Date, bool) {
+	if r.MaxCount > 0 && generated >= r.MaxCount {
+		return civil.Date{}, false
+	}
+
+	start := civil.DateOf(time.Now())
+	if r.StartDate != nil {
+		start = *r.StartDate
+	}
+
+	if r.Frequency == Frequencies.Daily {
+		date := start
+		if after != nil && !after.AddDays(r.Interval).Before(start) {
+			date = after.AddDays(r.Interval)
+		}
+		return r.withinEnd(date, r.adjust(date, cal))
+	}
+
+	for k := range maxOccurrenceLookup {
+		nominal := r.nominal(start, k)
+		if nominal.Before(start) {
+			continue
+		}
+
+		date := r.adjust(nominal, cal)
+		if after == nil || date.After(*after) {
+			return r.withinEnd(nominal, date)
+		}
+
+		if r.EndDate != nil && nominal.After(*r.EndDate) {
+			break
+		}
+	}
+
+	return civil.Date{}, false
+}
+
+func (r Recurrence) withinEnd(nominal, date civil.Date) (civil.Date, bool) {
+	if r.EndDate != nil && nominal.After(*r.EndDate) {
+		return civil.Date{}, false
+	}
+
+	return date, true
+}
+
+// nominal returns the k-th date of the rule before any business day adjustment.
+func (r Recurrence) nominal(start civil.Date, k int) civil.Date {
+	switch r.Frequency {
+	case Frequencies.Weekly:
+		first := start
+		if r.Weekday != nil {
+			offset := (int(*r.Weekday) - int(start.In(time.UTC).Weekday()) + 7) % 7
+			first = start.AddDays(offset)
+		}
+		return first.AddDays(7 * r.Interval * k)
+	case Frequencies.Monthly:
+		month := time.Date(start.Year, start.Month+time.Month(r.Interval*k), 1, 0, 0, 0, 0, time.UTC)
+		return r.onDay(month.Year(), month.Month(), start.Day)
+	default:
+		month := start.Month
+		if r.Month != 0 {
+			month = r.Month
+		}
+		return r.onDay(start.Year+r.Interval*k, month, start.Day)
+	}
+}
+
+func (r Recurrence) onDay(year int, month time.Month, defaultDay int) civil.Date {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	day := defaultDay
+	switch {
+	case r.DayOfMonth == LastDayOfMonth:
+		day = lastDay
+	case r.DayOfMonth > 0:
+		day = r.DayOfMonth
+	}
+
+	return civil.Date{Year: year, Month: month, Day: min(day, lastDay)}
+}
+
+func (r Recurrence) adjust(date civil.Date, cal calendar.Calendar) civil.Date {
+	if cal == nil || r.BusinessDay == BusinessDayRules.Ignore {
+		return date
+	}
+
+	step := 1
+	if r.BusinessDay == BusinessDayRules.Previous {
+		step = -1
+	}
+
+	for range 31 {
+		if cal.IsBusinessDay(date) {
+			break
+		}
+		date = date.AddDays(step)
+	}
+
+	return date
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 type ScheduledExpenseID struct{ Value int }
@@ -26,7 +28,9 @@ type ScheduledExpense struct {
 	PayerID         user.ID
 	ReceiverID      user.ID
 	FrequencyInDays int
+	Recurrence      *Recurrence
 	LastGeneratedAt *civil.Date
+	OccurrenceCount int
 	IsActive        bool
 }
 
@@ -42,6 +46,19 @@ type ScheduledExpenseAttributes struct {
 	ReceiverID      user.ID
 	LastGeneratedAt *civil.Date
 	FrequencyInDays int
+	Recurrence      *Recurrence
+}
+
+type ScheduledExpenseUpdateAttributes struct {
+	Name            *string
+	Amount          *int
+	Description     *string
+	CategoryID      *category.ID
+	SplitType       *SplitType
+	PayerID         *user.ID
+	ReceiverID      *user.ID
+	FrequencyInDays *int
+	Recurrence      *Recurrence
 }
 
 func NewScheduledExpense(attr ScheduledExpenseAttributes) (*ScheduledExpense, error) {
@@ -61,6 +78,7 @@ func NewScheduledExpense(attr ScheduledExpenseAttributes) (*ScheduledExpense, er
 		PayerID:         attr.PayerID,
 		ReceiverID:      attr.ReceiverID,
 		FrequencyInDays: attr.FrequencyInDays,
+		Recurrence:      attr.Recurrence,
 		LastGeneratedAt: attr.LastGeneratedAt,
 		IsActive:        true,
 	}
@@ -81,19 +99,31 @@ func (s *ScheduledExpense) validate() error {
 		return fmt.Errorf("amount must be greater than zero")
 	}
 
+	if s.Recurrence == nil && s.FrequencyInDays <= 0 {
+		return ErrInvalidRecurrence
+	}
+
+	if s.Recurrence != nil {
+		return s.Recurrence.validate()
+	}
+
 	return nil
 }
 
-func (s *ScheduledExpense) ToExpense() (*Expense, error) {
-	createdAt := time.Now()
+// ToExpense builds the expense of the occurrence due at the given date. Its ID is assigned when
+// the occurrence is generated, so a redelivered occurrence is never created twice. It is stamped
+// at noon UTC so it falls on the same day in every brazilian time zone.
+func (s *ScheduledExpense) ToExpense(id ID, dueDate civil.Date) (*Expense, error) {
+	createdAt := time.Date(dueDate.Year, dueDate.Month, dueDate.Day, 12, 0, 0, 0, time.UTC)
 
 	return New(Attributes{
+		ID:          id,
 		Name:        s.Name,
 		Amount:      s.Amount,
 		Description: s.Description,
 		GroupID:     s.GroupID,
 		CategoryID:  s.CategoryID,
-		SplitRatio:  NewEqualSplitRatio(), // This is a temporary value, it will be updated when the expense is created
+		SplitRatio:  NewEqualSplitRatio(s.PayerID, s.ReceiverID), // This is a temporary value, it will be updated when the expense is created
 		SplitType:   s.SplitType,
 		PayerID:     s.PayerID,
 		ReceiverID:  s.ReceiverID,
@@ -101,34 +131,123 @@ func (s *ScheduledExpense) ToExpense() (*Expense, error) {
 	})
 }
 
-func (se *ScheduledExpense) ShouldGenerateExpense() bool {
+func (se *ScheduledExpense) ShouldGenerateExpense(cal calendar.Calendar) bool {
 	if !se.IsActive {
 		return false
 	}
 
-	if se.LastGeneratedAt == nil {
-		return true
+	nextGeneration, ok := se.calculateNextGenerationDate(cal)
+	if !ok {
+		return false
 	}
 
 	today := civil.DateOf(time.Now())
-	nextGeneration := se.calculateNextGenerationDate()
 	return today == nextGeneration || today.After(nextGeneration)
 }
 
-func (se *ScheduledExpense) calculateNextGenerationDate() civil.Date {
-	lastGen := se.LastGeneratedAt
-	if lastGen == nil {
-		return civil.DateOf(se.CreatedAt)
+func (se *ScheduledExpense) calculateNextGenerationDate(cal calendar.Calendar) (civil.Date, bool) {
+	return se.rule().next(se.LastGeneratedAt, se.OccurrenceCount, cal)
+}
+
+// rule returns the recurrence of the scheduled expense, falling back to its frequency in
+// days, starting at its creation date unless told otherwise.
+func (se *ScheduledExpense) rule() Recurrence {
+	rule := EveryNDays(se.FrequencyInDays)
+	if se.Recurrence != nil {
+		rule = *se.Recurrence
+	}
+
+	if rule.StartDate == nil {
+		start := civil.DateOf(se.CreatedAt)
+		rule.StartDate = &start
 	}
 
-	return lastGen.AddDays(se.FrequencyInDays)
+	return rule
 }
 
-func (se *ScheduledExpense) UpdateLastGeneratedAt() {
-	today := civil.DateOf(time.Now())
-	se.LastGeneratedAt = &today
+// DueOccurrences returns every occurrence due until the given date that was not generated yet,
+// oldest first.
+func (se *ScheduledExpense) DueOccurrences(cal calendar.Calendar, until civil.Date) []civil.Date {
+	if !se.IsActive {
+		return nil
+	}
+
+	return se.occurrences(cal, maxOccurrenceLookup, &until)
+}
+
+// UpdateLastGeneratedAt records the occurrence due at the given date as generated, so the next
+// one is computed from its due date rather than from the day it was actually generated. The
+// version is left to ScheduledExpenseRepository.StoreOccurrences, which checks it against the
+// one read.
+func (se *ScheduledExpense) UpdateLastGeneratedAt(dueDate civil.Date) {
+	se.LastGeneratedAt = &dueDate
+	se.OccurrenceCount++
+	se.UpdatedAt = time.Now()
+}
+
+func (se *ScheduledExpense) Update(p ScheduledExpenseUpdateAttributes) error {
+	if p.Name != nil {
+		se.Name = *p.Name
+	}
+	if p.Amount != nil {
+		se.Amount = *p.Amount
+	}
+	if p.Description != nil {
+		se.Description = *p.Description
+	}
+	if p.CategoryID != nil {
+		se.CategoryID = *p.CategoryID
+	}
+	if p.SplitType != nil {
+		se.SplitType = *p.SplitType
+	}
+	if p.PayerID != nil {
+		se.PayerID = *p.PayerID
+	}
+	if p.ReceiverID != nil {
+		se.ReceiverID = *p.ReceiverID
+	}
+	// A new rule replaces the previous one, so giving a frequency in days drops the recurrence.
+	if p.FrequencyInDays != nil {
+		se.FrequencyInDays = *p.FrequencyInDays
+		se.Recurrence = nil
+	}
+	if p.Recurrence != nil {
+		se.Recurrence = p.Recurrence
+	}
 	se.UpdatedAt = time.Now()
 	se.Version++
+
+	if err := se.validate(); err != nil {
+		return fmt.Errorf("scheduled expense validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// NextOccurrences returns up to n dates in which the scheduled expense will generate an expense,
+// starting from the next pending one.
+func (se *ScheduledExpense) NextOccurrences(cal calendar.Calendar, n int) []civil.Date {
+	return se.occurrences(cal, n, nil)
+}
+
+func (se *ScheduledExpense) occurrences(cal calendar.Calendar, limit int, until *civil.Date) []civil.Date {
+	rule := se.rule()
+	after := se.LastGeneratedAt
+	generated := se.OccurrenceCount
+
+	var occurrences []civil.Date
+	for len(occurrences) < limit {
+		date, ok := rule.next(after, generated, cal)
+		if !ok || (until != nil && date.After(*until)) {
+			break
+		}
+		occurrences = append(occurrences, date)
+		after = &date
+		generated++
+	}
+
+	return occurrences
 }
 
 func (se *ScheduledExpense) Deactivate() {
@@ -137,8 +256,44 @@ func (se *ScheduledExpense) Deactivate() {
 	se.Version++
 }
 
+// Activate resumes a paused scheduled expense from the next occurrence after the given date, so
+// the ones that fell due while it was paused are skipped rather than generated all at once. They
+// still count towards the maximum number of occurrences of its recurrence.
+func (se *ScheduledExpense) Activate(cal calendar.Calendar, today civil.Date) {
+	if !se.IsActive {
+		if skipped := se.occurrences(cal, maxOccurrenceLookup, &today); len(skipped) > 0 {
+			se.LastGeneratedAt = &skipped[len(skipped)-1]
+			se.OccurrenceCount += len(skipped)
+		}
+	}
+
+	se.IsActive = true
+	se.UpdatedAt = time.Now()
+	se.Version++
+}
+
+func (se *ScheduledExpense) Delete() {
+	now := time.Now()
+	se.IsActive = false
+	se.DeletedAt = &now
+	se.UpdatedAt = now
+	se.Version++
+}
+
+// ScheduledOccurrence is an expense due by a scheduled expense, along with the event announcing it.
+type ScheduledOccurrence struct {
+	DueDate civil.Date
+	Event   outbox.Event
+}
+
 type ScheduledExpenseRepository interface {
 	ddd.Repository[ScheduledExpenseID, ScheduledExpense]
 	GetActiveScheduledExpenses(ctx context.Context) ([]ScheduledExpense, error)
+	GetByGroupID(ctx context.Context, groupID group.ID) ([]ScheduledExpense, error)
+	// StoreOccurrences registers the given occurrences along with the last one generated by the
+	// scheduled expense, returning the due dates of the ones that were not registered before. Only
+	// their events reach the outbox. Nothing is stored once the scheduled expense is paused or
+	// deleted, and a ddd.VersionConflictError is returned when it changed otherwise since it was read.
+	StoreOccurrences(ctx context.Context, scheduledExpense *ScheduledExpense, occurrences []ScheduledOccurrence) ([]civil.Date, error)
 	BulkStore(ctx context.Context, scheduledExpenses []ScheduledExpense) error
 }
@@ -0,0 +1,298 @@
+package expense
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+)
+
+func date(year int, month time.Month, day int) *civil.Date {
+	return &civil.Date{Year: year, Month: month, Day: day}
+}
+
+func TestScheduledExpense_calculateNextGenerationDate(t *testing.T) {
+	cal := calendar.NewBrazilian()
+	monday := time.Monday
+
+	tests := []struct {
+		name       string
+		recurrence *Recurrence
+		frequency  int
+		last       *civil.Date
+		generated  int
+		expected   *civil.Date
+	}{
+		{
+			name:      "every n days after the last generation",
+			frequency: 30,
+			last:      date(2025, time.January, 10),
+			expected:  date(2025, time.February, 9),
+		},
+		{
+			name:       "monthly on day 5, first occurrence",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5, StartDate: date(2025, time.January, 1)},
+			expected:   date(2025, time.January, 5),
+		},
+		{
+			name:       "monthly on day 5 does not drift",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5, StartDate: date(2025, time.January, 1)},
+			last:       date(2025, time.January, 5),
+			expected:   date(2025, time.February, 5),
+		},
+		{
+			name:       "monthly skips the start month when the day already passed",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5, StartDate: date(2025, time.January, 10)},
+			expected:   date(2025, time.February, 5),
+		},
+		{
+			name:       "monthly on day 31 is clamped to the end of february",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 31, StartDate: date(2025, time.January, 1)},
+			last:       date(2025, time.January, 31),
+			expected:   date(2025, time.February, 28),
+		},
+		{
+			name:       "quarterly",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 3, DayOfMonth: 10, StartDate: date(2025, time.January, 1)},
+			last:       date(2025, time.January, 10),
+			expected:   date(2025, time.April, 10),
+		},
+		{
+			name:       "last business day of a month ending on a saturday",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: LastDayOfMonth, BusinessDay: BusinessDayRules.Previous, StartDate: date(2025, time.May, 1)},
+			expected:   date(2025, time.May, 30),
+		},
+		{
+			name:       "next business day after a weekend",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5, BusinessDay: BusinessDayRules.Next, StartDate: date(2025, time.April, 1)},
+			expected:   date(2025, time.April, 7),
+		},
+		{
+			name:       "previous business day skips holidays",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 21, BusinessDay: BusinessDayRules.Previous, StartDate: date(2025, time.April, 1)},
+			expected:   date(2025, time.April, 17),
+		},
+		{
+			name:       "weekly on monday",
+			recurrence: &Recurrence{Frequency: Frequencies.Weekly, Interval: 1, Weekday: &monday, StartDate: date(2025, time.March, 12)},
+			expected:   date(2025, time.March, 17),
+		},
+		{
+			name:       "every other week",
+			recurrence: &Recurrence{Frequency: Frequencies.Weekly, Interval: 2, Weekday: &monday, StartDate: date(2025, time.March, 12)},
+			last:       date(2025, time.March, 17),
+			expected:   date(2025, time.March, 31),
+		},
+		{
+			name:       "yearly on a leap day",
+			recurrence: &Recurrence{Frequency: Frequencies.Yearly, Interval: 1, StartDate: date(2024, time.February, 29)},
+			last:       date(2024, time.February, 29),
+			expected:   date(2025, time.February, 28),
+		},
+		{
+			name:       "ends at the end date",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5, StartDate: date(2025, time.January, 1), EndDate: date(2025, time.February, 1)},
+			last:       date(2025, time.January, 5),
+		},
+		{
+			name:       "ends after max count",
+			recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5, StartDate: date(2025, time.January, 1), MaxCount: 2},
+			last:       date(2025, time.February, 5),
+			generated:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			se := ScheduledExpense{
+				FrequencyInDays: tt.frequency,
+				Recurrence:      tt.recurrence,
+				LastGeneratedAt: tt.last,
+				OccurrenceCount: tt.generated,
+				IsActive:        true,
+			}
+			se.CreatedAt = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+			next, ok := se.calculateNextGenerationDate(cal)
+			if tt.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, *tt.expected, next)
+		})
+	}
+}
+
+func TestScheduledExpense_ShouldGenerateExpense(t *testing.T) {
+	cal := calendar.New()
+	today := civil.DateOf(time.Now())
+
+	se := ScheduledExpense{
+		Recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, StartDate: &today},
+		IsActive:   true,
+	}
+	assert.True(t, se.ShouldGenerateExpense(cal))
+
+	se.UpdateLastGeneratedAt(today)
+	assert.False(t, se.ShouldGenerateExpense(cal))
+	assert.Equal(t, 1, se.OccurrenceCount)
+
+	se.Recurrence.MaxCount = 1
+	se.LastGeneratedAt = date(2000, time.January, 1)
+	assert.False(t, se.ShouldGenerateExpense(cal))
+
+	se.Recurrence.MaxCount = 0
+	se.Deactivate()
+	assert.False(t, se.ShouldGenerateExpense(cal))
+}
+
+func TestNewScheduledExpense_Recurrence(t *testing.T) {
+	attr := ScheduledExpenseAttributes{Name: "rent", Amount: 100}
+
+	_, err := NewScheduledExpense(attr)
+	assert.ErrorIs(t, err, ErrInvalidRecurrence)
+
+	attr.Recurrence = &Recurrence{Frequency: "hourly", Interval: 1}
+	_, err = NewScheduledExpense(attr)
+	assert.ErrorIs(t, err, ErrInvalidRecurrence)
+
+	attr.Recurrence = &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, StartDate: date(2025, time.February, 1), EndDate: date(2025, time.January, 1)}
+	_, err = NewScheduledExpense(attr)
+	assert.ErrorIs(t, err, ErrInvalidRecurrence)
+
+	attr.Recurrence = &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5}
+	se, err := NewScheduledExpense(attr)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, se.Recurrence.DayOfMonth)
+}
+
+func TestScheduledExpense_NextOccurrences(t *testing.T) {
+	cal := calendar.NewBrazilian()
+
+	se, err := NewScheduledExpense(ScheduledExpenseAttributes{
+		Name:       "rent",
+		Amount:     100,
+		Recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5, StartDate: date(2025, time.January, 1), MaxCount: 4},
+	})
+	assert.NoError(t, err)
+	se.LastGeneratedAt = date(2025, time.January, 6)
+	se.OccurrenceCount = 1
+
+	// April 5th 2025 is a saturday, business day adjustment is not set so it is kept.
+	assert.Equal(t, []civil.Date{
+		*date(2025, time.February, 5),
+		*date(2025, time.March, 5),
+		*date(2025, time.April, 5),
+	}, se.NextOccurrences(cal, 5))
+
+	assert.Len(t, se.NextOccurrences(cal, 2), 2)
+}
+
+func TestScheduledExpense_Update(t *testing.T) {
+	se, err := NewScheduledExpense(ScheduledExpenseAttributes{
+		Name:       "rent",
+		Amount:     100,
+		Recurrence: &Recurrence{Frequency: Frequencies.Monthly, Interval: 1, DayOfMonth: 5},
+	})
+	assert.NoError(t, err)
+
+	name, amount, frequency := "new rent", 200, 15
+	assert.NoError(t, se.Update(ScheduledExpenseUpdateAttributes{Name: &name, Amount: &amount, FrequencyInDays: &frequency}))
+	assert.Equal(t, "new rent", se.Name)
+	assert.Equal(t, 200, se.Amount)
+	assert.Equal(t, 15, se.FrequencyInDays)
+	assert.Nil(t, se.Recurrence)
+	assert.Equal(t, 1, se.Version)
+
+	invalid := 0
+	assert.Error(t, se.Update(ScheduledExpenseUpdateAttributes{Amount: &invalid}))
+}
+
+func TestScheduledExpense_Status(t *testing.T) {
+	se, err := NewScheduledExpense(ScheduledExpenseAttributes{Name: "rent", Amount: 100, FrequencyInDays: 30})
+	assert.NoError(t, err)
+
+	se.Deactivate()
+	assert.False(t, se.IsActive)
+
+	se.Activate(calendar.NewBrazilian(), civil.DateOf(time.Now()))
+	assert.True(t, se.IsActive)
+
+	se.Delete()
+	assert.False(t, se.IsActive)
+	assert.NotNil(t, se.DeletedAt)
+	assert.Equal(t, 3, se.Version)
+}
+
+func TestScheduledExpense_DueOccurrences(t *testing.T) {
+	cal := calendar.NewBrazilian()
+
+	se, err := NewScheduledExpense(ScheduledExpenseAttributes{
+		Name:            "gym",
+		Amount:          100,
+		SplitType:       SplitTypes.Equal,
+		PayerID:         user.ID{Value: 1},
+		ReceiverID:      user.ID{Value: 2},
+		FrequencyInDays: 30,
+		LastGeneratedAt: date(2025, time.January, 1),
+	})
+	assert.NoError(t, err)
+	se.CreatedAt = time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+	// The trigger did not run for two months, both missed occurrences are due on their own dates.
+	due := se.DueOccurrences(cal, *date(2025, time.March, 5))
+	assert.Equal(t, []civil.Date{*date(2025, time.January, 31), *date(2025, time.March, 2)}, due)
+
+	for _, d := range due {
+		se.UpdateLastGeneratedAt(d)
+	}
+	assert.Equal(t, date(2025, time.March, 2), se.LastGeneratedAt)
+	assert.Equal(t, 2, se.OccurrenceCount)
+	assert.Empty(t, se.DueOccurrences(cal, *date(2025, time.March, 5)))
+
+	exp, err := se.ToExpense(ID{Value: 7}, due[0])
+	assert.NoError(t, err)
+	assert.Equal(t, ID{Value: 7}, exp.ID)
+	assert.Equal(t, *date(2025, time.January, 31), civil.DateOf(exp.CreatedAt))
+
+	se.Deactivate()
+	assert.Empty(t, se.DueOccurrences(cal, *date(2025, time.December, 31)))
+}
+
+func TestScheduledExpense_Activate(t *testing.T) {
+	cal := calendar.NewBrazilian()
+
+	se, err := NewScheduledExpense(ScheduledExpenseAttributes{
+		Name:            "gym",
+		Amount:          100,
+		SplitType:       SplitTypes.Equal,
+		PayerID:         user.ID{Value: 1},
+		ReceiverID:      user.ID{Value: 2},
+		FrequencyInDays: 30,
+		LastGeneratedAt: date(2025, time.January, 1),
+	})
+	assert.NoError(t, err)
+	se.CreatedAt = time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+	// Paused on January 10th and resumed three months later, on April 10th.
+	se.Deactivate()
+	assert.Empty(t, se.DueOccurrences(cal, *date(2025, time.April, 10)))
+
+	se.Activate(cal, *date(2025, time.April, 10))
+	assert.True(t, se.IsActive)
+	assert.Equal(t, date(2025, time.April, 1), se.LastGeneratedAt)
+	assert.Equal(t, 3, se.OccurrenceCount)
+	assert.Empty(t, se.DueOccurrences(cal, *date(2025, time.April, 10)))
+	assert.Equal(t, []civil.Date{*date(2025, time.May, 1)}, se.DueOccurrences(cal, *date(2025, time.May, 5)))
+
+	// Resuming an active scheduled expense keeps the occurrences that are still due.
+	se.Activate(cal, *date(2025, time.May, 5))
+	assert.Equal(t, date(2025, time.April, 1), se.LastGeneratedAt)
+	assert.Equal(t, []civil.Date{*date(2025, time.May, 1)}, se.DueOccurrences(cal, *date(2025, time.May, 5)))
+}
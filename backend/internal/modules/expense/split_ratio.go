@@ -1,6 +1,10 @@
 package expense
 
-import "math"
+import (
+	"slices"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+)
 
 type SplitType string
 
@@ -8,55 +12,289 @@ var SplitTypes = struct {
 	Equal        SplitType
 	Proportional SplitType
 	Transfer     SplitType
+	Exact        SplitType
+	Weighted     SplitType
 }{
 	Equal:        "equal",
 	Proportional: "proportional",
 	Transfer:     "transfer",
+	Exact:        "exact",
+	Weighted:     "weighted",
 }
 
 func (s SplitType) String() string {
 	return string(s)
 }
 
+// IsCustom reports whether the shares of the split are informed by the user, either as
+// exact amounts or as weights.
+func (s SplitType) IsCustom() bool {
+	return s == SplitTypes.Exact || s == SplitTypes.Weighted
+}
+
+// Share is the part of an expense that a participant is accountable for. Custom splits
+// also carry the amount in cents owed by the participant, and weighted ones the weight
+// it was derived from.
+type Share struct {
+	UserID  user.ID `json:"user_id"`
+	Percent int     `json:"percent"`
+	Amount  int     `json:"amount,omitempty"`
+	Weight  int     `json:"weight,omitempty"`
+}
+
+// SplitRatio describes how an expense is divided among its participants.
 type SplitRatio struct {
-	Payer    int `json:"payer"`
-	Receiver int `json:"receiver"`
+	Shares []Share `json:"shares"`
 }
 
-func (s SplitRatio) Type() SplitType {
-	if s.Payer == 0 {
-		return SplitTypes.Transfer
+// Participants returns the users taking part in the split, in the order they were added.
+func (s SplitRatio) Participants() []user.ID {
+	participants := make([]user.ID, 0, len(s.Shares))
+	for _, share := range s.Shares {
+		participants = append(participants, share.UserID)
 	}
 
-	if s.Payer == 50 {
-		return SplitTypes.Equal
+	return participants
+}
+
+// ShareOf returns the percentage owed by the given user, zero if the user is not a participant.
+func (s SplitRatio) ShareOf(userID user.ID) int {
+	for _, share := range s.Shares {
+		if share.UserID == userID {
+			return share.Percent
+		}
 	}
 
-	return SplitTypes.Proportional
+	return 0
 }
 
-func NewEqualSplitRatio() SplitRatio {
-	return SplitRatio{
-		Payer:    50,
-		Receiver: 50,
+func (s SplitRatio) amountOf(userID user.ID) int {
+	for _, share := range s.Shares {
+		if share.UserID == userID {
+			return share.Amount
+		}
+	}
+
+	return 0
+}
+
+// Has reports whether the given user is a participant of the split.
+func (s SplitRatio) Has(userID user.ID) bool {
+	for _, share := range s.Shares {
+		if share.UserID == userID {
+			return true
+		}
 	}
+
+	return false
 }
 
-func NewProportionalSplitRatio(payerIncome, receiverIncome int) SplitRatio {
-	totalIncome := payerIncome + receiverIncome
-	payerRatio := int(math.Round(float64(payerIncome) * 100.0 / float64(totalIncome)))
+func (s SplitRatio) validate() error {
+	if len(s.Shares) == 0 {
+		return ErrInvalidSplitRatio
+	}
 
-	receiverRatio := 100 - payerRatio
+	total := 0
+	seen := make(map[user.ID]bool, len(s.Shares))
+	for _, share := range s.Shares {
+		if share.Percent < 0 || seen[share.UserID] {
+			return ErrInvalidSplitRatio
+		}
 
-	return SplitRatio{
-		Payer:    payerRatio,
-		Receiver: receiverRatio,
+		seen[share.UserID] = true
+		total += share.Percent
 	}
+
+	if total != 100 {
+		return ErrInvalidSplitRatio
+	}
+
+	return nil
 }
 
-func NewTransferRatio() SplitRatio {
+func (s SplitRatio) validateExact(amount int) error {
+	total := 0
+	for _, share := range s.Shares {
+		if share.Amount < 0 {
+			return ErrInvalidSplitRatio
+		}
+		total += share.Amount
+	}
+
+	if total != amount {
+		return ErrInvalidSplitRatio
+	}
+
+	return nil
+}
+
+func (s SplitRatio) validateWeighted(amount int) error {
+	weights := make([]int, len(s.Shares))
+	for i, share := range s.Shares {
+		if share.Weight < 0 {
+			return ErrInvalidSplitRatio
+		}
+		weights[i] = share.Weight
+	}
+
+	if slices.Max(weights) == 0 {
+		return ErrInvalidSplitRatio
+	}
+
+	for i, part := range distribute(amount, weights) {
+		if s.Shares[i].Amount != part {
+			return ErrInvalidSplitRatio
+		}
+	}
+
+	return nil
+}
+
+func (s SplitRatio) isEqual() bool {
+	lowest, highest := s.Shares[0].Percent, s.Shares[0].Percent
+	for _, share := range s.Shares {
+		lowest = min(lowest, share.Percent)
+		highest = max(highest, share.Percent)
+	}
+
+	return highest-lowest <= 1
+}
+
+func NewEqualSplitRatio(participants ...user.ID) SplitRatio {
+	weights := make([]int, len(participants))
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return newSplitRatio(participants, distribute(100, weights))
+}
+
+// NewProportionalSplitRatio splits the expense according to each participant's income.
+// When nobody has income the expense is split equally.
+func NewProportionalSplitRatio(participants []user.ID, incomes map[user.ID]int) SplitRatio {
+	weights := make([]int, len(participants))
+	for i, participant := range participants {
+		weights[i] = incomes[participant]
+	}
+
+	return newSplitRatio(participants, distribute(100, weights))
+}
+
+// NewExactSplitRatio splits the expense by the amount in cents informed for each share.
+func NewExactSplitRatio(shares []Share) SplitRatio {
+	amounts := make([]int, len(shares))
+	for i, share := range shares {
+		amounts[i] = share.Amount
+	}
+
+	split := make([]Share, len(shares))
+	for i, percent := range distribute(100, amounts) {
+		split[i] = Share{UserID: shares[i].UserID, Percent: percent, Amount: shares[i].Amount}
+	}
+
+	return SplitRatio{Shares: split}
+}
+
+// NewWeightedSplitRatio splits amount according to the weight informed for each share,
+// so that 2:1 gives twice as much to the first participant. Cents that can not be evenly
+// divided go to the participants with the largest remainders.
+func NewWeightedSplitRatio(amount int, shares []Share) SplitRatio {
+	weights := make([]int, len(shares))
+	for i, share := range shares {
+		weights[i] = share.Weight
+	}
+
+	percents := distribute(100, weights)
+	amounts := distribute(amount, weights)
+	split := make([]Share, len(shares))
+	for i, share := range shares {
+		split[i] = Share{UserID: share.UserID, Percent: percents[i], Amount: amounts[i], Weight: share.Weight}
+	}
+
+	return SplitRatio{Shares: split}
+}
+
+// ScaleTo returns the same split applied to a different amount. Only custom splits hold
+// amounts in cents, the other ones are returned as they are.
+func (s SplitRatio) ScaleTo(splitType SplitType, amount int) SplitRatio {
+	switch splitType {
+	case SplitTypes.Weighted:
+		return NewWeightedSplitRatio(amount, s.Shares)
+	case SplitTypes.Exact:
+		amounts := make([]int, len(s.Shares))
+		for i, share := range s.Shares {
+			amounts[i] = share.Amount
+		}
+
+		shares := make([]Share, len(s.Shares))
+		for i, part := range distribute(amount, amounts) {
+			shares[i] = Share{UserID: s.Shares[i].UserID, Amount: part}
+		}
+
+		return NewExactSplitRatio(shares)
+	default:
+		return s
+	}
+}
+
+func NewTransferRatio(payerID, receiverID user.ID) SplitRatio {
 	return SplitRatio{
-		Payer:    0,
-		Receiver: 100,
+		Shares: []Share{
+			{UserID: payerID, Percent: 0},
+			{UserID: receiverID, Percent: 100},
+		},
+	}
+}
+
+func newSplitRatio(participants []user.ID, percents []int) SplitRatio {
+	shares := make([]Share, len(participants))
+	for i, participant := range participants {
+		shares[i] = Share{UserID: participant, Percent: percents[i]}
+	}
+
+	return SplitRatio{Shares: shares}
+}
+
+// distribute splits total into integer parts proportional to weights using the
+// largest remainder method, so the parts always add up to total. Ties go to the
+// earliest weight. If every weight is zero the total is split equally.
+func distribute(total int, weights []int) []int {
+	parts := make([]int, len(weights))
+	if len(weights) == 0 {
+		return parts
 	}
+
+	sum := 0
+	for _, w := range weights {
+		sum += w
+	}
+
+	if sum == 0 {
+		weights = make([]int, len(parts))
+		for i := range weights {
+			weights[i] = 1
+		}
+		sum = len(weights)
+	}
+
+	remainders := make([]int, len(weights))
+	assigned := 0
+	for i, w := range weights {
+		parts[i] = total * w / sum
+		remainders[i] = total * w % sum
+		assigned += parts[i]
+	}
+
+	for left := total - assigned; left > 0; left-- {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+		parts[largest]++
+		remainders[largest] = -1
+	}
+
+	return parts
 }
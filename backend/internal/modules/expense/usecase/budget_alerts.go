@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
+)
+
+// budgetAlertEvents checks the budgets covering the category of the new expenses, each in its own
+// month, and returns an event for every alert threshold they reach. Alerts are a warning on top of
+// creating the expenses, so failing to check them is only logged.
+func budgetAlertEvents(
+	ctx context.Context,
+	budgetRepo expense.BudgetRepository,
+	cat category.Category,
+	userID user.ID,
+	newExpenses []expense.Expense,
+) []outbox.Event {
+	if len(newExpenses) == 0 {
+		return nil
+	}
+
+	budgets, err := budgetRepo.GetByGroupID(ctx, newExpenses[0].GroupID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to look for budgets", "group_id", newExpenses[0].GroupID.Value, "error", err)
+		return nil
+	}
+
+	var events []outbox.Event
+	for _, budget := range budgets {
+		if !budget.Covers(cat) {
+			continue
+		}
+
+		for _, newExpense := range newExpenses {
+			month := expense.MonthOf(newExpense.CreatedAt)
+			if month.Before(budget.StartMonth()) {
+				continue
+			}
+
+			spending, err := budgetRepo.GetMonthlySpending(ctx, budget, month)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to check budget", "budget_id", budget.ID.Value, "error", err)
+				continue
+			}
+
+			if len(spending) == 0 {
+				continue
+			}
+
+			before := spending[len(spending)-1]
+			limit := budget.Limit(spending[:len(spending)-1])
+			for _, alert := range budget.Alerts(month, limit, before, before+newExpense.Amount) {
+				events = append(events, outbox.NewEvent(pubsub.BudgetsTopic, pubsub.BudgetAlertEvent{
+					Event: pubsub.Event{
+						SentAt:  time.Now(),
+						Type:    "budget_alert",
+						UserID:  userID,
+						GroupID: budget.GroupID,
+					},
+					Alert: alert,
+				}))
+			}
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	// ConfirmImportCandidateParams completes what a statement does not tell about the expense.
+	// The category falls back to the one of the candidate, the payer to who imported it and the
+	// split type to proportional.
+	ConfirmImportCandidateParams struct {
+		ID           expense.ImportCandidateID
+		GroupID      group.ID
+		Description  string
+		CategoryID   *category.ID
+		SplitType    expense.SplitType
+		PayerID      *user.ID
+		ReceiverID   user.ID
+		Participants []user.ID
+		Shares       []expense.Share
+		UserID       *user.ID
+	}
+
+	ConfirmImportCandidate func(ctx context.Context, p ConfirmImportCandidateParams) (*CreateExpenseResult, error)
+)
+
+func NewConfirmImportCandidate(
+	candidateRepo expense.ImportCandidateRepository,
+	expenseRepo expense.Repository,
+	createExpense CreateExpense,
+	unitOfWork db.UnitOfWork,
+) ConfirmImportCandidate {
+	return func(ctx context.Context, p ConfirmImportCandidateParams) (*CreateExpenseResult, error) {
+		candidate, err := getGroupImportCandidate(ctx, candidateRepo, p.ID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if candidate.Status != expense.ImportCandidateStatuses.Pending {
+			return nil, except.UnprocessableEntityError(expense.ErrImportCandidateReviewed.Error())
+		}
+
+		categoryID := candidate.CategoryID
+		if p.CategoryID != nil {
+			categoryID = p.CategoryID
+		}
+
+		if categoryID == nil {
+			return nil, except.UnprocessableEntityError("missing category")
+		}
+
+		if p.SplitType == "" {
+			p.SplitType = expense.SplitTypes.Proportional
+		}
+
+		payerID := candidate.ImportedBy
+		if p.PayerID != nil {
+			payerID = *p.PayerID
+		}
+
+		createdAt := candidate.ExpenseCreatedAt()
+
+		var created *CreateExpenseResult
+		if err := unitOfWork(ctx, func(ctx context.Context) error {
+			created, err = createExpense(ctx, CreateExpenseParams{
+				GroupID:      p.GroupID,
+				Name:         candidate.Name,
+				Amount:       candidate.Amount,
+				Description:  p.Description,
+				CategoryID:   *categoryID,
+				SplitType:    p.SplitType,
+				PayerID:      payerID,
+				ReceiverID:   p.ReceiverID,
+				Participants: p.Participants,
+				Shares:       p.Shares,
+				CreatedAt:    &createdAt,
+				UserID:       p.UserID,
+			})
+			if err != nil {
+				return fmt.Errorf("CreateExpense: %w", err)
+			}
+
+			if err := candidate.Confirm(created.Expense.ID); err != nil {
+				return fmt.Errorf("candidate.Confirm: %w", err)
+			}
+
+			if err := candidateRepo.Store(ctx, candidate); err != nil {
+				return fmt.Errorf("candidateRepo.Store: %w", err)
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		created.PossibleDuplicates = possibleDuplicates(ctx, expenseRepo, *created.Expense)
+
+		return created, nil
+	}
+}
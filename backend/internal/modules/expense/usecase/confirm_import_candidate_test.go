@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func newImportCandidate(t *testing.T, categoryID *category.ID) *expense.ImportCandidate {
+	candidate, err := expense.NewImportCandidate(expense.ImportCandidateAttributes{
+		ID:         expense.ImportCandidateID{Value: 1},
+		GroupID:    group.ID{Value: 1},
+		Source:     "ofx",
+		ExternalID: "202501070001",
+		Name:       "Padaria",
+		Amount:     5290,
+		Date:       civil.Date{Year: 2025, Month: 1, Day: 7},
+		CategoryID: categoryID,
+		ImportedBy: user.ID{Value: 2},
+	})
+	assert.NoError(t, err)
+
+	return candidate
+}
+
+func TestConfirmImportCandidate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	candidateRepo := mocks.NewMockexpenseImportCandidateRepository(t)
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	createExpense := mocks.NewMockusecaseCreateExpense(t)
+	confirmImportCandidate := usecase.NewConfirmImportCandidate(candidateRepo, expenseRepo, createExpense.Execute, dbtest.UnitOfWork)
+	params := usecase.ConfirmImportCandidateParams{
+		ID:         expense.ImportCandidateID{Value: 1},
+		GroupID:    group.ID{Value: 1},
+		ReceiverID: user.ID{Value: 3},
+	}
+
+	created, err := expense.New(expense.Attributes{
+		ID:         expense.ID{Value: 10},
+		Name:       "Padaria",
+		Amount:     5290,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 4},
+		SplitRatio: expense.NewEqualSplitRatio(user.ID{Value: 2}, user.ID{Value: 3}),
+		PayerID:    user.ID{Value: 2},
+		ReceiverID: user.ID{Value: 3},
+	})
+	assert.NoError(t, err)
+
+	t.Run("should return error if candidate belongs to another group", func(t *testing.T) {
+		candidate := newImportCandidate(t, &category.ID{Value: 4})
+		candidate.GroupID = group.ID{Value: 2}
+		candidateRepo.EXPECT().GetByID(ctx, params.ID).Return(candidate, nil).Once()
+
+		expns, err := confirmImportCandidate(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "import candidate not found")
+	})
+
+	t.Run("should return error if candidate was already reviewed", func(t *testing.T) {
+		candidate := newImportCandidate(t, &category.ID{Value: 4})
+		assert.NoError(t, candidate.Discard())
+		candidateRepo.EXPECT().GetByID(ctx, params.ID).Return(candidate, nil).Once()
+
+		expns, err := confirmImportCandidate(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "import candidate was already reviewed")
+	})
+
+	t.Run("should return error if no category was suggested nor given", func(t *testing.T) {
+		candidateRepo.EXPECT().GetByID(ctx, params.ID).Return(newImportCandidate(t, nil), nil).Once()
+
+		expns, err := confirmImportCandidate(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "missing category")
+	})
+
+	t.Run("should return error if CreateExpense fails", func(t *testing.T) {
+		candidateRepo.EXPECT().GetByID(ctx, params.ID).Return(newImportCandidate(t, &category.ID{Value: 4}), nil).Once()
+		createExpense.EXPECT().Execute(ctx, mock.Anything).Return(nil, errors.New("test error")).Once()
+
+		expns, err := confirmImportCandidate(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "CreateExpense: test error")
+	})
+
+	t.Run("should create the expense and take the candidate out of the queue", func(t *testing.T) {
+		candidateRepo.EXPECT().GetByID(ctx, params.ID).Return(newImportCandidate(t, &category.ID{Value: 4}), nil).Once()
+		createExpense.EXPECT().Execute(ctx, mock.MatchedBy(func(p usecase.CreateExpenseParams) bool {
+			return p.Name == "Padaria" &&
+				p.Amount == 5290 &&
+				p.CategoryID == category.ID{Value: 4} &&
+				p.SplitType == expense.SplitTypes.Proportional &&
+				p.PayerID == user.ID{Value: 2} &&
+				p.ReceiverID == user.ID{Value: 3} &&
+				p.CreatedAt.Equal(time.Date(2025, 1, 7, 12, 0, 0, 0, time.UTC))
+		})).Return(&usecase.CreateExpenseResult{Expense: created}, nil).Once()
+		candidateRepo.EXPECT().Store(ctx, mock.MatchedBy(func(c *expense.ImportCandidate) bool {
+			return c.Status == expense.ImportCandidateStatuses.Confirmed && *c.ExpenseID == created.ID
+		})).Return(nil).Once()
+		// Looked for once the transaction commits, since a failed query would abort it
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, *created).Return([]expense.Expense{*created}, nil).Once()
+
+		result, err := confirmImportCandidate(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, created, result.Expense)
+		assert.Equal(t, []expense.Expense{*created}, result.PossibleDuplicates)
+	})
+}
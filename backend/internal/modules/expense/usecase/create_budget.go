@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	CreateBudgetParams struct {
+		GroupID         group.ID
+		CategoryID      *category.ID
+		CategoryGroupID *category.GroupID
+		Amount          int
+		CarryOver       bool
+	}
+
+	CreateBudget func(ctx context.Context, p CreateBudgetParams) (*expense.Budget, error)
+)
+
+func NewCreateBudget(
+	budgetRepo expense.BudgetRepository,
+	categoryRepo category.Repository,
+	categoryGroupRepo category.GroupRepository,
+) CreateBudget {
+	return func(ctx context.Context, p CreateBudgetParams) (*expense.Budget, error) {
+		newBudget, err := expense.NewBudget(expense.BudgetAttributes{
+			ID:              budgetRepo.GetNextID(),
+			GroupID:         p.GroupID,
+			CategoryID:      p.CategoryID,
+			CategoryGroupID: p.CategoryGroupID,
+			Amount:          p.Amount,
+			CarryOver:       p.CarryOver,
+		})
+		if err != nil {
+			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("expense.NewBudget: %w", err))
+		}
+
+		if p.CategoryID != nil {
+			cat, err := categoryRepo.GetByID(ctx, *p.CategoryID)
+			if err != nil {
+				return nil, fmt.Errorf("categoryRepo.GetByID: %w", err)
+			}
+
+			if cat == nil {
+				return nil, except.NotFoundError("category not found")
+			}
+		} else {
+			categoryGroup, err := categoryGroupRepo.GetByID(ctx, *p.CategoryGroupID)
+			if err != nil {
+				return nil, fmt.Errorf("categoryGroupRepo.GetByID: %w", err)
+			}
+
+			if categoryGroup == nil {
+				return nil, except.NotFoundError("category group not found")
+			}
+		}
+
+		budgets, err := budgetRepo.GetByGroupID(ctx, p.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("budgetRepo.GetByGroupID: %w", err)
+		}
+
+		for _, budget := range budgets {
+			if sameBudgetScope(budget, *newBudget) {
+				return nil, except.ConflictError("there is already a budget for it")
+			}
+		}
+
+		if err := budgetRepo.Store(ctx, newBudget); err != nil {
+			return nil, fmt.Errorf("budgetRepo.Store: %w", err)
+		}
+
+		return newBudget, nil
+	}
+}
+
+func sameBudgetScope(a, b expense.Budget) bool {
+	if a.CategoryID != nil && b.CategoryID != nil {
+		return *a.CategoryID == *b.CategoryID
+	}
+
+	if a.CategoryGroupID != nil && b.CategoryGroupID != nil {
+		return *a.CategoryGroupID == *b.CategoryGroupID
+	}
+
+	return false
+}
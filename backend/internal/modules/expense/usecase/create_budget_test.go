@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestCreateBudget(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	budgetRepo := mocks.NewMockexpenseBudgetRepository(t)
+	categoryRepo := mocks.NewMockcategoryRepository(t)
+	categoryGroupRepo := mocks.NewMockcategoryGroupRepository(t)
+	createBudget := usecase.NewCreateBudget(budgetRepo, categoryRepo, categoryGroupRepo)
+
+	grp := group.ID{Value: 1}
+	categoryGroupID := category.GroupID{Value: 2}
+	categoryGroup := category.NewGroup(category.GroupAttributes{ID: categoryGroupID, Name: "Alimentação"})
+	params := usecase.CreateBudgetParams{GroupID: grp, CategoryGroupID: &categoryGroupID, Amount: 80000, CarryOver: true}
+
+	t.Run("should refuse a budget without category nor category group", func(t *testing.T) {
+		budgetRepo.EXPECT().GetNextID().Return(expense.BudgetID{Value: 1}).Once()
+
+		result, err := createBudget(ctx, usecase.CreateBudgetParams{GroupID: grp, Amount: 80000})
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, expense.ErrInvalidBudgetScope)
+	})
+
+	t.Run("should return error if category group is not found", func(t *testing.T) {
+		budgetRepo.EXPECT().GetNextID().Return(expense.BudgetID{Value: 1}).Once()
+		categoryGroupRepo.EXPECT().GetByID(ctx, categoryGroupID).Return(nil, nil).Once()
+
+		result, err := createBudget(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "category group not found")
+	})
+
+	t.Run("should refuse a second budget for the same category group", func(t *testing.T) {
+		budgetRepo.EXPECT().GetNextID().Return(expense.BudgetID{Value: 2}).Once()
+		categoryGroupRepo.EXPECT().GetByID(ctx, categoryGroupID).Return(categoryGroup, nil).Once()
+		budgetRepo.EXPECT().GetByGroupID(ctx, grp).Return([]expense.Budget{
+			{GroupID: grp, CategoryGroupID: &category.GroupID{Value: 2}, Amount: 50000},
+		}, nil).Once()
+
+		result, err := createBudget(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "there is already a budget for it")
+	})
+
+	t.Run("should return error if store fails", func(t *testing.T) {
+		budgetRepo.EXPECT().GetNextID().Return(expense.BudgetID{Value: 3}).Once()
+		categoryGroupRepo.EXPECT().GetByID(ctx, categoryGroupID).Return(categoryGroup, nil).Once()
+		budgetRepo.EXPECT().GetByGroupID(ctx, grp).Return(nil, nil).Once()
+		budgetRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+
+		result, err := createBudget(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "budgetRepo.Store: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		categoryID := category.ID{Value: 5}
+		budgetRepo.EXPECT().GetNextID().Return(expense.BudgetID{Value: 4}).Once()
+		categoryGroupRepo.EXPECT().GetByID(ctx, categoryGroupID).Return(categoryGroup, nil).Once()
+		// Budgets for a single category do not collide with the one for its group
+		budgetRepo.EXPECT().GetByGroupID(ctx, grp).Return([]expense.Budget{
+			{GroupID: grp, CategoryID: &categoryID, Amount: 20000},
+		}, nil).Once()
+		budgetRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		result, err := createBudget(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, expense.BudgetID{Value: 4}, result.ID)
+		assert.Equal(t, &categoryGroupID, result.CategoryGroupID)
+		assert.Equal(t, 80000, result.Amount)
+		assert.True(t, result.CarryOver)
+	})
+}
@@ -3,29 +3,51 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 )
 
 type (
 	CreateExpenseParams struct {
-		GroupID     group.ID
-		Name        string
-		Amount      int
-		Description string
-		CategoryID  category.ID
-		SplitType   expense.SplitType
-		PayerID     user.ID
-		ReceiverID  user.ID
-		CreatedAt   *time.Time
+		// ID is given when the expense was assigned one beforehand, as occurrences of scheduled
+		// expenses are. Creating it again then fails with a ddd.VersionConflictError.
+		ID           *expense.ID
+		GroupID      group.ID
+		Name         string
+		Amount       int
+		Description  string
+		CategoryID   category.ID
+		SplitType    expense.SplitType
+		PayerID      user.ID
+		ReceiverID   user.ID
+		Participants []user.ID
+		Shares       []expense.Share
+		Installments int
+		TagIDs       []tag.ID
+		CreatedAt    *time.Time
+		// UserID is the user creating the expense, nil when it is created by the system.
+		UserID *user.ID
 	}
-	CreateExpense func(ctx context.Context, p CreateExpenseParams) (*expense.Expense, error)
+
+	CreateExpenseResult struct {
+		// Expense is the expense created, the first installment of a purchase paid in installments.
+		Expense *expense.Expense
+		// PossibleDuplicates are the expenses of the group that look like the new one. They only
+		// warn the user, who may have entered it twice, and never keep the expense from being
+		// created.
+		PossibleDuplicates []expense.Expense
+	}
+
+	CreateExpense func(ctx context.Context, p CreateExpenseParams) (*CreateExpenseResult, error)
 )
 
 func NewCreateExpense(
@@ -34,8 +56,22 @@ func NewCreateExpense(
 	groupRepo group.Repository,
 	categoryRepo category.Repository,
 	incomeRepo income.Repository,
+	tagRepo tag.Repository,
+	budgetRepo expense.BudgetRepository,
 ) CreateExpense {
-	return func(ctx context.Context, p CreateExpenseParams) (*expense.Expense, error) {
+	return func(ctx context.Context, p CreateExpenseParams) (*CreateExpenseResult, error) {
+		if p.SplitType.IsCustom() {
+			if len(p.Shares) == 0 {
+				return nil, except.UnprocessableEntityError("missing split shares")
+			}
+			p.Participants = expense.SplitRatio{Shares: p.Shares}.Participants()
+		}
+
+		participants := participantsOf(p.PayerID, p.ReceiverID, p.Participants)
+		if p.ReceiverID == (user.ID{}) {
+			p.ReceiverID = counterpartOf(p.PayerID, participants)
+		}
+
 		payer, err := userRepo.GetByID(ctx, p.PayerID)
 		if err != nil {
 			return nil, fmt.Errorf("userRepo.GetByID: %w", err)
@@ -67,6 +103,10 @@ func NewCreateExpense(
 			return nil, except.UnprocessableEntityError("group mismatch")
 		}
 
+		if err := checkParticipants(ctx, userRepo, grp.ID, participants, payer.ID, receiver.ID); err != nil {
+			return nil, err
+		}
+
 		cat, err := categoryRepo.GetByID(ctx, p.CategoryID)
 		if err != nil {
 			return nil, fmt.Errorf("categoryRepo.GetByID: %w", err)
@@ -76,42 +116,34 @@ func NewCreateExpense(
 			return nil, except.NotFoundError("category not found")
 		}
 
+		if err := checkTags(ctx, tagRepo, grp.ID, p.TagIDs); err != nil {
+			return nil, err
+		}
+
 		var splitRatio expense.SplitRatio
 		switch p.SplitType {
 		case expense.SplitTypes.Proportional:
-			payerIncomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, payer.ID, p.CreatedAt)
-			if err != nil {
-				return nil, fmt.Errorf("incomeRepo.GetUserMonthlyIncomes: %w", err)
-			}
-
-			receiverIncomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, receiver.ID, p.CreatedAt)
+			incomes, err := getParticipantsIncomes(ctx, incomeRepo, participants, p.CreatedAt)
 			if err != nil {
-				return nil, fmt.Errorf("incomeRepo.GetUserMonthlyIncomes: %w", err)
+				return nil, err
 			}
 
-			if receiverIncomes == nil || payerIncomes == nil {
-				splitRatio = expense.NewEqualSplitRatio()
+			if incomes == nil {
+				splitRatio = expense.NewEqualSplitRatio(participants...)
 			} else {
-				totalPayerIncome := 0
-				for _, incm := range payerIncomes {
-					totalPayerIncome += incm.Amount
-				}
-
-				totalReceiverIncome := 0
-				for _, incm := range receiverIncomes {
-					totalReceiverIncome += incm.Amount
-				}
-
-				splitRatio = expense.NewProportionalSplitRatio(totalPayerIncome, totalReceiverIncome)
+				splitRatio = expense.NewProportionalSplitRatio(participants, incomes)
 			}
 		case expense.SplitTypes.Transfer:
-			splitRatio = expense.NewTransferRatio()
+			splitRatio = expense.NewTransferRatio(p.PayerID, p.ReceiverID)
+		case expense.SplitTypes.Exact:
+			splitRatio = expense.NewExactSplitRatio(p.Shares)
+		case expense.SplitTypes.Weighted:
+			splitRatio = expense.NewWeightedSplitRatio(p.Amount, p.Shares)
 		default:
-			splitRatio = expense.NewEqualSplitRatio()
+			splitRatio = expense.NewEqualSplitRatio(participants...)
 		}
 
-		newExpense, err := expense.New(expense.Attributes{
-			ID:          expenseRepo.GetNextID(),
+		attr := expense.Attributes{
 			Name:        p.Name,
 			Amount:      p.Amount,
 			Description: p.Description,
@@ -121,16 +153,73 @@ func NewCreateExpense(
 			SplitType:   p.SplitType,
 			PayerID:     p.PayerID,
 			ReceiverID:  p.ReceiverID,
+			TagIDs:      p.TagIDs,
 			CreatedAt:   p.CreatedAt,
-		})
+			CreatedBy:   p.UserID,
+		}
+
+		if p.Installments > 1 {
+			installments, err := newInstallments(expenseRepo, attr, p.Installments)
+			if err != nil {
+				return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("entity.New: %w", err))
+			}
+
+			events := budgetAlertEvents(ctx, budgetRepo, *cat, authorOf(p), installments)
+			if err := expenseRepo.BulkStore(ctx, installments, events...); err != nil {
+				return nil, fmt.Errorf("expenseRepo.BulkStore: %w", err)
+			}
+
+			return &CreateExpenseResult{
+				Expense:            &installments[0],
+				PossibleDuplicates: possibleDuplicates(ctx, expenseRepo, installments[0]),
+			}, nil
+		}
+
+		if p.ID != nil {
+			attr.ID = *p.ID
+		} else {
+			attr.ID = expenseRepo.GetNextID()
+		}
+		newExpense, err := expense.New(attr)
 		if err != nil {
 			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("entity.New: %w", err))
 		}
 
-		if err := expenseRepo.Store(ctx, newExpense); err != nil {
-			return nil, fmt.Errorf("expenseRepo.Store: %w", err)
+		events := budgetAlertEvents(ctx, budgetRepo, *cat, authorOf(p), []expense.Expense{*newExpense})
+		if err := expenseRepo.StoreWithEvents(ctx, newExpense, events...); err != nil {
+			return nil, fmt.Errorf("expenseRepo.StoreWithEvents: %w", err)
 		}
 
-		return newExpense, nil
+		return &CreateExpenseResult{
+			Expense:            newExpense,
+			PossibleDuplicates: possibleDuplicates(ctx, expenseRepo, *newExpense),
+		}, nil
+	}
+}
+
+// authorOf is who the budget alerts of the expense are attributed to: its author or, for expenses
+// created by the system, the payer.
+func authorOf(p CreateExpenseParams) user.ID {
+	if p.UserID != nil {
+		return *p.UserID
 	}
+
+	return p.PayerID
+}
+
+// possibleDuplicates looks for expenses the new one may duplicate. Finding them is a courtesy to
+// the user, so a failure is only logged. Inside a unit of work a failed query would abort the
+// whole transaction, so the lookup is skipped and left to the caller, once it commits.
+func possibleDuplicates(ctx context.Context, expenseRepo expense.Repository, newExpense expense.Expense) []expense.Expense {
+	if _, ok := db.TxFromContext(ctx); ok {
+		return nil
+	}
+
+	duplicates, err := expenseRepo.GetPossibleDuplicates(ctx, newExpense)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to look for duplicated expenses", "expense_id", newExpense.ID.Value, "error", err)
+		return nil
+	}
+
+	return duplicates
 }
@@ -3,8 +3,11 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -13,7 +16,12 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
 
@@ -25,6 +33,9 @@ func TestCreateExpense(t *testing.T) {
 	categoryRepo := mocks.NewMockcategoryRepository(t)
 	expenseRepo := mocks.NewMockexpenseRepository(t)
 	incomeRepo := mocks.NewMockincomeRepository(t)
+	tagRepo := mocks.NewMocktagRepository(t)
+	budgetRepo := mocks.NewMockexpenseBudgetRepository(t)
+	budgetRepo.EXPECT().GetByGroupID(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
 	grp := group.New(group.Attributes{
 		ID:   group.ID{Value: 1},
@@ -47,13 +58,21 @@ func TestCreateExpense(t *testing.T) {
 		GroupID:        &grp.ID,
 	})
 
+	participant := user.New(user.Attributes{
+		ID:             user.ID{Value: 3},
+		Name:           "participant",
+		Email:          "participant@email.com",
+		ProfilePicture: nil,
+		GroupID:        &grp.ID,
+	})
+
 	catgry := category.New(category.Attributes{
 		ID:   category.ID{Value: 1},
 		Name: "test category",
 		Icon: "1",
 	})
 
-	createExpense := usecase.NewCreateExpense(expenseRepo, userRepo, groupRepo, categoryRepo, incomeRepo)
+	createExpense := usecase.NewCreateExpense(expenseRepo, userRepo, groupRepo, categoryRepo, incomeRepo, tagRepo, budgetRepo)
 
 	t.Run("should return error userRepo fails", func(t *testing.T) {
 		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(nil, errors.New("test error")).Once()
@@ -226,7 +245,7 @@ func TestCreateExpense(t *testing.T) {
 		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
 		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
 		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
-		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(errors.New("test error")).Once()
 
 		p := usecase.CreateExpenseParams{
 			PayerID:     payer.ID,
@@ -241,7 +260,7 @@ func TestCreateExpense(t *testing.T) {
 
 		expns, err := createExpense(ctx, p)
 		assert.Nil(t, expns)
-		assert.EqualError(t, err, "expenseRepo.Store: test error")
+		assert.EqualError(t, err, "expenseRepo.StoreWithEvents: test error")
 	})
 
 	t.Run("happy path with equal split ratio", func(t *testing.T) {
@@ -250,7 +269,8 @@ func TestCreateExpense(t *testing.T) {
 		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
 		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
 		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
-		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
 
 		p := usecase.CreateExpenseParams{
 			PayerID:     payer.ID,
@@ -263,26 +283,54 @@ func TestCreateExpense(t *testing.T) {
 			Description: "description",
 		}
 
-		expns, err := createExpense(ctx, p)
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
 		assert.Equal(t, expense.ID{Value: 1}, expns.ID)
 		assert.Equal(t, "name", expns.Name)
 		assert.Equal(t, 100, expns.Amount)
 		assert.Equal(t, "description", expns.Description)
 		assert.Equal(t, grp.ID, expns.GroupID)
 		assert.Equal(t, catgry.ID, expns.CategoryID)
-		assert.Equal(t, expense.NewEqualSplitRatio(), expns.SplitRatio)
+		assert.Equal(t, expense.NewEqualSplitRatio(payer.ID, receiver.ID), expns.SplitRatio)
 		assert.Equal(t, payer.ID, expns.PayerID)
 		assert.Equal(t, receiver.ID, expns.ReceiverID)
 		assert.Nil(t, err)
 	})
 
+	t.Run("should create the expense under the ID it was given", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.MatchedBy(func(e *expense.Expense) bool {
+			return e.ID == expense.ID{Value: 10}
+		})).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			ID:         &expense.ID{Value: 10},
+			PayerID:    payer.ID,
+			ReceiverID: receiver.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "equal",
+			Name:       "name",
+			Amount:     100,
+		}
+
+		result, err := createExpense(ctx, p)
+		assert.NoError(t, err)
+		assert.Equal(t, expense.ID{Value: 10}, result.Expense.ID)
+	})
+
 	t.Run("happy path with proportional split ratio", func(t *testing.T) {
 		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
 		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
 		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
 		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
 		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
-		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
 		incomeRepo.EXPECT().GetUserMonthlyIncomes(ctx, payer.ID, mock.Anything).Return([]income.Income{{Amount: 40}}, nil).Once()
 		incomeRepo.EXPECT().GetUserMonthlyIncomes(ctx, receiver.ID, mock.Anything).Return([]income.Income{{Amount: 60}}, nil).Once()
 
@@ -297,7 +345,8 @@ func TestCreateExpense(t *testing.T) {
 			Description: "description",
 		}
 
-		expns, err := createExpense(ctx, p)
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
 		assert.Equal(t, expense.ID{Value: 1}, expns.ID)
 		assert.Equal(t, "name", expns.Name)
 		assert.Equal(t, 100, expns.Amount)
@@ -305,8 +354,10 @@ func TestCreateExpense(t *testing.T) {
 		assert.Equal(t, grp.ID, expns.GroupID)
 		assert.Equal(t, catgry.ID, expns.CategoryID)
 		assert.Equal(t, expense.SplitRatio{
-			Payer:    40,
-			Receiver: 60,
+			Shares: []expense.Share{
+				{UserID: payer.ID, Percent: 40},
+				{UserID: receiver.ID, Percent: 60},
+			},
 		}, expns.SplitRatio)
 		assert.Equal(t, payer.ID, expns.PayerID)
 		assert.Equal(t, receiver.ID, expns.ReceiverID)
@@ -319,7 +370,8 @@ func TestCreateExpense(t *testing.T) {
 		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
 		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
 		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
-		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
 
 		p := usecase.CreateExpenseParams{
 			PayerID:     payer.ID,
@@ -332,7 +384,8 @@ func TestCreateExpense(t *testing.T) {
 			Description: "description",
 		}
 
-		expns, err := createExpense(ctx, p)
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
 		assert.Equal(t, expense.ID{Value: 1}, expns.ID)
 		assert.Equal(t, "name", expns.Name)
 		assert.Equal(t, 100, expns.Amount)
@@ -340,11 +393,504 @@ func TestCreateExpense(t *testing.T) {
 		assert.Equal(t, grp.ID, expns.GroupID)
 		assert.Equal(t, catgry.ID, expns.CategoryID)
 		assert.Equal(t, expense.SplitRatio{
-			Payer:    0,
-			Receiver: 100,
+			Shares: []expense.Share{
+				{UserID: payer.ID, Percent: 0},
+				{UserID: receiver.ID, Percent: 100},
+			},
+		}, expns.SplitRatio)
+		assert.Equal(t, payer.ID, expns.PayerID)
+		assert.Equal(t, receiver.ID, expns.ReceiverID)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should return error if participant's group does not match", func(t *testing.T) {
+		outsider := user.New(user.Attributes{
+			ID:      user.ID{Value: 4},
+			Name:    "outsider",
+			Email:   "outsider@email.com",
+			GroupID: &group.ID{Value: 3},
+		})
+
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, outsider.ID).Return(outsider, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:      payer.ID,
+			Participants: []user.ID{payer.ID, receiver.ID, outsider.ID},
+			GroupID:      grp.ID,
+			CategoryID:   catgry.ID,
+			SplitType:    "equal",
+			Name:         "name",
+			Amount:       100,
+			Description:  "description",
+		}
+
+		expns, err := createExpense(ctx, p)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "group mismatch")
+	})
+
+	t.Run("happy path with equal split among three participants", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, participant.ID).Return(participant, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:      payer.ID,
+			Participants: []user.ID{payer.ID, receiver.ID, participant.ID},
+			GroupID:      grp.ID,
+			CategoryID:   catgry.ID,
+			SplitType:    "equal",
+			Name:         "name",
+			Amount:       100,
+			Description:  "description",
+		}
+
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
+		assert.Nil(t, err)
+		assert.Equal(t, expense.SplitRatio{
+			Shares: []expense.Share{
+				{UserID: payer.ID, Percent: 34},
+				{UserID: receiver.ID, Percent: 33},
+				{UserID: participant.ID, Percent: 33},
+			},
 		}, expns.SplitRatio)
 		assert.Equal(t, payer.ID, expns.PayerID)
 		assert.Equal(t, receiver.ID, expns.ReceiverID)
+		assert.Equal(t, []user.ID{payer.ID, receiver.ID, participant.ID}, expns.Participants())
+	})
+
+	t.Run("happy path with proportional split among three participants", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, participant.ID).Return(participant, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
+		incomeRepo.EXPECT().GetUserMonthlyIncomes(ctx, payer.ID, mock.Anything).Return([]income.Income{{Amount: 5000}}, nil).Once()
+		incomeRepo.EXPECT().GetUserMonthlyIncomes(ctx, receiver.ID, mock.Anything).Return([]income.Income{{Amount: 3000}}, nil).Once()
+		incomeRepo.EXPECT().GetUserMonthlyIncomes(ctx, participant.ID, mock.Anything).Return([]income.Income{{Amount: 2000}}, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:      payer.ID,
+			Participants: []user.ID{payer.ID, receiver.ID, participant.ID},
+			GroupID:      grp.ID,
+			CategoryID:   catgry.ID,
+			SplitType:    "proportional",
+			Name:         "name",
+			Amount:       100,
+			Description:  "description",
+		}
+
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
+		assert.Nil(t, err)
+		assert.Equal(t, expense.SplitRatio{
+			Shares: []expense.Share{
+				{UserID: payer.ID, Percent: 50},
+				{UserID: receiver.ID, Percent: 30},
+				{UserID: participant.ID, Percent: 20},
+			},
+		}, expns.SplitRatio)
+	})
+
+	t.Run("should return error if custom split has no shares", func(t *testing.T) {
+		p := usecase.CreateExpenseParams{
+			PayerID:     payer.ID,
+			ReceiverID:  receiver.ID,
+			GroupID:     grp.ID,
+			CategoryID:  catgry.ID,
+			SplitType:   "exact",
+			Name:        "name",
+			Amount:      10000,
+			Description: "description",
+		}
+
+		expns, err := createExpense(ctx, p)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "missing split shares")
+	})
+
+	t.Run("should return error if exact amounts do not add up to the expense amount", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "exact",
+			Shares: []expense.Share{
+				{UserID: payer.ID, Amount: 6255},
+				{UserID: receiver.ID, Amount: 3744},
+			},
+			Name:        "name",
+			Amount:      10000,
+			Description: "description",
+		}
+
+		expns, err := createExpense(ctx, p)
+		assert.Nil(t, expns)
+		assert.ErrorIs(t, err, expense.ErrInvalidSplitRatio)
+	})
+
+	t.Run("happy path with exact split", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "exact",
+			Shares: []expense.Share{
+				{UserID: payer.ID, Amount: 6255},
+				{UserID: receiver.ID, Amount: 3745},
+			},
+			Name:        "name",
+			Amount:      10000,
+			Description: "description",
+		}
+
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
+		assert.Nil(t, err)
+		assert.Equal(t, expense.SplitRatio{
+			Shares: []expense.Share{
+				{UserID: payer.ID, Percent: 63, Amount: 6255},
+				{UserID: receiver.ID, Percent: 37, Amount: 3745},
+			},
+		}, expns.SplitRatio)
+		assert.Equal(t, receiver.ID, expns.ReceiverID)
+	})
+
+	t.Run("happy path with weighted split", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "weighted",
+			Shares: []expense.Share{
+				{UserID: payer.ID, Weight: 1},
+				{UserID: receiver.ID, Weight: 2},
+			},
+			Name:        "name",
+			Amount:      10000,
+			Description: "description",
+		}
+
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
+		assert.Nil(t, err)
+		assert.Equal(t, expense.SplitRatio{
+			Shares: []expense.Share{
+				{UserID: payer.ID, Percent: 33, Amount: 3333, Weight: 1},
+				{UserID: receiver.ID, Percent: 67, Amount: 6667, Weight: 2},
+			},
+		}, expns.SplitRatio)
+	})
+
+	t.Run("happy path with installments", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 2}).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 3}).Once()
+
+		var stored []expense.Expense
+		expenseRepo.EXPECT().BulkStore(ctx, mock.Anything).Run(func(_ context.Context, expenses []expense.Expense, _ ...outbox.Event) {
+			stored = expenses
+		}).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.MatchedBy(func(e expense.Expense) bool {
+			return e.ID.Value == 1
+		})).Return(nil, nil).Once()
+
+		purchaseDate := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+		p := usecase.CreateExpenseParams{
+			PayerID:      payer.ID,
+			ReceiverID:   receiver.ID,
+			GroupID:      grp.ID,
+			CategoryID:   catgry.ID,
+			SplitType:    "equal",
+			Name:         "name",
+			Amount:       10001,
+			Description:  "description",
+			Installments: 3,
+			CreatedAt:    &purchaseDate,
+		}
+
+		result, err := createExpense(ctx, p)
+		expns := result.Expense
+		assert.Nil(t, err)
+		assert.Equal(t, expense.ID{Value: 1}, expns.ID)
+		assert.Len(t, stored, 3)
+
+		amounts := []int{3334, 3334, 3333}
+		dates := []time.Time{
+			purchaseDate,
+			time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC),
+		}
+		for i, installment := range stored {
+			assert.Equal(t, amounts[i], installment.Amount)
+			assert.Equal(t, dates[i], installment.CreatedAt)
+			assert.Equal(t, expns.Installment.PurchaseID, installment.Installment.PurchaseID)
+			assert.Equal(t, fmt.Sprintf("%d/3", i+1), installment.Installment.String())
+		}
+	})
+	t.Run("should warn about possible duplicates", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 2}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+
+		duplicate := expense.Expense{Name: "Name", Amount: 100}
+		duplicate.ID = expense.ID{Value: 1}
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.MatchedBy(func(e expense.Expense) bool {
+			return e.ID.Value == 2 && e.Name == "name" && e.Amount == 100
+		})).Return([]expense.Expense{duplicate}, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			ReceiverID: receiver.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "equal",
+			Name:       "name",
+			Amount:     100,
+		}
+
+		result, err := createExpense(ctx, p)
+		assert.Nil(t, err)
+		assert.Equal(t, expense.ID{Value: 2}, result.Expense.ID)
+		assert.Equal(t, []expense.Expense{duplicate}, result.PossibleDuplicates)
+	})
+
+	t.Run("should create the expense even if looking for duplicates fails", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 2}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, errors.New("test error")).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			ReceiverID: receiver.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "equal",
+			Name:       "name",
+			Amount:     100,
+		}
+
+		result, err := createExpense(ctx, p)
+		assert.Nil(t, err)
+		assert.Equal(t, expense.ID{Value: 2}, result.Expense.ID)
+		assert.Empty(t, result.PossibleDuplicates)
+	})
+
+	t.Run("should not look for duplicates inside a transaction", func(t *testing.T) {
+		txCtx := db.WithTx(ctx, &sqlx.Tx{})
+		userRepo.EXPECT().GetByID(txCtx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(txCtx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(txCtx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(txCtx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 2}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(txCtx, mock.Anything).Return(nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			ReceiverID: receiver.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "equal",
+			Name:       "name",
+			Amount:     100,
+		}
+
+		result, err := createExpense(txCtx, p)
+		assert.Nil(t, err)
+		assert.Equal(t, expense.ID{Value: 2}, result.Expense.ID)
+		assert.Empty(t, result.PossibleDuplicates)
+	})
+
+	t.Run("should return error if a tag belongs to another group", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		otherTag, _ := tag.New(tag.Attributes{ID: tag.ID{Value: 5}, GroupID: group.ID{Value: 2}, Name: "viagem"})
+		tagRepo.EXPECT().GetByID(ctx, otherTag.ID).Return(otherTag, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			ReceiverID: receiver.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "equal",
+			Name:       "name",
+			Amount:     100,
+			TagIDs:     []tag.ID{otherTag.ID},
+		}
+
+		result, err := createExpense(ctx, p)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "tag 5 not found")
+	})
+
+	t.Run("happy path with tags", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		trip, _ := tag.New(tag.Attributes{ID: tag.ID{Value: 3}, GroupID: grp.ID, Name: "viagem"})
+		beach, _ := tag.New(tag.Attributes{ID: tag.ID{Value: 1}, GroupID: grp.ID, Name: "praia"})
+		tagRepo.EXPECT().GetByID(ctx, trip.ID).Return(trip, nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, beach.ID).Return(beach, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 3}).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
+
+		p := usecase.CreateExpenseParams{
+			PayerID:    payer.ID,
+			ReceiverID: receiver.ID,
+			GroupID:    grp.ID,
+			CategoryID: catgry.ID,
+			SplitType:  "equal",
+			Name:       "name",
+			Amount:     100,
+			TagIDs:     []tag.ID{trip.ID, beach.ID},
+		}
+
+		result, err := createExpense(ctx, p)
+		assert.Nil(t, err)
+		assert.Equal(t, []tag.ID{beach.ID, trip.ID}, result.Expense.TagIDs)
+	})
+}
+
+func TestCreateExpense_BudgetAlerts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userRepo := mocks.NewMockuserRepository(t)
+	groupRepo := mocks.NewMockgroupRepository(t)
+	categoryRepo := mocks.NewMockcategoryRepository(t)
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	incomeRepo := mocks.NewMockincomeRepository(t)
+	tagRepo := mocks.NewMocktagRepository(t)
+	budgetRepo := mocks.NewMockexpenseBudgetRepository(t)
+
+	grp := group.New(group.Attributes{ID: group.ID{Value: 1}, Name: "group"})
+	receiver := user.New(user.Attributes{ID: user.ID{Value: 1}, Name: "receiver", Email: "receiver@email.com", GroupID: &grp.ID})
+	payer := user.New(user.Attributes{ID: user.ID{Value: 2}, Name: "payer", Email: "payer@email.com", GroupID: &grp.ID})
+	catgry := category.New(category.Attributes{ID: category.ID{Value: 1}, Name: "mercado", CategoryGroupID: category.GroupID{Value: 7}})
+
+	createdAt := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC)
+	groupBudget := expense.Budget{
+		Entity:          ddd.Entity[expense.BudgetID]{ID: expense.BudgetID{Value: 1}, CreatedAt: time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)},
+		GroupID:         grp.ID,
+		CategoryGroupID: &category.GroupID{Value: 7},
+		Amount:          10000,
+		CarryOver:       true,
+	}
+	otherBudget := expense.Budget{
+		Entity:     ddd.Entity[expense.BudgetID]{ID: expense.BudgetID{Value: 2}, CreatedAt: groupBudget.CreatedAt},
+		GroupID:    grp.ID,
+		CategoryID: &category.ID{Value: 9},
+		Amount:     10000,
+	}
+
+	createExpense := usecase.NewCreateExpense(expenseRepo, userRepo, groupRepo, categoryRepo, incomeRepo, tagRepo, budgetRepo)
+
+	p := usecase.CreateExpenseParams{
+		PayerID:    payer.ID,
+		ReceiverID: receiver.ID,
+		GroupID:    grp.ID,
+		CategoryID: catgry.ID,
+		SplitType:  "equal",
+		Name:       "compras do mês",
+		Amount:     3000,
+		CreatedAt:  &createdAt,
+	}
+
+	setup := func() {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		groupRepo.EXPECT().GetByID(ctx, grp.ID).Return(grp, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, catgry.ID).Return(catgry, nil).Once()
+		expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 1}).Once()
+		expenseRepo.EXPECT().GetPossibleDuplicates(ctx, mock.Anything).Return(nil, nil).Once()
+	}
+
+	t.Run("should write an event for each threshold reached", func(t *testing.T) {
+		setup()
+		budgetRepo.EXPECT().GetByGroupID(ctx, grp.ID).Return([]expense.Budget{groupBudget, otherBudget}, nil).Once()
+		// April left 2000 over, so May has a limit of 12000 and 9000 spent before the new expense
+		budgetRepo.EXPECT().GetMonthlySpending(ctx, groupBudget, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)).Return([]int{8000, 9000}, nil).Once()
+
+		var events []outbox.Event
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ *expense.Expense, e ...outbox.Event) {
+				events = e
+			}).Return(nil).Once()
+
+		_, err := createExpense(ctx, p)
+		assert.Nil(t, err)
+		assert.Len(t, events, 2)
+
+		for i, threshold := range []int{80, 100} {
+			assert.Equal(t, pubsub.BudgetsTopic, events[i].Topic)
+			event, ok := events[i].Payload.(pubsub.BudgetAlertEvent)
+			assert.True(t, ok)
+			assert.Equal(t, "budget_alert", event.Type)
+			assert.Equal(t, grp.ID, event.GroupID)
+			assert.Equal(t, payer.ID, event.UserID)
+			assert.Equal(t, groupBudget.ID, event.Alert.BudgetID)
+			assert.Equal(t, threshold, event.Alert.Threshold)
+			assert.Equal(t, 12000, event.Alert.Limit)
+			assert.Equal(t, 12000, event.Alert.Spent)
+			assert.Equal(t, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), event.Alert.Month)
+		}
+	})
+
+	t.Run("should create the expense even if checking the budgets fails", func(t *testing.T) {
+		setup()
+		budgetRepo.EXPECT().GetByGroupID(ctx, grp.ID).Return(nil, errors.New("test error")).Once()
+		expenseRepo.EXPECT().StoreWithEvents(ctx, mock.Anything).Return(nil).Once()
+
+		result, err := createExpense(ctx, p)
 		assert.Nil(t, err)
+		assert.Equal(t, expense.ID{Value: 1}, result.Expense.ID)
 	})
 }
@@ -10,19 +10,21 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 )
 
 type CreateScheduledExpenseInput struct {
-	Name            string            `json:"name" validate:"required"`
-	Amount          int               `json:"amount" validate:"required"`
-	Description     string            `json:"description" validate:"required"`
-	GroupID         group.ID          `json:"group_id" validate:"required"`
-	CategoryID      category.ID       `json:"category_id" validate:"required"`
-	SplitType       expense.SplitType `json:"split_type" validate:"required"`
-	PayerID         user.ID           `json:"payer_id" validate:"required"`
-	ReceiverID      user.ID           `json:"receiver_id" validate:"required"`
-	FrequencyInDays int               `json:"frequency_in_days" validate:"required"`
-	LastGeneratedAt *civil.Date       `json:"last_generated_at" validate:"required"`
+	Name            string              `json:"name" validate:"required"`
+	Amount          int                 `json:"amount" validate:"required"`
+	Description     string              `json:"description" validate:"required"`
+	GroupID         group.ID            `json:"group_id" validate:"required"`
+	CategoryID      category.ID         `json:"category_id" validate:"required"`
+	SplitType       expense.SplitType   `json:"split_type" validate:"required"`
+	PayerID         user.ID             `json:"payer_id" validate:"required"`
+	ReceiverID      user.ID             `json:"receiver_id" validate:"required"`
+	FrequencyInDays int                 `json:"frequency_in_days" validate:"required_without=Recurrence"`
+	Recurrence      *expense.Recurrence `json:"recurrence"`
+	LastGeneratedAt *civil.Date         `json:"last_generated_at" validate:"required"`
 }
 
 type CreateScheduledExpense func(ctx context.Context, input CreateScheduledExpenseInput) error
@@ -43,10 +45,11 @@ func NewCreateScheduledExpense(
 			PayerID:         input.PayerID,
 			ReceiverID:      input.ReceiverID,
 			FrequencyInDays: input.FrequencyInDays,
+			Recurrence:      input.Recurrence,
 			LastGeneratedAt: input.LastGeneratedAt,
 		})
 		if err != nil {
-			return fmt.Errorf("failed to create scheduled expense: %w", err)
+			return except.UnprocessableEntityError().SetInternal(fmt.Errorf("failed to create scheduled expense: %w", err))
 		}
 
 		// Salvar a despesa agendada
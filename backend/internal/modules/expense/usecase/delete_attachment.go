@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/shared/service"
+)
+
+type (
+	DeleteAttachmentParams struct {
+		GroupID      group.ID
+		ExpenseID    expense.ID
+		AttachmentID expense.AttachmentID
+	}
+
+	DeleteAttachment func(ctx context.Context, p DeleteAttachmentParams) error
+)
+
+// NewDeleteAttachment detaches the file from the expense before removing it from the storage.
+func NewDeleteAttachment(attachmentRepo expense.AttachmentRepository, fileStorage service.FileStorage) DeleteAttachment {
+	return func(ctx context.Context, p DeleteAttachmentParams) error {
+		attachment, err := getExpenseAttachment(ctx, attachmentRepo, p.AttachmentID, p.ExpenseID, p.GroupID)
+		if err != nil {
+			return err
+		}
+
+		if err := attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+			return fmt.Errorf("attachmentRepo.Delete: %w", err)
+		}
+
+		deleteAttachmentFiles(ctx, fileStorage, *attachment)
+
+		return nil
+	}
+}
+
+// deleteAttachmentFiles removes the files of an attachment already deleted, so a storage failure
+// leaves orphaned files behind rather than an attachment that can't be opened. Failures are only
+// logged.
+func deleteAttachmentFiles(ctx context.Context, fileStorage service.FileStorage, attachment expense.Attachment) {
+	keys := []string{attachment.StorageKey}
+	if attachment.ThumbnailKey != nil {
+		keys = append(keys, *attachment.ThumbnailKey)
+	}
+
+	for _, key := range keys {
+		if err := fileStorage.Delete(ctx, key); err != nil {
+			slog.WarnContext(ctx, "failed to delete the file of an attachment", "attachment_id", attachment.ID.Value, "key", key, "error", err)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+)
+
+type DeleteBudget func(ctx context.Context, p BudgetParams) error
+
+func NewDeleteBudget(budgetRepo expense.BudgetRepository) DeleteBudget {
+	return func(ctx context.Context, p BudgetParams) error {
+		budget, err := getGroupBudget(ctx, budgetRepo, p)
+		if err != nil {
+			return err
+		}
+
+		if err := budgetRepo.Delete(ctx, budget.ID); err != nil {
+			return fmt.Errorf("budgetRepo.Delete: %w", err)
+		}
+
+		return nil
+	}
+}
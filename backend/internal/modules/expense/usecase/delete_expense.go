@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 )
 
-type DeleteExpense func(ctx context.Context, expenseID expense.ID) (*expense.Expense, error)
+type DeleteExpense func(ctx context.Context, expenseID expense.ID, deletedBy *user.ID) (*expense.Expense, error)
 
 func NewDeleteExpense(expenseRepo expense.Repository) DeleteExpense {
-	return func(ctx context.Context, expenseID expense.ID) (*expense.Expense, error) {
+	return func(ctx context.Context, expenseID expense.ID, deletedBy *user.ID) (*expense.Expense, error) {
 		expns, err := expenseRepo.GetByID(ctx, expenseID)
 		if err != nil {
 			return nil, fmt.Errorf("expenseRepo.GetByID: %w", err)
@@ -21,10 +22,27 @@ func NewDeleteExpense(expenseRepo expense.Repository) DeleteExpense {
 			return nil, except.NotFoundError("expense not found")
 		}
 
-		expns.Delete()
+		expns.Delete(deletedBy)
 
-		if err := expenseRepo.Store(ctx, expns); err != nil {
-			return nil, fmt.Errorf("expenseRepo.Store: %w", err)
+		if expns.Installment == nil {
+			if err := expenseRepo.Store(ctx, expns); err != nil {
+				return nil, fmt.Errorf("expenseRepo.Store: %w", err)
+			}
+
+			return expns, nil
+		}
+
+		later, err := laterInstallments(ctx, expenseRepo, expns)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range later {
+			later[i].Delete(deletedBy)
+		}
+
+		if err := expenseRepo.BulkStore(ctx, append([]expense.Expense{*expns}, later...)); err != nil {
+			return nil, fmt.Errorf("expenseRepo.BulkStore: %w", err)
 		}
 
 		return expns, nil
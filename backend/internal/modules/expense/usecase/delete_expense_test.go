@@ -28,18 +28,19 @@ func TestDeleteExpense(t *testing.T) {
 		Description: "description",
 		GroupID:     group.ID{Value: 1},
 		CategoryID:  category.ID{Value: 1},
-		SplitRatio:  expense.NewEqualSplitRatio(),
+		SplitRatio:  expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
 		PayerID:     user.ID{Value: 1},
 		ReceiverID:  user.ID{Value: 2},
 	})
 	assert.Nil(t, err)
 
+	deletedBy := user.ID{Value: 2}
 	deleteExpense := usecase.NewDeleteExpense(expenseRepo)
 
 	t.Run("should return error if expenseRepo fails", func(t *testing.T) {
 		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(nil, errors.New("test error")).Once()
 
-		delExpense, err := deleteExpense(ctx, expns.ID)
+		delExpense, err := deleteExpense(ctx, expns.ID, &deletedBy)
 		assert.Nil(t, delExpense)
 		assert.EqualError(t, err, "expenseRepo.GetByID: test error")
 	})
@@ -47,7 +48,7 @@ func TestDeleteExpense(t *testing.T) {
 	t.Run("should return error if expense not found", func(t *testing.T) {
 		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(nil, nil).Once()
 
-		delExpense, err := deleteExpense(ctx, expns.ID)
+		delExpense, err := deleteExpense(ctx, expns.ID, &deletedBy)
 		assert.Nil(t, delExpense)
 		assert.EqualError(t, err, "expense not found")
 	})
@@ -56,7 +57,7 @@ func TestDeleteExpense(t *testing.T) {
 		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
 		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
 
-		delExpense, err := deleteExpense(ctx, expns.ID)
+		delExpense, err := deleteExpense(ctx, expns.ID, &deletedBy)
 		assert.Nil(t, delExpense)
 		assert.EqualError(t, err, "expenseRepo.Store: test error")
 	})
@@ -65,9 +66,42 @@ func TestDeleteExpense(t *testing.T) {
 		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
 		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
 
-		delExpense, err := deleteExpense(ctx, expns.ID)
+		delExpense, err := deleteExpense(ctx, expns.ID, &deletedBy)
 		assert.Equal(t, expense.ID{Value: 1}, delExpense.ID)
 		assert.NotNil(t, delExpense.DeletedAt)
+		assert.Equal(t, &deletedBy, delExpense.UpdatedBy)
 		assert.Nil(t, err)
 	})
+
+	t.Run("should delete the following installments of the purchase", func(t *testing.T) {
+		var installments []expense.Expense
+		for number := 1; number <= 3; number++ {
+			installment, err := expense.New(expense.Attributes{
+				ID:          expense.ID{Value: 10 + number},
+				Name:        "name",
+				Amount:      100,
+				GroupID:     group.ID{Value: 1},
+				CategoryID:  category.ID{Value: 1},
+				SplitRatio:  expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
+				PayerID:     user.ID{Value: 1},
+				ReceiverID:  user.ID{Value: 2},
+				Installment: &expense.Installment{PurchaseID: "purchase", Number: number, Total: 3},
+			})
+			assert.Nil(t, err)
+			installments = append(installments, *installment)
+		}
+
+		second := installments[1]
+		expenseRepo.EXPECT().GetByID(ctx, second.ID).Return(&second, nil).Once()
+		expenseRepo.EXPECT().GetByPurchaseID(ctx, "purchase").Return(installments, nil).Once()
+		expenseRepo.EXPECT().BulkStore(ctx, mock.MatchedBy(func(expenses []expense.Expense) bool {
+			return len(expenses) == 2 &&
+				expenses[0].ID == second.ID && expenses[0].DeletedAt != nil &&
+				expenses[1].ID == installments[2].ID && expenses[1].DeletedAt != nil
+		})).Return(nil).Once()
+
+		delExpense, err := deleteExpense(ctx, second.ID, &deletedBy)
+		assert.Nil(t, err)
+		assert.NotNil(t, delExpense.DeletedAt)
+	})
 }
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+)
+
+type DeleteScheduledExpense func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error)
+
+func NewDeleteScheduledExpense(scheduledExpenseRepo expense.ScheduledExpenseRepository) DeleteScheduledExpense {
+	return func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error) {
+		scheduledExpense, err := getGroupScheduledExpense(ctx, scheduledExpenseRepo, p)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduledExpense.Delete()
+
+		if err := scheduledExpenseRepo.Store(ctx, scheduledExpense); err != nil {
+			return nil, fmt.Errorf("scheduledExpenseRepo.Store: %w", err)
+		}
+
+		return scheduledExpense, nil
+	}
+}
@@ -0,0 +1,51 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestDeleteScheduledExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	deleteScheduledExpense := usecase.NewDeleteScheduledExpense(scheduledExpenseRepo)
+	params := usecase.ScheduledExpenseParams{ID: expense.ScheduledExpenseID{Value: 1}, GroupID: group.ID{Value: 1}}
+
+	t.Run("should return error if store fails", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(newScheduledExpense(t), nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+
+		result, err := deleteScheduledExpense(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduledExpenseRepo.Store: test error")
+	})
+
+	t.Run("should forbid scheduled expenses from another group", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(newScheduledExpense(t), nil).Once()
+
+		result, err := deleteScheduledExpense(ctx, usecase.ScheduledExpenseParams{ID: params.ID, GroupID: group.ID{Value: 2}})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduled expense does not belong to the group")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(newScheduledExpense(t), nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.MatchedBy(func(se *expense.ScheduledExpense) bool {
+			return se.DeletedAt != nil && !se.IsActive
+		})).Return(nil).Once()
+
+		result, err := deleteScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.NotNil(t, result.DeletedAt)
+	})
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	DiscardImportCandidateParams struct {
+		ID      expense.ImportCandidateID
+		GroupID group.ID
+	}
+
+	DiscardImportCandidate func(ctx context.Context, p DiscardImportCandidateParams) (*expense.ImportCandidate, error)
+)
+
+func NewDiscardImportCandidate(candidateRepo expense.ImportCandidateRepository) DiscardImportCandidate {
+	return func(ctx context.Context, p DiscardImportCandidateParams) (*expense.ImportCandidate, error) {
+		candidate, err := getGroupImportCandidate(ctx, candidateRepo, p.ID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := candidate.Discard(); err != nil {
+			return nil, except.UnprocessableEntityError(err.Error())
+		}
+
+		if err := candidateRepo.Store(ctx, candidate); err != nil {
+			return nil, fmt.Errorf("candidateRepo.Store: %w", err)
+		}
+
+		return candidate, nil
+	}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	DismissDuplicatesParams struct {
+		GroupID    group.ID
+		ExpenseIDs []expense.ID
+	}
+
+	DismissDuplicates func(ctx context.Context, p DismissDuplicatesParams) error
+)
+
+// NewDismissDuplicates marks expenses suspected of being duplicates as distinct purchases, taking
+// them out of the duplicate clusters of the group.
+func NewDismissDuplicates(expenseRepo expense.Repository) DismissDuplicates {
+	return func(ctx context.Context, p DismissDuplicatesParams) error {
+		if len(p.ExpenseIDs) < 2 {
+			return except.UnprocessableEntityError("at least two expenses are needed")
+		}
+
+		if _, err := getGroupExpenses(ctx, expenseRepo, p.ExpenseIDs, p.GroupID); err != nil {
+			return err
+		}
+
+		if err := expenseRepo.DismissDuplicates(ctx, p.GroupID, p.ExpenseIDs); err != nil {
+			return fmt.Errorf("expenseRepo.DismissDuplicates: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// getGroupExpenses returns the expenses, failing as not found when any of them is deleted or
+// belongs to another group.
+func getGroupExpenses(ctx context.Context, expenseRepo expense.Repository, ids []expense.ID, groupID group.ID) ([]expense.Expense, error) {
+	expenses := make([]expense.Expense, 0, len(ids))
+	for _, id := range ids {
+		expns, err := expenseRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("expenseRepo.GetByID: %w", err)
+		}
+
+		if expns == nil || expns.GroupID != groupID {
+			return nil, except.NotFoundError(fmt.Sprintf("expense %d not found", id.Value))
+		}
+
+		expenses = append(expenses, *expns)
+	}
+
+	return expenses, nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/storage"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/thumbnail"
+	"github.com/Beigelman/nossas-despesas/internal/shared/service"
+)
+
+type (
+	DownloadAttachmentParams struct {
+		GroupID      group.ID
+		ExpenseID    expense.ID
+		AttachmentID expense.AttachmentID
+		// Thumbnail asks for the preview of the file, falling back to the file itself when there is none.
+		Thumbnail bool
+	}
+
+	DownloadAttachmentResult struct {
+		Attachment  expense.Attachment
+		ContentType string
+		Content     io.ReadCloser
+	}
+
+	DownloadAttachment func(ctx context.Context, p DownloadAttachmentParams) (*DownloadAttachmentResult, error)
+)
+
+// NewDownloadAttachment opens the file of an attachment. Closing the content is up to the caller.
+func NewDownloadAttachment(attachmentRepo expense.AttachmentRepository, fileStorage service.FileStorage) DownloadAttachment {
+	return func(ctx context.Context, p DownloadAttachmentParams) (*DownloadAttachmentResult, error) {
+		attachment, err := getExpenseAttachment(ctx, attachmentRepo, p.AttachmentID, p.ExpenseID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		key, contentType := attachment.StorageKey, attachment.ContentType
+		if p.Thumbnail && attachment.ThumbnailKey != nil {
+			key, contentType = *attachment.ThumbnailKey, thumbnail.ContentType
+		}
+
+		content, err := fileStorage.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, except.NotFoundError("attachment file not found")
+			}
+			return nil, fmt.Errorf("fileStorage.Get: %w", err)
+		}
+
+		return &DownloadAttachmentResult{
+			Attachment:  *attachment,
+			ContentType: contentType,
+			Content:     content,
+		}, nil
+	}
+}
+
+// getExpenseAttachment returns the attachment, failing as not found when it belongs to another
+// expense or group.
+func getExpenseAttachment(
+	ctx context.Context,
+	attachmentRepo expense.AttachmentRepository,
+	id expense.AttachmentID,
+	expenseID expense.ID,
+	groupID group.ID,
+) (*expense.Attachment, error) {
+	attachment, err := attachmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("attachmentRepo.GetByID: %w", err)
+	}
+
+	if attachment == nil || attachment.ExpenseID != expenseID || attachment.GroupID != groupID {
+		return nil, except.NotFoundError("attachment not found")
+	}
+
+	return attachment, nil
+}
@@ -2,10 +2,17 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"cloud.google.com/go/civil"
+
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 )
 
@@ -13,7 +20,8 @@ type GenerateExpensesFromScheduledUseCase func(ctx context.Context) (expensesCre
 
 func NewGenerateExpensesFromScheduledUseCase(
 	scheduledExpenseRepo expense.ScheduledExpenseRepository,
-	publisher pubsub.Publisher,
+	expenseRepo expense.Repository,
+	cal calendar.Calendar,
 ) GenerateExpensesFromScheduledUseCase {
 	return func(ctx context.Context) (expensesCreated int, err error) {
 		activeScheduledExpenses, err := scheduledExpenseRepo.GetActiveScheduledExpenses(ctx)
@@ -21,45 +29,64 @@ func NewGenerateExpensesFromScheduledUseCase(
 			return 0, fmt.Errorf("failed to get active scheduled expenses: %w", err)
 		}
 
-		var (
-			expenses                 []expense.Expense
-			scheduledExpensesCreated []expense.ScheduledExpense
-		)
+		today := civil.DateOf(time.Now())
+
 		for _, scheduledExpense := range activeScheduledExpenses {
-			if !scheduledExpense.ShouldGenerateExpense() {
+			dueDates := scheduledExpense.DueOccurrences(cal, today)
+			if len(dueDates) == 0 {
 				continue
 			}
 
-			exp, err := scheduledExpense.ToExpense()
+			occurrences, err := scheduledOccurrences(expenseRepo, &scheduledExpense, dueDates)
 			if err != nil {
-				return 0, fmt.Errorf("failed to convert scheduled expense to expense: %w", err)
+				// It would fail again on every run, so it must not hold back the ones after it
+				slog.ErrorContext(ctx, "failed to convert scheduled expense to expense", "scheduled_expense_id", scheduledExpense.ID.Value, "error", err)
+				continue
 			}
-			expenses = append(expenses, *exp)
 
-			scheduledExpense.UpdateLastGeneratedAt()
-			scheduledExpensesCreated = append(scheduledExpensesCreated, scheduledExpense)
+			// Occurrences registered by a previous run are skipped, so retrying never duplicates an expense.
+			registered, err := scheduledExpenseRepo.StoreOccurrences(ctx, &scheduledExpense, occurrences)
+			if err != nil {
+				// It was changed since it was read, the next run generates it as it is now
+				if errors.Is(err, ddd.ErrVersionConflict) {
+					slog.WarnContext(ctx, "scheduled expense changed while generating its expenses", "scheduled_expense_id", scheduledExpense.ID.Value)
+					continue
+				}
+				return 0, fmt.Errorf("failed to store scheduled expense occurrences: %w", err)
+			}
+
+			expensesCreated += len(registered)
 		}
 
-		if len(scheduledExpensesCreated) > 0 {
-			if err := scheduledExpenseRepo.BulkStore(ctx, scheduledExpensesCreated); err != nil {
-				return 0, fmt.Errorf("failed to store scheduled expenses: %w", err)
-			}
+		return expensesCreated, nil
+	}
+}
+
+// scheduledOccurrences builds the expenses due at the given dates, recording them as generated by
+// the scheduled expense.
+func scheduledOccurrences(expenseRepo expense.Repository, scheduledExpense *expense.ScheduledExpense, dueDates []civil.Date) ([]expense.ScheduledOccurrence, error) {
+	occurrences := make([]expense.ScheduledOccurrence, 0, len(dueDates))
+	for _, dueDate := range dueDates {
+		exp, err := scheduledExpense.ToExpense(expenseRepo.GetNextID(), dueDate)
+		if err != nil {
+			return nil, err
 		}
 
-		for _, exp := range expenses {
-			if err := publisher.Publish(ctx, pubsub.ExpensesTopic, pubsub.ExpenseEvent{
+		occurrences = append(occurrences, expense.ScheduledOccurrence{
+			DueDate: dueDate,
+			Event: outbox.NewEvent(pubsub.ExpensesTopic, pubsub.ExpenseEvent{
 				Event: pubsub.Event{
 					Type:    "expense.created",
 					GroupID: exp.GroupID,
 					UserID:  exp.PayerID,
 					SentAt:  time.Now(),
 				},
-				Expense: exp,
-			}); err != nil {
-				return 0, fmt.Errorf("failed to publish expense created event: %w", err)
-			}
-		}
+				Expense: *exp,
+			}),
+		})
 
-		return len(expenses), nil
+		scheduledExpense.UpdateLastGeneratedAt(dueDate)
 	}
+
+	return occurrences, nil
 }
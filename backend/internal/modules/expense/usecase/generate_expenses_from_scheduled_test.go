@@ -15,6 +15,8 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
@@ -23,7 +25,8 @@ func TestGenerateExpensesFromScheduled(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
-	publisher := mocks.NewMockpubsubPublisher(t)
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	expenseRepo.EXPECT().GetNextID().Return(expense.ID{Value: 10}).Maybe()
 
 	grp := group.New(group.Attributes{
 		ID:   group.ID{Value: 1},
@@ -69,7 +72,15 @@ func TestGenerateExpensesFromScheduled(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	generateExpensesFromScheduled := usecase.NewGenerateExpensesFromScheduledUseCase(scheduledExpenseRepo, publisher)
+	registerAll := func(_ context.Context, _ *expense.ScheduledExpense, occurrences []expense.ScheduledOccurrence) ([]civil.Date, error) {
+		dueDates := make([]civil.Date, 0, len(occurrences))
+		for _, occurrence := range occurrences {
+			dueDates = append(dueDates, occurrence.DueDate)
+		}
+		return dueDates, nil
+	}
+
+	generateExpensesFromScheduled := usecase.NewGenerateExpensesFromScheduledUseCase(scheduledExpenseRepo, expenseRepo, calendar.NewBrazilian())
 
 	t.Run("should return error if GetActiveScheduledExpenses fails", func(t *testing.T) {
 		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return(nil, errors.New("database error")).Once()
@@ -105,31 +116,19 @@ func TestGenerateExpensesFromScheduled(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
-	t.Run("should return error if BulkStore fails", func(t *testing.T) {
-		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*scheduledExpense}, nil).Once()
-		scheduledExpenseRepo.EXPECT().BulkStore(ctx, mock.AnythingOfType("[]expense.ScheduledExpense")).Return(errors.New("bulk store error")).Once()
-
-		expensesCreated, err := generateExpensesFromScheduled(ctx)
-		assert.Equal(t, 0, expensesCreated)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "failed to store scheduled expenses")
-	})
-
-	t.Run("should return error if Publish fails", func(t *testing.T) {
+	t.Run("should return error if StoreOccurrences fails", func(t *testing.T) {
 		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*scheduledExpense}, nil).Once()
-		scheduledExpenseRepo.EXPECT().BulkStore(ctx, mock.AnythingOfType("[]expense.ScheduledExpense")).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.ExpensesTopic, mock.AnythingOfType("pubsub.ExpenseEvent")).Return(errors.New("publish error")).Once()
+		scheduledExpenseRepo.EXPECT().StoreOccurrences(ctx, mock.Anything, mock.Anything).Return(nil, errors.New("store error")).Once()
 
 		expensesCreated, err := generateExpensesFromScheduled(ctx)
 		assert.Equal(t, 0, expensesCreated)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "failed to publish expense created event")
+		assert.Contains(t, err.Error(), "failed to store scheduled expense occurrences")
 	})
 
 	t.Run("should generate expenses successfully", func(t *testing.T) {
 		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*scheduledExpense}, nil).Once()
-		scheduledExpenseRepo.EXPECT().BulkStore(ctx, mock.AnythingOfType("[]expense.ScheduledExpense")).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.ExpensesTopic, mock.AnythingOfType("pubsub.ExpenseEvent")).Return(nil).Once()
+		scheduledExpenseRepo.EXPECT().StoreOccurrences(ctx, mock.Anything, mock.Anything).RunAndReturn(registerAll).Once()
 
 		expensesCreated, err := generateExpensesFromScheduled(ctx)
 		assert.Equal(t, 1, expensesCreated)
@@ -154,14 +153,98 @@ func TestGenerateExpensesFromScheduled(t *testing.T) {
 		assert.NoError(t, err)
 
 		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*scheduledExpense, *scheduledExpense2}, nil).Once()
-		scheduledExpenseRepo.EXPECT().BulkStore(ctx, mock.AnythingOfType("[]expense.ScheduledExpense")).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.ExpensesTopic, mock.AnythingOfType("pubsub.ExpenseEvent")).Return(nil).Times(2)
+		scheduledExpenseRepo.EXPECT().StoreOccurrences(ctx, mock.Anything, mock.Anything).RunAndReturn(registerAll).Times(2)
 
 		expensesCreated, err := generateExpensesFromScheduled(ctx)
 		assert.Equal(t, 2, expensesCreated)
 		assert.NoError(t, err)
 	})
 
+	t.Run("should catch up every missed occurrence on its due date", func(t *testing.T) {
+		missedSince := civil.DateOf(time.Now().AddDate(0, 0, -65))
+		missed, err := expense.NewScheduledExpense(expense.ScheduledExpenseAttributes{
+			ID:              expense.ScheduledExpenseID{Value: 4},
+			Name:            "missed scheduled expense",
+			Amount:          100,
+			Description:     "test description",
+			GroupID:         grp.ID,
+			CategoryID:      catgry.ID,
+			SplitType:       expense.SplitTypes.Equal,
+			PayerID:         payer.ID,
+			ReceiverID:      receiver.ID,
+			FrequencyInDays: 30,
+			LastGeneratedAt: &missedSince,
+		})
+		assert.NoError(t, err)
+		missed.CreatedAt = time.Now().AddDate(0, -6, 0)
+
+		expectedDates := []civil.Date{missedSince.AddDays(30), missedSince.AddDays(60)}
+
+		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*missed}, nil).Once()
+		scheduledExpenseRepo.EXPECT().StoreOccurrences(ctx, mock.MatchedBy(func(se *expense.ScheduledExpense) bool {
+			return *se.LastGeneratedAt == expectedDates[1] && se.OccurrenceCount == 2
+		}), mock.MatchedBy(func(occurrences []expense.ScheduledOccurrence) bool {
+			if len(occurrences) != len(expectedDates) {
+				return false
+			}
+			for i, occurrence := range occurrences {
+				event, ok := occurrence.Event.Payload.(pubsub.ExpenseEvent)
+				if !ok || occurrence.Event.Topic != pubsub.ExpensesTopic || occurrence.DueDate != expectedDates[i] ||
+					civil.DateOf(event.Expense.CreatedAt) != expectedDates[i] || event.Expense.ID != (expense.ID{Value: 10}) {
+					return false
+				}
+			}
+			return true
+		})).RunAndReturn(registerAll).Once()
+
+		expensesCreated, err := generateExpensesFromScheduled(ctx)
+		assert.Equal(t, 2, expensesCreated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not count occurrences already registered by a previous run", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*scheduledExpense}, nil).Once()
+		scheduledExpenseRepo.EXPECT().StoreOccurrences(ctx, mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		expensesCreated, err := generateExpensesFromScheduled(ctx)
+		assert.Equal(t, 0, expensesCreated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip a scheduled expense that can not be converted and go on with the others", func(t *testing.T) {
+		invalid, err := expense.NewScheduledExpense(expense.ScheduledExpenseAttributes{
+			ID:              expense.ScheduledExpenseID{Value: 5},
+			Name:            "invalid scheduled expense",
+			Amount:          100,
+			GroupID:         grp.ID,
+			CategoryID:      catgry.ID,
+			SplitType:       expense.SplitTypes.Equal,
+			PayerID:         payer.ID,
+			ReceiverID:      payer.ID,
+			FrequencyInDays: 30,
+			LastGeneratedAt: &lastGeneratedAt,
+		})
+		assert.NoError(t, err)
+
+		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*invalid, *scheduledExpense}, nil).Once()
+		scheduledExpenseRepo.EXPECT().StoreOccurrences(ctx, mock.MatchedBy(func(se *expense.ScheduledExpense) bool {
+			return se.ID == scheduledExpense.ID
+		}), mock.Anything).RunAndReturn(registerAll).Once()
+
+		expensesCreated, err := generateExpensesFromScheduled(ctx)
+		assert.Equal(t, 1, expensesCreated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip a scheduled expense changed since it was read", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{*scheduledExpense}, nil).Once()
+		scheduledExpenseRepo.EXPECT().StoreOccurrences(ctx, mock.Anything, mock.Anything).Return(nil, &ddd.VersionConflictError{CurrentVersion: 1}).Once()
+
+		expensesCreated, err := generateExpensesFromScheduled(ctx)
+		assert.Equal(t, 0, expensesCreated)
+		assert.NoError(t, err)
+	})
+
 	t.Run("should return 0 when no expenses to generate", func(t *testing.T) {
 		scheduledExpenseRepo.EXPECT().GetActiveScheduledExpenses(ctx).Return([]expense.ScheduledExpense{}, nil).Once()
 
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+)
+
+type (
+	GetAttachmentsParams struct {
+		GroupID   group.ID
+		ExpenseID expense.ID
+	}
+
+	GetAttachments func(ctx context.Context, p GetAttachmentsParams) ([]expense.Attachment, error)
+)
+
+func NewGetAttachments(expenseRepo expense.Repository, attachmentRepo expense.AttachmentRepository) GetAttachments {
+	return func(ctx context.Context, p GetAttachmentsParams) ([]expense.Attachment, error) {
+		if _, err := getGroupExpenses(ctx, expenseRepo, []expense.ID{p.ExpenseID}, p.GroupID); err != nil {
+			return nil, err
+		}
+
+		attachments, err := attachmentRepo.GetByExpenseID(ctx, p.ExpenseID)
+		if err != nil {
+			return nil, fmt.Errorf("attachmentRepo.GetByExpenseID: %w", err)
+		}
+
+		return attachments, nil
+	}
+}
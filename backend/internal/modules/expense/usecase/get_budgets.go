@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+)
+
+type (
+	GetBudgetsParams struct {
+		GroupID group.ID
+		Month   time.Time
+	}
+
+	// BudgetStatus is a budget as it stands in a month.
+	BudgetStatus struct {
+		Budget expense.Budget
+		// Limit is what can be spent in the month, the budget amount plus what was carried over.
+		Limit int
+		// CarriedOver is what was left from the months before, negative when they were overspent.
+		CarriedOver int
+	}
+
+	GetBudgets func(ctx context.Context, p GetBudgetsParams) ([]BudgetStatus, error)
+)
+
+// NewGetBudgets returns the budgets of the group that apply in the month. Budgets created after it
+// are left out.
+func NewGetBudgets(budgetRepo expense.BudgetRepository) GetBudgets {
+	return func(ctx context.Context, p GetBudgetsParams) ([]BudgetStatus, error) {
+		budgets, err := budgetRepo.GetByGroupID(ctx, p.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("budgetRepo.GetByGroupID: %w", err)
+		}
+
+		month := expense.MonthOf(p.Month)
+		statuses := make([]BudgetStatus, 0, len(budgets))
+		for _, budget := range budgets {
+			if month.Before(budget.StartMonth()) {
+				continue
+			}
+
+			limit := budget.Amount
+			if budget.CarryOver {
+				spending, err := budgetRepo.GetMonthlySpending(ctx, budget, month.AddDate(0, -1, 0))
+				if err != nil {
+					return nil, fmt.Errorf("budgetRepo.GetMonthlySpending: %w", err)
+				}
+				limit = budget.Limit(spending)
+			}
+
+			statuses = append(statuses, BudgetStatus{
+				Budget:      budget,
+				Limit:       limit,
+				CarriedOver: limit - budget.Amount,
+			})
+		}
+
+		return statuses, nil
+	}
+}
@@ -0,0 +1,72 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestGetBudgets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	budgetRepo := mocks.NewMockexpenseBudgetRepository(t)
+	getBudgets := usecase.NewGetBudgets(budgetRepo)
+
+	grp := group.ID{Value: 1}
+	createdAt := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	fixed := expense.Budget{
+		Entity:     ddd.Entity[expense.BudgetID]{ID: expense.BudgetID{Value: 1}, CreatedAt: createdAt},
+		GroupID:    grp,
+		CategoryID: &category.ID{Value: 1},
+		Amount:     10000,
+	}
+	carried := expense.Budget{
+		Entity:          ddd.Entity[expense.BudgetID]{ID: expense.BudgetID{Value: 2}, CreatedAt: createdAt},
+		GroupID:         grp,
+		CategoryGroupID: &category.GroupID{Value: 1},
+		Amount:          10000,
+		CarryOver:       true,
+	}
+	future := expense.Budget{
+		Entity:     ddd.Entity[expense.BudgetID]{ID: expense.BudgetID{Value: 3}, CreatedAt: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)},
+		GroupID:    grp,
+		CategoryID: &category.ID{Value: 2},
+		Amount:     10000,
+	}
+
+	t.Run("should return error if budgetRepo fails", func(t *testing.T) {
+		budgetRepo.EXPECT().GetByGroupID(ctx, grp).Return(nil, errors.New("test error")).Once()
+
+		result, err := getBudgets(ctx, usecase.GetBudgetsParams{GroupID: grp, Month: time.Now()})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "budgetRepo.GetByGroupID: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		budgetRepo.EXPECT().GetByGroupID(ctx, grp).Return([]expense.Budget{fixed, carried, future}, nil).Once()
+		budgetRepo.EXPECT().GetMonthlySpending(ctx, carried, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)).Return([]int{4000, 13000}, nil).Once()
+
+		result, err := getBudgets(ctx, usecase.GetBudgetsParams{GroupID: grp, Month: time.Date(2026, 5, 15, 0, 0, 0, 0, time.UTC)})
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		assert.Equal(t, fixed.ID, result[0].Budget.ID)
+		assert.Equal(t, 10000, result[0].Limit)
+		assert.Equal(t, 0, result[0].CarriedOver)
+
+		// March left 6000 over, April spent 13000 of its 16000
+		assert.Equal(t, carried.ID, result[1].Budget.ID)
+		assert.Equal(t, 13000, result[1].Limit)
+		assert.Equal(t, 3000, result[1].CarriedOver)
+	})
+}
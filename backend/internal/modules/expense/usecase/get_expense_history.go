@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetExpenseHistoryParams struct {
+		ID      expense.ID
+		GroupID group.ID
+	}
+
+	GetExpenseHistory func(ctx context.Context, p GetExpenseHistoryParams) ([]expense.Revision, error)
+)
+
+func NewGetExpenseHistory(expenseRepo expense.Repository) GetExpenseHistory {
+	return func(ctx context.Context, p GetExpenseHistoryParams) ([]expense.Revision, error) {
+		versions, err := getGroupExpenseVersions(ctx, expenseRepo, p.ID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		return expense.History(versions), nil
+	}
+}
+
+// getGroupExpenseVersions loads every version of the expense making sure it belongs to the group of the request.
+func getGroupExpenseVersions(ctx context.Context, expenseRepo expense.Repository, id expense.ID, groupID group.ID) ([]expense.Expense, error) {
+	versions, err := expenseRepo.GetVersions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("expenseRepo.GetVersions: %w", err)
+	}
+
+	if len(versions) == 0 {
+		return nil, except.NotFoundError("expense not found")
+	}
+
+	if versions[len(versions)-1].GroupID != groupID {
+		return nil, except.ForbiddenError("expense does not belong to the group")
+	}
+
+	return versions, nil
+}
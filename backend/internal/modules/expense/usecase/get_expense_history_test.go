@@ -0,0 +1,57 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestGetExpenseHistory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	getExpenseHistory := usecase.NewGetExpenseHistory(expenseRepo)
+	params := usecase.GetExpenseHistoryParams{ID: expense.ID{Value: 1}, GroupID: group.ID{Value: 1}}
+
+	t.Run("should return error if expenseRepo fails", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(nil, errors.New("test error")).Once()
+
+		revisions, err := getExpenseHistory(ctx, params)
+		assert.Nil(t, revisions)
+		assert.EqualError(t, err, "expenseRepo.GetVersions: test error")
+	})
+
+	t.Run("should return error if expense not found", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(nil, nil).Once()
+
+		revisions, err := getExpenseHistory(ctx, params)
+		assert.Nil(t, revisions)
+		assert.EqualError(t, err, "expense not found")
+	})
+
+	t.Run("should return error if expense belongs to another group", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+
+		revisions, err := getExpenseHistory(ctx, usecase.GetExpenseHistoryParams{ID: params.ID, GroupID: group.ID{Value: 2}})
+		assert.Nil(t, revisions)
+		assert.EqualError(t, err, "expense does not belong to the group")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+
+		revisions, err := getExpenseHistory(ctx, params)
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 2)
+		assert.Equal(t, &user.ID{Value: 2}, revisions[1].UpdatedBy)
+		assert.Equal(t, []expense.Change{{Field: "name", From: "Mercado", To: "Feira"}}, revisions[1].Changes)
+	})
+}
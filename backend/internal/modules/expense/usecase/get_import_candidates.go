@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetImportCandidatesParams struct {
+		GroupID group.ID
+		Status  expense.ImportCandidateStatus
+	}
+
+	GetImportCandidates func(ctx context.Context, p GetImportCandidatesParams) ([]expense.ImportCandidate, error)
+)
+
+func NewGetImportCandidates(candidateRepo expense.ImportCandidateRepository) GetImportCandidates {
+	return func(ctx context.Context, p GetImportCandidatesParams) ([]expense.ImportCandidate, error) {
+		if p.Status == "" {
+			p.Status = expense.ImportCandidateStatuses.Pending
+		}
+
+		candidates, err := candidateRepo.GetByGroupID(ctx, p.GroupID, p.Status)
+		if err != nil {
+			return nil, fmt.Errorf("candidateRepo.GetByGroupID: %w", err)
+		}
+
+		return candidates, nil
+	}
+}
+
+// getGroupImportCandidate returns the candidate, failing as not found when it belongs to
+// another group.
+func getGroupImportCandidate(ctx context.Context, candidateRepo expense.ImportCandidateRepository, id expense.ImportCandidateID, groupID group.ID) (*expense.ImportCandidate, error) {
+	candidate, err := candidateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("candidateRepo.GetByID: %w", err)
+	}
+
+	if candidate == nil || candidate.GroupID != groupID {
+		return nil, except.NotFoundError("import candidate not found")
+	}
+
+	return candidate, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	ScheduledExpenseParams struct {
+		ID      expense.ScheduledExpenseID
+		GroupID group.ID
+	}
+
+	GetScheduledExpense func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error)
+)
+
+func NewGetScheduledExpense(scheduledExpenseRepo expense.ScheduledExpenseRepository) GetScheduledExpense {
+	return func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error) {
+		return getGroupScheduledExpense(ctx, scheduledExpenseRepo, p)
+	}
+}
+
+// getGroupScheduledExpense loads the scheduled expense making sure it belongs to the group of the request.
+func getGroupScheduledExpense(ctx context.Context, scheduledExpenseRepo expense.ScheduledExpenseRepository, p ScheduledExpenseParams) (*expense.ScheduledExpense, error) {
+	scheduledExpense, err := scheduledExpenseRepo.GetByID(ctx, p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("scheduledExpenseRepo.GetByID: %w", err)
+	}
+
+	if scheduledExpense == nil {
+		return nil, except.NotFoundError("scheduled expense not found")
+	}
+
+	if scheduledExpense.GroupID != p.GroupID {
+		return nil, except.ForbiddenError("scheduled expense does not belong to the group")
+	}
+
+	return scheduledExpense, nil
+}
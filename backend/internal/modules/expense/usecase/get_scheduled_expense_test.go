@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func newScheduledExpense(t *testing.T) *expense.ScheduledExpense {
+	t.Helper()
+
+	scheduledExpense, err := expense.NewScheduledExpense(expense.ScheduledExpenseAttributes{
+		ID:              expense.ScheduledExpenseID{Value: 1},
+		Name:            "rent",
+		Amount:          1000,
+		Description:     "monthly rent",
+		GroupID:         group.ID{Value: 1},
+		CategoryID:      category.ID{Value: 1},
+		SplitType:       expense.SplitTypes.Equal,
+		PayerID:         user.ID{Value: 1},
+		ReceiverID:      user.ID{Value: 2},
+		FrequencyInDays: 30,
+	})
+	assert.NoError(t, err)
+
+	return scheduledExpense
+}
+
+func TestGetScheduledExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	getScheduledExpense := usecase.NewGetScheduledExpense(scheduledExpenseRepo)
+
+	scheduledExpense := newScheduledExpense(t)
+	params := usecase.ScheduledExpenseParams{ID: scheduledExpense.ID, GroupID: group.ID{Value: 1}}
+
+	t.Run("should return error if repository fails", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, scheduledExpense.ID).Return(nil, errors.New("test error")).Once()
+
+		result, err := getScheduledExpense(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduledExpenseRepo.GetByID: test error")
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, scheduledExpense.ID).Return(nil, nil).Once()
+
+		result, err := getScheduledExpense(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduled expense not found")
+	})
+
+	t.Run("should forbid scheduled expenses from another group", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, scheduledExpense.ID).Return(scheduledExpense, nil).Once()
+
+		result, err := getScheduledExpense(ctx, usecase.ScheduledExpenseParams{ID: scheduledExpense.ID, GroupID: group.ID{Value: 2}})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduled expense does not belong to the group")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, scheduledExpense.ID).Return(scheduledExpense, nil).Once()
+
+		result, err := getScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledExpense, result)
+	})
+}
+
+func TestGetScheduledExpenses(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	getScheduledExpenses := usecase.NewGetScheduledExpenses(scheduledExpenseRepo)
+
+	t.Run("should return error if repository fails", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByGroupID(ctx, group.ID{Value: 1}).Return(nil, errors.New("test error")).Once()
+
+		result, err := getScheduledExpenses(ctx, group.ID{Value: 1})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduledExpenseRepo.GetByGroupID: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		scheduledExpense := newScheduledExpense(t)
+		scheduledExpenseRepo.EXPECT().GetByGroupID(ctx, group.ID{Value: 1}).Return([]expense.ScheduledExpense{*scheduledExpense}, nil).Once()
+
+		result, err := getScheduledExpenses(ctx, group.ID{Value: 1})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
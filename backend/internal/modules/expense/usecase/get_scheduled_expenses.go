@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+)
+
+type GetScheduledExpenses func(ctx context.Context, groupID group.ID) ([]expense.ScheduledExpense, error)
+
+func NewGetScheduledExpenses(scheduledExpenseRepo expense.ScheduledExpenseRepository) GetScheduledExpenses {
+	return func(ctx context.Context, groupID group.ID) ([]expense.ScheduledExpense, error) {
+		scheduledExpenses, err := scheduledExpenseRepo.GetByGroupID(ctx, groupID)
+		if err != nil {
+			return nil, fmt.Errorf("scheduledExpenseRepo.GetByGroupID: %w", err)
+		}
+
+		return scheduledExpenses, nil
+	}
+}
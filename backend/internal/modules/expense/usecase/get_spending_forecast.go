@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+)
+
+type (
+	// CategorySpending is what the group spent in a category in a month.
+	CategorySpending struct {
+		Month           time.Time
+		CategoryID      category.ID
+		CategoryGroupID category.GroupID
+		Amount          int
+	}
+
+	GetSpendingForecastParams struct {
+		GroupID group.ID
+		Date    time.Time
+		// Spending covers the month of Date up to it and the expense.ForecastTrailingMonths before it.
+		Spending []CategorySpending
+	}
+
+	GetSpendingForecast func(ctx context.Context, p GetSpendingForecastParams) (*expense.SpendingForecast, error)
+)
+
+// NewGetSpendingForecast projects the spending of the month per category group. Categories with an
+// active scheduled expense are projected from what is still scheduled for the month, the others
+// from their trailing average.
+func NewGetSpendingForecast(
+	scheduledExpenseRepo expense.ScheduledExpenseRepository,
+	categoryRepo category.Repository,
+	userRepo user.Repository,
+	incomeRepo income.Repository,
+	cal calendar.Calendar,
+) GetSpendingForecast {
+	return func(ctx context.Context, p GetSpendingForecastParams) (*expense.SpendingForecast, error) {
+		month := expense.MonthOf(p.Date)
+
+		scheduled, scheduledCategories, err := scheduledThisMonth(ctx, scheduledExpenseRepo, categoryRepo, cal, p.GroupID, month)
+		if err != nil {
+			return nil, err
+		}
+
+		trailingStart := month.AddDate(0, -expense.ForecastTrailingMonths, 0)
+		spent, trailing := map[category.GroupID]int{}, map[category.GroupID]int{}
+		for _, spending := range p.Spending {
+			switch spendingMonth := expense.MonthOf(spending.Month); {
+			case spendingMonth.Equal(month):
+				spent[spending.CategoryGroupID] += spending.Amount
+			case !spendingMonth.Before(trailingStart) && spendingMonth.Before(month):
+				if !scheduledCategories[spending.CategoryID] {
+					trailing[spending.CategoryGroupID] += spending.Amount
+				}
+			}
+		}
+
+		categoryGroups := make([]category.GroupID, 0, len(spent)+len(scheduled)+len(trailing))
+		for _, amounts := range []map[category.GroupID]int{spent, scheduled, trailing} {
+			for id := range amounts {
+				if !slices.Contains(categoryGroups, id) {
+					categoryGroups = append(categoryGroups, id)
+				}
+			}
+		}
+		slices.SortFunc(categoryGroups, func(a, b category.GroupID) int { return a.Value - b.Value })
+
+		elapsed := expense.MonthElapsed(p.Date)
+		forecast := &expense.SpendingForecast{
+			Month:          month,
+			CategoryGroups: make([]expense.CategoryGroupForecast, 0, len(categoryGroups)),
+		}
+		for _, id := range categoryGroups {
+			forecast.CategoryGroups = append(forecast.CategoryGroups, expense.ProjectCategoryGroup(id, spent[id], scheduled[id], trailing[id], elapsed))
+		}
+
+		members, err := userRepo.GetByGroupID(ctx, p.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("userRepo.GetByGroupID: %w", err)
+		}
+
+		for _, member := range members {
+			incomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, member.ID, &month)
+			if err != nil {
+				return nil, fmt.Errorf("incomeRepo.GetUserMonthlyIncomes: %w", err)
+			}
+
+			for _, incm := range incomes {
+				forecast.Incomes += incm.Amount
+			}
+		}
+
+		return forecast, nil
+	}
+}
+
+// scheduledThisMonth sums, per category group, the occurrences of the active scheduled expenses of
+// the group that are due in the month and were not generated yet. It also returns the categories
+// those scheduled expenses are in.
+func scheduledThisMonth(
+	ctx context.Context,
+	scheduledExpenseRepo expense.ScheduledExpenseRepository,
+	categoryRepo category.Repository,
+	cal calendar.Calendar,
+	groupID group.ID,
+	month time.Time,
+) (map[category.GroupID]int, map[category.ID]bool, error) {
+	scheduledExpenses, err := scheduledExpenseRepo.GetByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("scheduledExpenseRepo.GetByGroupID: %w", err)
+	}
+
+	firstDay := civil.DateOf(month)
+	lastDay := civil.DateOf(month.AddDate(0, 1, -1))
+
+	scheduled, scheduledCategories := map[category.GroupID]int{}, map[category.ID]bool{}
+	categoryGroups := map[category.ID]category.GroupID{}
+	for _, scheduledExpense := range scheduledExpenses {
+		if !scheduledExpense.IsActive {
+			continue
+		}
+		scheduledCategories[scheduledExpense.CategoryID] = true
+
+		categoryGroupID, ok := categoryGroups[scheduledExpense.CategoryID]
+		if !ok {
+			cat, err := categoryRepo.GetByID(ctx, scheduledExpense.CategoryID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("categoryRepo.GetByID: %w", err)
+			}
+
+			if cat == nil {
+				continue
+			}
+
+			categoryGroupID = cat.GroupCategoryID
+			categoryGroups[scheduledExpense.CategoryID] = categoryGroupID
+		}
+
+		for _, dueDate := range scheduledExpense.DueOccurrences(cal, lastDay) {
+			if !dueDate.Before(firstDay) {
+				scheduled[categoryGroupID] += scheduledExpense.Amount
+			}
+		}
+	}
+
+	return scheduled, scheduledCategories, nil
+}
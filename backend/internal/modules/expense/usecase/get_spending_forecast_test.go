@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestGetSpendingForecast(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	categoryRepo := mocks.NewMockcategoryRepository(t)
+	userRepo := mocks.NewMockuserRepository(t)
+	incomeRepo := mocks.NewMockincomeRepository(t)
+	getSpendingForecast := usecase.NewGetSpendingForecast(scheduledExpenseRepo, categoryRepo, userRepo, incomeRepo, calendar.NewBrazilian())
+
+	grp := group.ID{Value: 1}
+	june := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	month := func(m time.Month) time.Time { return time.Date(2026, m, 1, 0, 0, 0, 0, time.UTC) }
+	params := usecase.GetSpendingForecastParams{
+		GroupID: grp,
+		// A third of June has passed
+		Date: time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC),
+		Spending: []usecase.CategorySpending{
+			{Month: june, CategoryID: category.ID{Value: 1}, CategoryGroupID: category.GroupID{Value: 1}, Amount: 5000},
+			{Month: june, CategoryID: category.ID{Value: 2}, CategoryGroupID: category.GroupID{Value: 1}, Amount: 3000},
+			{Month: june, CategoryID: category.ID{Value: 4}, CategoryGroupID: category.GroupID{Value: 2}, Amount: 1000},
+			// Category 1 is scheduled, so its past months do not make the average
+			{Month: month(time.March), CategoryID: category.ID{Value: 1}, CategoryGroupID: category.GroupID{Value: 1}, Amount: 9999},
+			{Month: month(time.March), CategoryID: category.ID{Value: 2}, CategoryGroupID: category.GroupID{Value: 1}, Amount: 6000},
+			{Month: month(time.April), CategoryID: category.ID{Value: 2}, CategoryGroupID: category.GroupID{Value: 1}, Amount: 3000},
+			{Month: month(time.April), CategoryID: category.ID{Value: 4}, CategoryGroupID: category.GroupID{Value: 2}, Amount: 4500},
+			// Out of the trailing months
+			{Month: month(time.February), CategoryID: category.ID{Value: 4}, CategoryGroupID: category.GroupID{Value: 2}, Amount: 99999},
+		},
+	}
+
+	t.Run("should return error if scheduledExpenseRepo fails", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByGroupID(ctx, grp).Return(nil, errors.New("test error")).Once()
+
+		forecast, err := getSpendingForecast(ctx, params)
+		assert.Nil(t, forecast)
+		assert.EqualError(t, err, "scheduledExpenseRepo.GetByGroupID: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		// Due every ten days, on the 15th and on the 25th of June
+		rent := newScheduledExpense(t)
+		rent.FrequencyInDays = 10
+		rent.CreatedAt = time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+		last := civil.Date{Year: 2026, Month: time.June, Day: 5}
+		rent.LastGeneratedAt = &last
+		paused := newScheduledExpense(t)
+		paused.CategoryID = category.ID{Value: 4}
+		paused.Deactivate()
+		scheduledExpenseRepo.EXPECT().GetByGroupID(ctx, grp).Return([]expense.ScheduledExpense{*rent, *paused}, nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, category.ID{Value: 1}).
+			Return(category.New(category.Attributes{ID: category.ID{Value: 1}, CategoryGroupID: category.GroupID{Value: 1}}), nil).Once()
+
+		userRepo.EXPECT().GetByGroupID(ctx, grp).Return([]user.User{
+			{Entity: ddd.Entity[user.ID]{ID: user.ID{Value: 1}}},
+			{Entity: ddd.Entity[user.ID]{ID: user.ID{Value: 2}}},
+		}, nil).Once()
+		incomeRepo.EXPECT().GetUserMonthlyIncomes(ctx, user.ID{Value: 1}, mock.Anything).
+			Return([]income.Income{{Amount: 30000}, {Amount: 5000}}, nil).Once()
+		incomeRepo.EXPECT().GetUserMonthlyIncomes(ctx, user.ID{Value: 2}, mock.Anything).
+			Return([]income.Income{{Amount: 20000}}, nil).Once()
+
+		forecast, err := getSpendingForecast(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, june, forecast.Month)
+		assert.Equal(t, []expense.CategoryGroupForecast{
+			{CategoryGroupID: category.GroupID{Value: 1}, Spent: 8000, Scheduled: 2000, Discretionary: 2000, Projected: 12000},
+			{CategoryGroupID: category.GroupID{Value: 2}, Spent: 1000, Scheduled: 0, Discretionary: 1000, Projected: 2000},
+		}, forecast.CategoryGroups)
+		assert.Equal(t, 55000, forecast.Incomes)
+		assert.Equal(t, 41000, forecast.ProjectedSavings())
+	})
+}
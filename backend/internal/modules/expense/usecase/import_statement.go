@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/bankstatement"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/service"
+)
+
+type (
+	ImportStatementParams struct {
+		GroupID   group.ID
+		UserID    user.ID
+		Layout    bankstatement.Layout
+		Statement io.Reader
+	}
+
+	ImportStatementResult struct {
+		// Candidates are the transactions queued for review.
+		Candidates []expense.ImportCandidate
+		// Duplicated counts the transactions queued by a previous import, and Ignored the money
+		// that came into the account, which is not an expense.
+		Duplicated int
+		Ignored    int
+	}
+
+	ImportStatement func(ctx context.Context, p ImportStatementParams) (*ImportStatementResult, error)
+)
+
+// NewImportStatement queues the expenses of a bank statement for review, suggesting their
+// categories. Suggestions are best effort: once the prediction service fails the remaining
+// transactions are queued without one.
+func NewImportStatement(candidateRepo expense.ImportCandidateRepository, predicter service.Predicter) ImportStatement {
+	return func(ctx context.Context, p ImportStatementParams) (*ImportStatementResult, error) {
+		transactions, err := bankstatement.Parse(p.Statement, p.Layout)
+		if err != nil {
+			if errors.Is(err, bankstatement.ErrInvalidStatement) || errors.Is(err, bankstatement.ErrUnknownLayout) {
+				return nil, except.UnprocessableEntityError("invalid statement").SetInternal(err)
+			}
+			return nil, fmt.Errorf("bankstatement.Parse: %w", err)
+		}
+
+		result := &ImportStatementResult{}
+		candidates := make([]expense.ImportCandidate, 0, len(transactions))
+		predict := true
+		for _, transaction := range transactions {
+			if transaction.Amount >= 0 {
+				result.Ignored++
+				continue
+			}
+
+			amount := -transaction.Amount
+
+			var categoryID *category.ID
+			if predict {
+				predicted, err := predicter.ExpenseCategory(ctx, transaction.Description, amount)
+				if err != nil {
+					slog.WarnContext(ctx, "failed to suggest the category of imported expenses", slog.String("error", err.Error()))
+					predict = false
+				} else if predicted != 0 {
+					categoryID = &category.ID{Value: predicted}
+				}
+			}
+
+			candidate, err := expense.NewImportCandidate(expense.ImportCandidateAttributes{
+				ID:         candidateRepo.GetNextID(),
+				GroupID:    p.GroupID,
+				Source:     string(p.Layout),
+				ExternalID: transaction.ID,
+				Name:       transaction.Description,
+				Amount:     amount,
+				Date:       transaction.Date,
+				CategoryID: categoryID,
+				ImportedBy: p.UserID,
+			})
+			if err != nil {
+				return nil, except.UnprocessableEntityError("invalid statement").SetInternal(fmt.Errorf("expense.NewImportCandidate: %w", err))
+			}
+
+			candidates = append(candidates, *candidate)
+		}
+
+		stored, err := candidateRepo.StoreNew(ctx, candidates)
+		if err != nil {
+			return nil, fmt.Errorf("candidateRepo.StoreNew: %w", err)
+		}
+
+		result.Candidates = stored
+		result.Duplicated = len(candidates) - len(stored)
+
+		return result, nil
+	}
+}
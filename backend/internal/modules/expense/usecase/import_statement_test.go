@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/bankstatement"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestImportStatement(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	candidateRepo := mocks.NewMockexpenseImportCandidateRepository(t)
+	predicter := mocks.NewMockservicePredicter(t)
+	importStatement := usecase.NewImportStatement(candidateRepo, predicter)
+
+	statement := "date,title,amount\n2025-01-07,Padaria,52.90\n2025-01-08,Pagamento recebido,-300.00\n2025-01-09,Uber,18.00\n"
+	params := func() usecase.ImportStatementParams {
+		return usecase.ImportStatementParams{
+			GroupID:   group.ID{Value: 1},
+			UserID:    user.ID{Value: 2},
+			Layout:    bankstatement.Layouts.Nubank,
+			Statement: strings.NewReader(statement),
+		}
+	}
+
+	t.Run("should return error if the statement is invalid", func(t *testing.T) {
+		p := params()
+		p.Layout = bankstatement.Layouts.Inter
+
+		result, err := importStatement(ctx, p)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "invalid statement: internal=invalid statement: no known header found")
+	})
+
+	t.Run("should return error if candidateRepo.StoreNew fails", func(t *testing.T) {
+		candidateRepo.EXPECT().GetNextID().Return(expense.ImportCandidateID{Value: 1}).Twice()
+		predicter.EXPECT().ExpenseCategory(ctx, mock.Anything, mock.Anything).Return(3, nil).Twice()
+		candidateRepo.EXPECT().StoreNew(ctx, mock.Anything).Return(nil, errors.New("test error")).Once()
+
+		result, err := importStatement(ctx, params())
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "candidateRepo.StoreNew: test error")
+	})
+
+	t.Run("should queue the expenses with the suggested categories", func(t *testing.T) {
+		candidateRepo.EXPECT().GetNextID().Return(expense.ImportCandidateID{Value: 1}).Once()
+		candidateRepo.EXPECT().GetNextID().Return(expense.ImportCandidateID{Value: 2}).Once()
+		predicter.EXPECT().ExpenseCategory(ctx, "Padaria", 5290).Return(3, nil).Once()
+		predicter.EXPECT().ExpenseCategory(ctx, "Uber", 1800).Return(4, nil).Once()
+		candidateRepo.EXPECT().StoreNew(ctx, mock.Anything).RunAndReturn(func(_ context.Context, candidates []expense.ImportCandidate) ([]expense.ImportCandidate, error) {
+			// The second transaction was queued by a previous import
+			return candidates[:1], nil
+		}).Once()
+
+		result, err := importStatement(ctx, params())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Duplicated)
+		assert.Equal(t, 1, result.Ignored)
+		assert.Len(t, result.Candidates, 1)
+
+		candidate := result.Candidates[0]
+		assert.Equal(t, "Padaria", candidate.Name)
+		assert.Equal(t, 5290, candidate.Amount)
+		assert.Equal(t, civil.Date{Year: 2025, Month: 1, Day: 7}, candidate.Date)
+		assert.Equal(t, &category.ID{Value: 3}, candidate.CategoryID)
+		assert.Equal(t, "nubank", candidate.Source)
+		assert.NotEmpty(t, candidate.ExternalID)
+		assert.Equal(t, expense.ImportCandidateStatuses.Pending, candidate.Status)
+		assert.Equal(t, user.ID{Value: 2}, candidate.ImportedBy)
+	})
+
+	t.Run("should stop suggesting categories once the prediction fails", func(t *testing.T) {
+		candidateRepo.EXPECT().GetNextID().Return(expense.ImportCandidateID{Value: 1}).Twice()
+		predicter.EXPECT().ExpenseCategory(ctx, "Padaria", 5290).Return(0, errors.New("unavailable")).Once()
+		candidateRepo.EXPECT().StoreNew(ctx, mock.MatchedBy(func(candidates []expense.ImportCandidate) bool {
+			return len(candidates) == 2 && candidates[0].CategoryID == nil && candidates[1].CategoryID == nil
+		})).RunAndReturn(func(_ context.Context, candidates []expense.ImportCandidate) ([]expense.ImportCandidate, error) {
+			return candidates, nil
+		}).Once()
+
+		result, err := importStatement(ctx, params())
+		assert.NoError(t, err)
+		assert.Len(t, result.Candidates, 2)
+	})
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+)
+
+// newInstallments creates one expense per month for a purchase paid in installments. The
+// amount and the split in attr refer to the whole purchase.
+func newInstallments(expenseRepo expense.Repository, attr expense.Attributes, total int) ([]expense.Expense, error) {
+	createdAt := time.Now()
+	if attr.CreatedAt != nil {
+		createdAt = *attr.CreatedAt
+	}
+
+	purchaseID := uuid.NewString()
+	splitRatio := attr.SplitRatio
+	installments := make([]expense.Expense, 0, total)
+	for i, amount := range expense.SplitInstallments(attr.Amount, total) {
+		number := i + 1
+		date := expense.InstallmentDate(createdAt, number)
+
+		attr.ID = expenseRepo.GetNextID()
+		attr.Amount = amount
+		attr.SplitRatio = splitRatio.ScaleTo(attr.SplitType, amount)
+		attr.CreatedAt = &date
+		attr.Installment = &expense.Installment{PurchaseID: purchaseID, Number: number, Total: total}
+
+		installment, err := expense.New(attr)
+		if err != nil {
+			return nil, err
+		}
+
+		installments = append(installments, *installment)
+	}
+
+	return installments, nil
+}
+
+// laterInstallments returns the installments of the same purchase that come after expns.
+func laterInstallments(ctx context.Context, expenseRepo expense.Repository, expns *expense.Expense) ([]expense.Expense, error) {
+	if expns.Installment == nil {
+		return nil, nil
+	}
+
+	installments, err := expenseRepo.GetByPurchaseID(ctx, expns.Installment.PurchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("expenseRepo.GetByPurchaseID: %w", err)
+	}
+
+	var later []expense.Expense
+	for _, installment := range installments {
+		if installment.Installment.Number > expns.Installment.Number {
+			later = append(later, installment)
+		}
+	}
+
+	return later, nil
+}
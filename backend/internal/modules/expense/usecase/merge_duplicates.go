@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	MergeDuplicatesParams struct {
+		GroupID group.ID
+		// KeepID is the expense that stays, DuplicateIDs the ones that were entered again.
+		KeepID       expense.ID
+		DuplicateIDs []expense.ID
+		UserID       *user.ID
+	}
+
+	MergeDuplicates func(ctx context.Context, p MergeDuplicatesParams) (*expense.Expense, error)
+)
+
+// NewMergeDuplicates resolves a duplicate cluster by keeping one of its expenses and sending the
+// others to the trash, from where they can still be restored.
+func NewMergeDuplicates(
+	expenseRepo expense.Repository,
+	deleteExpense DeleteExpense,
+	unitOfWork db.UnitOfWork,
+) MergeDuplicates {
+	return func(ctx context.Context, p MergeDuplicatesParams) (*expense.Expense, error) {
+		if len(p.DuplicateIDs) == 0 {
+			return nil, except.UnprocessableEntityError("missing duplicates")
+		}
+
+		if slices.Contains(p.DuplicateIDs, p.KeepID) {
+			return nil, except.UnprocessableEntityError("expense kept cannot be one of its duplicates")
+		}
+
+		expenses, err := getGroupExpenses(ctx, expenseRepo, append([]expense.ID{p.KeepID}, p.DuplicateIDs...), p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := unitOfWork(ctx, func(ctx context.Context) error {
+			for _, id := range p.DuplicateIDs {
+				if _, err := deleteExpense(ctx, id, p.UserID); err != nil {
+					return fmt.Errorf("DeleteExpense: %w", err)
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		return &expenses[0], nil
+	}
+}
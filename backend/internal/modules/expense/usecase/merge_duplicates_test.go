@@ -0,0 +1,110 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func newGroupExpense(id int, groupID int) *expense.Expense {
+	expns := &expense.Expense{Name: "Mercado", Amount: 15890, GroupID: group.ID{Value: groupID}}
+	expns.ID = expense.ID{Value: id}
+	return expns
+}
+
+func TestMergeDuplicates(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	deleteExpense := mocks.NewMockusecaseDeleteExpense(t)
+	mergeDuplicates := usecase.NewMergeDuplicates(expenseRepo, deleteExpense.Execute, dbtest.UnitOfWork)
+	userID := &user.ID{Value: 7}
+
+	t.Run("should return error if the kept expense is one of the duplicates", func(t *testing.T) {
+		kept, err := mergeDuplicates(ctx, usecase.MergeDuplicatesParams{
+			GroupID:      group.ID{Value: 1},
+			KeepID:       expense.ID{Value: 1},
+			DuplicateIDs: []expense.ID{{Value: 2}, {Value: 1}},
+		})
+		assert.Nil(t, kept)
+		assert.EqualError(t, err, "expense kept cannot be one of its duplicates")
+	})
+
+	t.Run("should return error if an expense belongs to another group", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 1}).Return(newGroupExpense(1, 1), nil).Once()
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 2}).Return(newGroupExpense(2, 2), nil).Once()
+
+		kept, err := mergeDuplicates(ctx, usecase.MergeDuplicatesParams{
+			GroupID:      group.ID{Value: 1},
+			KeepID:       expense.ID{Value: 1},
+			DuplicateIDs: []expense.ID{{Value: 2}},
+		})
+		assert.Nil(t, kept)
+		assert.EqualError(t, err, "expense 2 not found")
+	})
+
+	t.Run("should return error if DeleteExpense fails", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 1}).Return(newGroupExpense(1, 1), nil).Once()
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 2}).Return(newGroupExpense(2, 1), nil).Once()
+		deleteExpense.EXPECT().Execute(ctx, expense.ID{Value: 2}, userID).Return(nil, errors.New("test error")).Once()
+
+		kept, err := mergeDuplicates(ctx, usecase.MergeDuplicatesParams{
+			GroupID:      group.ID{Value: 1},
+			KeepID:       expense.ID{Value: 1},
+			DuplicateIDs: []expense.ID{{Value: 2}},
+			UserID:       userID,
+		})
+		assert.Nil(t, kept)
+		assert.EqualError(t, err, "DeleteExpense: test error")
+	})
+
+	t.Run("should keep one expense and send the others to the trash", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 1}).Return(newGroupExpense(1, 1), nil).Once()
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 2}).Return(newGroupExpense(2, 1), nil).Once()
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 3}).Return(newGroupExpense(3, 1), nil).Once()
+		deleteExpense.EXPECT().Execute(ctx, expense.ID{Value: 2}, userID).Return(newGroupExpense(2, 1), nil).Once()
+		deleteExpense.EXPECT().Execute(ctx, expense.ID{Value: 3}, userID).Return(newGroupExpense(3, 1), nil).Once()
+
+		kept, err := mergeDuplicates(ctx, usecase.MergeDuplicatesParams{
+			GroupID:      group.ID{Value: 1},
+			KeepID:       expense.ID{Value: 1},
+			DuplicateIDs: []expense.ID{{Value: 2}, {Value: 3}},
+			UserID:       userID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, expense.ID{Value: 1}, kept.ID)
+	})
+}
+
+func TestDismissDuplicates(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	dismissDuplicates := usecase.NewDismissDuplicates(expenseRepo)
+	ids := []expense.ID{{Value: 1}, {Value: 2}}
+
+	t.Run("should return error if an expense is not found", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 1}).Return(nil, nil).Once()
+
+		err := dismissDuplicates(ctx, usecase.DismissDuplicatesParams{GroupID: group.ID{Value: 1}, ExpenseIDs: ids})
+		assert.EqualError(t, err, "expense 1 not found")
+	})
+
+	t.Run("should dismiss the expenses as duplicates of each other", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 1}).Return(newGroupExpense(1, 1), nil).Once()
+		expenseRepo.EXPECT().GetByID(ctx, expense.ID{Value: 2}).Return(newGroupExpense(2, 1), nil).Once()
+		expenseRepo.EXPECT().DismissDuplicates(ctx, group.ID{Value: 1}, ids).Return(nil).Once()
+
+		err := dismissDuplicates(ctx, usecase.DismissDuplicatesParams{GroupID: group.ID{Value: 1}, ExpenseIDs: ids})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	vo "github.com/Beigelman/nossas-despesas/internal/pkg/email"
+	"github.com/Beigelman/nossas-despesas/internal/shared/service"
+)
+
+type (
+	NotifyBudgetAlertInput struct {
+		GroupID group.ID
+		Alert   expense.BudgetAlert
+	}
+
+	NotifyBudgetAlert func(ctx context.Context, input NotifyBudgetAlertInput) error
+)
+
+// NewNotifyBudgetAlert emails every member of the group that a budget reached an alert threshold.
+func NewNotifyBudgetAlert(
+	userRepo user.Repository,
+	categoryRepo category.Repository,
+	categoryGroupRepo category.GroupRepository,
+	emailProvider service.EmailProvider,
+) NotifyBudgetAlert {
+	return func(ctx context.Context, input NotifyBudgetAlertInput) error {
+		name, err := budgetScopeName(ctx, categoryRepo, categoryGroupRepo, input.Alert)
+		if err != nil {
+			return err
+		}
+
+		members, err := userRepo.GetByGroupID(ctx, input.GroupID)
+		if err != nil {
+			return fmt.Errorf("userRepo.GetByGroupID: %w", err)
+		}
+
+		var to []string
+		for _, member := range members {
+			to = append(to, member.Email)
+		}
+
+		if len(to) == 0 {
+			return nil
+		}
+
+		tmpl, err := template.ParseFiles("./templates/budget_alert.html")
+		if err != nil {
+			return fmt.Errorf("template.ParseFiles: %w", err)
+		}
+
+		html := strings.Builder{}
+		if err := tmpl.Execute(&html, map[string]any{
+			"Name":      name,
+			"Month":     input.Alert.Month.Format("01/2006"),
+			"Threshold": input.Alert.Threshold,
+			"Spent":     formatCents(input.Alert.Spent),
+			"Limit":     formatCents(input.Alert.Limit),
+		}); err != nil {
+			return fmt.Errorf("tmpl.Execute: %w", err)
+		}
+
+		subject := fmt.Sprintf("%s atingiu %d%% do orçamento", name, input.Alert.Threshold)
+		if err := emailProvider.Send(ctx, vo.Email{
+			From:    "noreplay@nossasdespesas.com.br",
+			To:      to,
+			Html:    html.String(),
+			Subject: subject,
+		}); err != nil {
+			return fmt.Errorf("emailProvider.Send: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func budgetScopeName(
+	ctx context.Context,
+	categoryRepo category.Repository,
+	categoryGroupRepo category.GroupRepository,
+	alert expense.BudgetAlert,
+) (string, error) {
+	if alert.CategoryID != nil {
+		cat, err := categoryRepo.GetByID(ctx, *alert.CategoryID)
+		if err != nil {
+			return "", fmt.Errorf("categoryRepo.GetByID: %w", err)
+		}
+
+		if cat == nil {
+			return "", fmt.Errorf("category %d not found", alert.CategoryID.Value)
+		}
+
+		return cat.Name, nil
+	}
+
+	categoryGroup, err := categoryGroupRepo.GetByID(ctx, *alert.CategoryGroupID)
+	if err != nil {
+		return "", fmt.Errorf("categoryGroupRepo.GetByID: %w", err)
+	}
+
+	if categoryGroup == nil {
+		return "", fmt.Errorf("category group %d not found", alert.CategoryGroupID.Value)
+	}
+
+	return categoryGroup.Name, nil
+}
+
+// formatCents writes an amount in cents as Brazilian reais, e.g. R$ 1234,50.
+func formatCents(cents int) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%sR$ %d,%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+// participantsOf returns who an expense is split among. When no participants are
+// informed the expense is shared between its payer and receiver.
+func participantsOf(payerID, receiverID user.ID, participants []user.ID) []user.ID {
+	if len(participants) > 0 {
+		return participants
+	}
+
+	return []user.ID{payerID, receiverID}
+}
+
+// counterpartOf returns the first participant that is not the payer.
+func counterpartOf(payerID user.ID, participants []user.ID) user.ID {
+	for _, participant := range participants {
+		if participant != payerID {
+			return participant
+		}
+	}
+
+	return payerID
+}
+
+// checkParticipants makes sure every participant exists and belongs to the group.
+// Users listed in known were already checked by the caller.
+func checkParticipants(ctx context.Context, userRepo user.Repository, groupID group.ID, participants []user.ID, known ...user.ID) error {
+	for _, participantID := range participants {
+		if slices.Contains(known, participantID) {
+			continue
+		}
+
+		participant, err := userRepo.GetByID(ctx, participantID)
+		if err != nil {
+			return fmt.Errorf("userRepo.GetByID: %w", err)
+		}
+
+		if participant == nil {
+			return except.NotFoundError("participant not found")
+		}
+
+		if participant.GroupID == nil || groupID != *participant.GroupID {
+			return except.UnprocessableEntityError("group mismatch")
+		}
+	}
+
+	return nil
+}
+
+// getParticipantsIncomes returns the total monthly income of each participant. It returns nil
+// if any of them has not registered an income for the month.
+func getParticipantsIncomes(ctx context.Context, incomeRepo income.Repository, participants []user.ID, date *time.Time) (map[user.ID]int, error) {
+	incomes := make(map[user.ID]int, len(participants))
+	for _, participant := range participants {
+		userIncomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, participant, date)
+		if err != nil {
+			return nil, fmt.Errorf("incomeRepo.GetUserMonthlyIncomes: %w", err)
+		}
+
+		if userIncomes == nil {
+			return nil, nil
+		}
+
+		total := 0
+		for _, incm := range userIncomes {
+			total += incm.Amount
+		}
+
+		incomes[participant] = total
+	}
+
+	return incomes, nil
+}
+
+// sameParticipants reports whether both lists hold the same users, regardless of order.
+func sameParticipants(a, b []user.ID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, participant := range a {
+		if !slices.Contains(b, participant) {
+			return false
+		}
+	}
+
+	return true
+}
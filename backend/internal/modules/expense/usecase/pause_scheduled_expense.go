@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+)
+
+type PauseScheduledExpense func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error)
+
+func NewPauseScheduledExpense(scheduledExpenseRepo expense.ScheduledExpenseRepository) PauseScheduledExpense {
+	return func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error) {
+		scheduledExpense, err := getGroupScheduledExpense(ctx, scheduledExpenseRepo, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if !scheduledExpense.IsActive {
+			return scheduledExpense, nil
+		}
+
+		scheduledExpense.Deactivate()
+
+		if err := scheduledExpenseRepo.Store(ctx, scheduledExpense); err != nil {
+			return nil, fmt.Errorf("scheduledExpenseRepo.Store: %w", err)
+		}
+
+		return scheduledExpense, nil
+	}
+}
@@ -0,0 +1,53 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestPauseScheduledExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	pauseScheduledExpense := usecase.NewPauseScheduledExpense(scheduledExpenseRepo)
+	params := usecase.ScheduledExpenseParams{ID: expense.ScheduledExpenseID{Value: 1}, GroupID: group.ID{Value: 1}}
+
+	t.Run("should return error if store fails", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(newScheduledExpense(t), nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+
+		result, err := pauseScheduledExpense(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduledExpenseRepo.Store: test error")
+	})
+
+	t.Run("should not store an already paused scheduled expense", func(t *testing.T) {
+		paused := newScheduledExpense(t)
+		paused.Deactivate()
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(paused, nil).Once()
+
+		result, err := pauseScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.False(t, result.IsActive)
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(newScheduledExpense(t), nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.MatchedBy(func(se *expense.ScheduledExpense) bool {
+			return !se.IsActive
+		})).Return(nil).Once()
+
+		result, err := pauseScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.False(t, result.IsActive)
+	})
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+)
+
+type (
+	PreviewScheduledExpenseParams struct {
+		ScheduledExpenseParams
+		Count int
+	}
+
+	PreviewScheduledExpense func(ctx context.Context, p PreviewScheduledExpenseParams) ([]civil.Date, error)
+)
+
+func NewPreviewScheduledExpense(scheduledExpenseRepo expense.ScheduledExpenseRepository, cal calendar.Calendar) PreviewScheduledExpense {
+	return func(ctx context.Context, p PreviewScheduledExpenseParams) ([]civil.Date, error) {
+		scheduledExpense, err := getGroupScheduledExpense(ctx, scheduledExpenseRepo, p.ScheduledExpenseParams)
+		if err != nil {
+			return nil, err
+		}
+
+		if !scheduledExpense.IsActive {
+			return []civil.Date{}, nil
+		}
+
+		return scheduledExpense.NextOccurrences(cal, p.Count), nil
+	}
+}
@@ -0,0 +1,49 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestPreviewScheduledExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	previewScheduledExpense := usecase.NewPreviewScheduledExpense(scheduledExpenseRepo, calendar.NewBrazilian())
+	params := usecase.PreviewScheduledExpenseParams{
+		ScheduledExpenseParams: usecase.ScheduledExpenseParams{ID: expense.ScheduledExpenseID{Value: 1}, GroupID: group.ID{Value: 1}},
+		Count:                  3,
+	}
+
+	t.Run("should return no occurrences for a paused scheduled expense", func(t *testing.T) {
+		paused := newScheduledExpense(t)
+		paused.Deactivate()
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(paused, nil).Once()
+
+		occurrences, err := previewScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.Empty(t, occurrences)
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		scheduledExpense := newScheduledExpense(t)
+		last := civil.Date{Year: 2025, Month: time.January, Day: 10}
+		scheduledExpense.CreatedAt = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		scheduledExpense.LastGeneratedAt = &last
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(scheduledExpense, nil).Once()
+
+		occurrences, err := previewScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, []civil.Date{last.AddDays(30), last.AddDays(60), last.AddDays(90)}, occurrences)
+	})
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/shared/service"
+)
+
+type PurgeDeletedExpenses func(ctx context.Context, deletedBefore time.Time) (int, error)
+
+// NewPurgeDeletedExpenses removes the files of the attachments only once their expenses are gone.
+func NewPurgeDeletedExpenses(expenseRepo expense.Repository, fileStorage service.FileStorage) PurgeDeletedExpenses {
+	return func(ctx context.Context, deletedBefore time.Time) (int, error) {
+		purged, attachments, err := expenseRepo.PurgeDeleted(ctx, deletedBefore)
+		if err != nil {
+			return 0, fmt.Errorf("expenseRepo.PurgeDeleted: %w", err)
+		}
+
+		for _, attachment := range attachments {
+			deleteAttachmentFiles(ctx, fileStorage, attachment)
+		}
+
+		return purged, nil
+	}
+}
@@ -45,24 +45,27 @@ func NewRecalculateExpensesSplitRatio(
 			return nil
 		}
 
-		usersIDs := []user.ID{proportionalExpenses[0].PayerID, proportionalExpenses[0].ReceiverID}
 		usersIncomes := map[user.ID]int{}
-		for _, userID := range usersIDs {
-			incomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, userID, &input.Date)
-			if err != nil || incomes == nil {
-				return fmt.Errorf("no incomes found for user %d", userID.Value)
-			}
+		for i, expns := range proportionalExpenses {
+			for _, userID := range expns.Participants() {
+				if _, ok := usersIncomes[userID]; ok {
+					continue
+				}
 
-			totalIncome := 0
-			for _, incm := range incomes {
-				totalIncome += incm.Amount
-			}
+				incomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, userID, &input.Date)
+				if err != nil || incomes == nil {
+					return fmt.Errorf("no incomes found for user %d", userID.Value)
+				}
 
-			usersIncomes[userID] = totalIncome
-		}
+				totalIncome := 0
+				for _, incm := range incomes {
+					totalIncome += incm.Amount
+				}
 
-		for i, expns := range proportionalExpenses {
-			newSplitRatio := expense.NewProportionalSplitRatio(usersIncomes[expns.PayerID], usersIncomes[expns.ReceiverID])
+				usersIncomes[userID] = totalIncome
+			}
+
+			newSplitRatio := expense.NewProportionalSplitRatio(expns.Participants(), usersIncomes)
 			if err := proportionalExpenses[i].Update(expense.UpdateAttributes{SplitRatio: &newSplitRatio}); err != nil {
 				return fmt.Errorf("proportionalExpenses[%d]: %w", i, err)
 			}
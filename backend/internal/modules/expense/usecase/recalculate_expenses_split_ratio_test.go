@@ -63,7 +63,7 @@ func TestRecalculateExpensesSplitRatio(t *testing.T) {
 		SplitType:   expense.SplitTypes.Proportional,
 		PayerID:     payer.ID,
 		ReceiverID:  receiver.ID,
-		SplitRatio:  expense.NewProportionalSplitRatio(6000, 4000), // 60% payer, 40% receiver
+		SplitRatio:  expense.NewProportionalSplitRatio([]user.ID{payer.ID, receiver.ID}, map[user.ID]int{payer.ID: 6000, receiver.ID: 4000}), // 60% payer, 40% receiver
 		CreatedAt:   &date,
 	})
 	assert.NoError(t, err)
@@ -78,7 +78,7 @@ func TestRecalculateExpensesSplitRatio(t *testing.T) {
 		SplitType:   expense.SplitTypes.Equal,
 		PayerID:     payer.ID,
 		ReceiverID:  receiver.ID,
-		SplitRatio:  expense.NewEqualSplitRatio(),
+		SplitRatio:  expense.NewEqualSplitRatio(payer.ID, receiver.ID),
 		CreatedAt:   &date,
 	})
 	assert.NoError(t, err)
@@ -219,7 +219,7 @@ func TestRecalculateExpensesSplitRatio(t *testing.T) {
 			SplitType:   expense.SplitTypes.Proportional,
 			PayerID:     payer.ID,
 			ReceiverID:  receiver.ID,
-			SplitRatio:  expense.NewProportionalSplitRatio(6000, 4000),
+			SplitRatio:  expense.NewProportionalSplitRatio([]user.ID{payer.ID, receiver.ID}, map[user.ID]int{payer.ID: 6000, receiver.ID: 4000}),
 			CreatedAt:   &date,
 		})
 		assert.NoError(t, err)
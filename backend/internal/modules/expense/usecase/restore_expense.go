@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	RestoreExpenseParams struct {
+		ID      expense.ID
+		GroupID group.ID
+		UserID  *user.ID
+	}
+
+	RestoreExpense func(ctx context.Context, p RestoreExpenseParams) (*expense.Expense, error)
+)
+
+// NewRestoreExpense takes an expense out of the trash. Installments deleted together with it
+// stay in the trash and must be restored one by one.
+func NewRestoreExpense(expenseRepo expense.Repository) RestoreExpense {
+	return func(ctx context.Context, p RestoreExpenseParams) (*expense.Expense, error) {
+		versions, err := getGroupExpenseVersions(ctx, expenseRepo, p.ID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		latest := versions[len(versions)-1]
+		if latest.DeletedAt == nil {
+			return nil, except.UnprocessableEntityError("expense is not deleted")
+		}
+
+		latest.Restore(p.UserID)
+
+		if err := expenseRepo.Store(ctx, &latest); err != nil {
+			return nil, fmt.Errorf("expenseRepo.Store: %w", err)
+		}
+
+		return &latest, nil
+	}
+}
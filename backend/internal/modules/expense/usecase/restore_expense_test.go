@@ -0,0 +1,118 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestRestoreExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	restoreExpense := usecase.NewRestoreExpense(expenseRepo)
+	author := user.ID{Value: 2}
+	params := usecase.RestoreExpenseParams{
+		ID:      expense.ID{Value: 1},
+		GroupID: group.ID{Value: 1},
+		UserID:  &author,
+	}
+
+	deletedVersions := func(t *testing.T) []expense.Expense {
+		versions := expenseVersions(t)
+		deleted := versions[1]
+		deleted.Delete(&user.ID{Value: 1})
+		return append(versions, deleted)
+	}
+
+	t.Run("should return error if expense does not exist", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(nil, nil).Once()
+
+		expns, err := restoreExpense(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "expense not found")
+	})
+
+	t.Run("should return error if expense belongs to another group", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(deletedVersions(t), nil).Once()
+
+		other := params
+		other.GroupID = group.ID{Value: 2}
+		expns, err := restoreExpense(ctx, other)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "expense does not belong to the group")
+	})
+
+	t.Run("should return error if expense is not deleted", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+
+		expns, err := restoreExpense(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "expense is not deleted")
+	})
+
+	t.Run("should return error if expenseRepo.Store fails", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(deletedVersions(t), nil).Once()
+		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+
+		expns, err := restoreExpense(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "expenseRepo.Store: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(deletedVersions(t), nil).Once()
+		expenseRepo.EXPECT().Store(ctx, mock.MatchedBy(func(e *expense.Expense) bool {
+			return e.Version == 3 && e.DeletedAt == nil && *e.UpdatedBy == author && e.Name == "Feira"
+		})).Return(nil).Once()
+
+		expns, err := restoreExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.Nil(t, expns.DeletedAt)
+		assert.Equal(t, 3, expns.Version)
+	})
+}
+
+func TestPurgeDeletedExpenses(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	fileStorage := mocks.NewMockserviceFileStorage(t)
+	purgeDeletedExpenses := usecase.NewPurgeDeletedExpenses(expenseRepo, fileStorage)
+	before := time.Date(2026, 9, 18, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should return error if expenseRepo.PurgeDeleted fails", func(t *testing.T) {
+		expenseRepo.EXPECT().PurgeDeleted(ctx, before).Return(0, nil, errors.New("test error")).Once()
+
+		purged, err := purgeDeletedExpenses(ctx, before)
+		assert.Zero(t, purged)
+		assert.EqualError(t, err, "expenseRepo.PurgeDeleted: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		thumbnailKey := "attachments/1/1/5-thumbnail"
+		attachments := []expense.Attachment{
+			{StorageKey: "attachments/1/1/5", ThumbnailKey: &thumbnailKey},
+			{StorageKey: "attachments/1/2/6"},
+		}
+		expenseRepo.EXPECT().PurgeDeleted(ctx, before).Return(3, attachments, nil).Once()
+		fileStorage.EXPECT().Delete(ctx, "attachments/1/1/5").Return(nil).Once()
+		fileStorage.EXPECT().Delete(ctx, thumbnailKey).Return(nil).Once()
+		// A file that can't be removed is left behind without failing the purge
+		fileStorage.EXPECT().Delete(ctx, "attachments/1/2/6").Return(errors.New("storage error")).Once()
+
+		purged, err := purgeDeletedExpenses(ctx, before)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, purged)
+	})
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+)
+
+type ResumeScheduledExpense func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error)
+
+func NewResumeScheduledExpense(
+	scheduledExpenseRepo expense.ScheduledExpenseRepository,
+	cal calendar.Calendar,
+) ResumeScheduledExpense {
+	return func(ctx context.Context, p ScheduledExpenseParams) (*expense.ScheduledExpense, error) {
+		scheduledExpense, err := getGroupScheduledExpense(ctx, scheduledExpenseRepo, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if scheduledExpense.IsActive {
+			return scheduledExpense, nil
+		}
+
+		scheduledExpense.Activate(cal, civil.DateOf(time.Now()))
+
+		if err := scheduledExpenseRepo.Store(ctx, scheduledExpense); err != nil {
+			return nil, fmt.Errorf("scheduledExpenseRepo.Store: %w", err)
+		}
+
+		return scheduledExpense, nil
+	}
+}
@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestResumeScheduledExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	resumeScheduledExpense := usecase.NewResumeScheduledExpense(scheduledExpenseRepo, calendar.NewBrazilian())
+	params := usecase.ScheduledExpenseParams{ID: expense.ScheduledExpenseID{Value: 1}, GroupID: group.ID{Value: 1}}
+
+	t.Run("should return error if scheduled expense is not found", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(nil, nil).Once()
+
+		result, err := resumeScheduledExpense(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduled expense not found")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		paused := newScheduledExpense(t)
+		paused.Deactivate()
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(paused, nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.MatchedBy(func(se *expense.ScheduledExpense) bool {
+			return se.IsActive
+		})).Return(nil).Once()
+
+		result, err := resumeScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.True(t, result.IsActive)
+	})
+	t.Run("should skip the occurrences due while paused", func(t *testing.T) {
+		paused := newScheduledExpense(t)
+		paused.CreatedAt = time.Now().AddDate(0, 0, -95)
+		paused.Deactivate()
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, params.ID).Return(paused, nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		result, err := resumeScheduledExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result.OccurrenceCount)
+		assert.Empty(t, result.DueOccurrences(calendar.NewBrazilian(), civil.DateOf(time.Now())))
+	})
+}
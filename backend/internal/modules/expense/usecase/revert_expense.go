@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	RevertExpenseParams struct {
+		ID      expense.ID
+		GroupID group.ID
+		// ToVersion is the version whose contents are copied into the new one.
+		ToVersion int
+		// Version, when set, is the version the client last read. The revert fails if it is stale.
+		Version *int
+		UserID  *user.ID
+	}
+
+	RevertExpense func(ctx context.Context, p RevertExpenseParams) (*expense.Expense, error)
+)
+
+func NewRevertExpense(expenseRepo expense.Repository) RevertExpense {
+	return func(ctx context.Context, p RevertExpenseParams) (*expense.Expense, error) {
+		versions, err := getGroupExpenseVersions(ctx, expenseRepo, p.ID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		latest := versions[len(versions)-1]
+		if latest.DeletedAt != nil {
+			return nil, except.NotFoundError("expense not found")
+		}
+
+		if err := latest.CheckVersion(p.Version); err != nil {
+			return nil, err
+		}
+
+		var target *expense.Expense
+		for i := range versions {
+			if versions[i].Version == p.ToVersion {
+				target = &versions[i]
+				break
+			}
+		}
+
+		if target == nil {
+			return nil, except.NotFoundError("version not found")
+		}
+
+		if target.Version == latest.Version {
+			return nil, except.UnprocessableEntityError("expense is already on this version")
+		}
+
+		if target.DeletedAt != nil {
+			return nil, except.UnprocessableEntityError("cannot revert to a deleted version")
+		}
+
+		if err := latest.Revert(*target, p.UserID); err != nil {
+			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("expense.Revert: %w", err))
+		}
+
+		if err := expenseRepo.Store(ctx, &latest); err != nil {
+			return nil, fmt.Errorf("expenseRepo.Store: %w", err)
+		}
+
+		return &latest, nil
+	}
+}
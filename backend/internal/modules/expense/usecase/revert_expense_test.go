@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+// expenseVersions builds the versions of an expense renamed once by the second participant.
+func expenseVersions(t *testing.T) []expense.Expense {
+	t.Helper()
+	original, err := expense.New(expense.Attributes{
+		ID:         expense.ID{Value: 1},
+		Name:       "Mercado",
+		Amount:     100,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 1},
+		SplitRatio: expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
+		SplitType:  expense.SplitTypes.Equal,
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+		CreatedBy:  &user.ID{Value: 1},
+	})
+	assert.NoError(t, err)
+
+	renamed := *original
+	name := "Feira"
+	assert.NoError(t, renamed.Update(expense.UpdateAttributes{Name: &name, UpdatedBy: &user.ID{Value: 2}}))
+
+	return []expense.Expense{*original, renamed}
+}
+
+func TestRevertExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	revertExpense := usecase.NewRevertExpense(expenseRepo)
+	author := user.ID{Value: 1}
+	params := usecase.RevertExpenseParams{
+		ID:        expense.ID{Value: 1},
+		GroupID:   group.ID{Value: 1},
+		ToVersion: 0,
+		UserID:    &author,
+	}
+
+	t.Run("should return error if expense is deleted", func(t *testing.T) {
+		versions := expenseVersions(t)
+		versions[1].Delete(&author)
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(versions, nil).Once()
+
+		expns, err := revertExpense(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "expense not found")
+	})
+
+	t.Run("should return a version conflict if the expense changed", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+
+		stale := params
+		stale.Version = func() *int { v := 0; return &v }()
+		expns, err := revertExpense(ctx, stale)
+		assert.Nil(t, expns)
+		assert.ErrorIs(t, err, ddd.ErrVersionConflict)
+	})
+
+	t.Run("should return error if version does not exist", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+
+		missing := params
+		missing.ToVersion = 5
+		expns, err := revertExpense(ctx, missing)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "version not found")
+	})
+
+	t.Run("should return error if expense is already on the version", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+
+		latest := params
+		latest.ToVersion = 1
+		expns, err := revertExpense(ctx, latest)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "expense is already on this version")
+	})
+
+	t.Run("should return error if expenseRepo.Store fails", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+
+		expns, err := revertExpense(ctx, params)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "expenseRepo.Store: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		expenseRepo.EXPECT().GetVersions(ctx, params.ID).Return(expenseVersions(t), nil).Once()
+		expenseRepo.EXPECT().Store(ctx, mock.MatchedBy(func(e *expense.Expense) bool {
+			return e.Version == 2 && e.Name == "Mercado" && *e.UpdatedBy == author
+		})).Return(nil).Once()
+
+		expns, err := revertExpense(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, expns.Version)
+		assert.Equal(t, "Mercado", expns.Name)
+	})
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+// checkTags makes sure every tag exists and belongs to the group. A tag of another group is
+// reported as not found, like a missing one.
+func checkTags(ctx context.Context, tagRepo tag.Repository, groupID group.ID, tagIDs []tag.ID) error {
+	for _, tagID := range tagIDs {
+		tg, err := tagRepo.GetByID(ctx, tagID)
+		if err != nil {
+			return fmt.Errorf("tagRepo.GetByID: %w", err)
+		}
+
+		if tg == nil || tg.GroupID != groupID {
+			return except.NotFoundError(fmt.Sprintf("tag %d not found", tagID.Value))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	BudgetParams struct {
+		ID      expense.BudgetID
+		GroupID group.ID
+	}
+
+	UpdateBudgetParams struct {
+		ID        expense.BudgetID
+		GroupID   group.ID
+		Amount    *int
+		CarryOver *bool
+	}
+
+	UpdateBudget func(ctx context.Context, p UpdateBudgetParams) (*expense.Budget, error)
+)
+
+func NewUpdateBudget(budgetRepo expense.BudgetRepository) UpdateBudget {
+	return func(ctx context.Context, p UpdateBudgetParams) (*expense.Budget, error) {
+		budget, err := getGroupBudget(ctx, budgetRepo, BudgetParams{ID: p.ID, GroupID: p.GroupID})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := budget.Update(expense.UpdateBudgetAttributes{
+			Amount:    p.Amount,
+			CarryOver: p.CarryOver,
+		}); err != nil {
+			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("budget.Update: %w", err))
+		}
+
+		if err := budgetRepo.Store(ctx, budget); err != nil {
+			return nil, fmt.Errorf("budgetRepo.Store: %w", err)
+		}
+
+		return budget, nil
+	}
+}
+
+func getGroupBudget(ctx context.Context, budgetRepo expense.BudgetRepository, p BudgetParams) (*expense.Budget, error) {
+	budget, err := budgetRepo.GetByID(ctx, p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("budgetRepo.GetByID: %w", err)
+	}
+
+	if budget == nil {
+		return nil, except.NotFoundError("budget not found")
+	}
+
+	if budget.GroupID != p.GroupID {
+		return nil, except.ForbiddenError("budget does not belong to the group")
+	}
+
+	return budget, nil
+}
@@ -3,11 +3,13 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/category"
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 )
@@ -23,7 +25,14 @@ type (
 		SplitType    *expense.SplitType
 		PayerID      *user.ID
 		ReceiverID   *user.ID
-		CreatedAt    *time.Time
+		Participants []user.ID
+		Shares       []expense.Share
+		// TagIDs, when set, replaces the tags of the expense. An empty list removes them all.
+		TagIDs    *[]tag.ID
+		CreatedAt *time.Time
+		// Version, when set, is the version the client last read. The update fails if it is stale.
+		Version *int
+		UserID  *user.ID
 	}
 	UpdateExpense func(ctx context.Context, p UpdateExpenseParams) (*expense.Expense, error)
 )
@@ -33,6 +42,7 @@ func NewUpdateExpense(
 	userRepo user.Repository,
 	categoryRepo category.Repository,
 	incomeRepo income.Repository,
+	tagRepo tag.Repository,
 ) UpdateExpense {
 	return func(ctx context.Context, p UpdateExpenseParams) (*expense.Expense, error) {
 		expns, err := expenseRepo.GetByID(ctx, p.ID)
@@ -44,6 +54,10 @@ func NewUpdateExpense(
 			return nil, except.NotFoundError("expense not found")
 		}
 
+		if err := expns.CheckVersion(p.Version); err != nil {
+			return nil, err
+		}
+
 		if p.PayerID != nil {
 			payer, err := userRepo.GetByID(ctx, *p.PayerID)
 			if err != nil {
@@ -85,53 +99,92 @@ func NewUpdateExpense(
 			}
 		}
 
+		if p.TagIDs != nil {
+			if err := checkTags(ctx, tagRepo, expns.GroupID, *p.TagIDs); err != nil {
+				return nil, err
+			}
+		}
+
+		payerID := expns.PayerID
+		if p.PayerID != nil {
+			payerID = *p.PayerID
+		}
+
+		receiverID := expns.ReceiverID
+		if p.ReceiverID != nil {
+			receiverID = *p.ReceiverID
+		}
+
+		splitType := expns.SplitType
+		if p.SplitType != nil {
+			splitType = *p.SplitType
+		}
+
+		amount := expns.Amount
+		if p.Amount != nil {
+			amount = *p.Amount
+		}
+
+		participants := expns.Participants()
+		switch {
+		case len(p.Shares) > 0:
+			participants = expense.SplitRatio{Shares: p.Shares}.Participants()
+		case len(p.Participants) > 0:
+			participants = p.Participants
+		case len(participants) <= 2 && (payerID != expns.PayerID || receiverID != expns.ReceiverID):
+			participants = participantsOf(payerID, receiverID, nil)
+		}
+
+		if !slices.Contains(participants, receiverID) {
+			receiverID = counterpartOf(payerID, participants)
+		}
+
+		if err := checkParticipants(ctx, userRepo, expns.GroupID, participants, expns.Participants()...); err != nil {
+			return nil, err
+		}
+
+		participantsChanged := !sameParticipants(participants, expns.Participants())
+		if splitType.IsCustom() && len(p.Shares) == 0 && (splitType != expns.SplitType || participantsChanged) {
+			return nil, except.UnprocessableEntityError("missing split shares")
+		}
+
 		var splitRatio *expense.SplitRatio
-		if p.SplitType != nil && *p.SplitType != expns.SplitType {
-			switch *p.SplitType {
+		roleChanged := payerID != expns.PayerID || receiverID != expns.ReceiverID
+		if splitType != expns.SplitType || participantsChanged || len(p.Shares) > 0 ||
+			(splitType == expense.SplitTypes.Transfer && roleChanged) ||
+			(splitType == expense.SplitTypes.Weighted && amount != expns.Amount) {
+			var split expense.SplitRatio
+			switch splitType {
 			case expense.SplitTypes.Proportional:
 				createdAt := &expns.CreatedAt
 				if p.CreatedAt != nil {
 					createdAt = p.CreatedAt
 				}
 
-				payerID := expns.PayerID
-				if p.PayerID != nil {
-					payerID = *p.PayerID
-				}
-
-				payerIncomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, payerID, createdAt)
-				if err != nil || payerIncomes == nil {
-					return nil, except.UnprocessableEntityError("payer income not found").SetInternal(fmt.Errorf("incomeRepo.GetUserMonthlyIncomes: %w", err))
-				}
-
-				receiverID := expns.ReceiverID
-				if p.ReceiverID != nil {
-					receiverID = *p.ReceiverID
-				}
-				receiverIncomes, err := incomeRepo.GetUserMonthlyIncomes(ctx, receiverID, createdAt)
-				if err != nil || receiverIncomes == nil {
-					return nil, except.UnprocessableEntityError("receiver income not found").SetInternal(fmt.Errorf("incomeRepo.GetUserMonthlyIncomes: %w", err))
+				incomes, err := getParticipantsIncomes(ctx, incomeRepo, participants, createdAt)
+				if err != nil {
+					return nil, err
 				}
 
-				totalPayerIncome := 0
-				for _, incm := range payerIncomes {
-					totalPayerIncome += incm.Amount
+				if incomes == nil {
+					return nil, except.UnprocessableEntityError("participant income not found")
 				}
 
-				totalReceiverIncome := 0
-				for _, incm := range receiverIncomes {
-					totalReceiverIncome += incm.Amount
-				}
-
-				split := expense.NewProportionalSplitRatio(totalPayerIncome, totalReceiverIncome)
-				splitRatio = &split
+				split = expense.NewProportionalSplitRatio(participants, incomes)
 			case expense.SplitTypes.Transfer:
-				split := expense.NewTransferRatio()
-				splitRatio = &split
+				split = expense.NewTransferRatio(payerID, receiverID)
+			case expense.SplitTypes.Exact:
+				split = expense.NewExactSplitRatio(p.Shares)
+			case expense.SplitTypes.Weighted:
+				shares := p.Shares
+				if len(shares) == 0 {
+					shares = expns.SplitRatio.Shares
+				}
+				split = expense.NewWeightedSplitRatio(amount, shares)
 			default:
-				split := expense.NewEqualSplitRatio()
-				splitRatio = &split
+				split = expense.NewEqualSplitRatio(participants...)
 			}
+			splitRatio = &split
 		}
 
 		if err := expns.Update(expense.UpdateAttributes{
@@ -143,14 +196,57 @@ func NewUpdateExpense(
 			SplitRatio:   splitRatio,
 			SplitType:    p.SplitType,
 			PayerID:      p.PayerID,
-			ReceiverID:   p.ReceiverID,
+			ReceiverID:   &receiverID,
+			TagIDs:       p.TagIDs,
 			CreatedAt:    p.CreatedAt,
+			UpdatedBy:    p.UserID,
 		}); err != nil {
 			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("expense.Update: %w", err))
 		}
 
-		if err := expenseRepo.Store(ctx, expns); err != nil {
-			return nil, fmt.Errorf("expenseRepo.Store: %w", err)
+		if expns.Installment == nil {
+			if err := expenseRepo.Store(ctx, expns); err != nil {
+				return nil, fmt.Errorf("expenseRepo.Store: %w", err)
+			}
+
+			return expns, nil
+		}
+
+		later, err := laterInstallments(ctx, expenseRepo, expns)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range later {
+			installment := &later[i]
+			var split *expense.SplitRatio
+			if splitRatio != nil || (p.Amount != nil && expns.SplitType.IsCustom()) {
+				amount := installment.Amount
+				if p.Amount != nil {
+					amount = *p.Amount
+				}
+				scaled := expns.SplitRatio.ScaleTo(expns.SplitType, amount)
+				split = &scaled
+			}
+
+			if err := installment.Update(expense.UpdateAttributes{
+				Name:        p.Name,
+				Amount:      p.Amount,
+				Description: p.Description,
+				CategoryID:  p.CategoryID,
+				SplitRatio:  split,
+				SplitType:   p.SplitType,
+				PayerID:     p.PayerID,
+				ReceiverID:  &receiverID,
+				TagIDs:      p.TagIDs,
+				UpdatedBy:   p.UserID,
+			}); err != nil {
+				return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("expense.Update: %w", err))
+			}
+		}
+
+		if err := expenseRepo.BulkStore(ctx, append([]expense.Expense{*expns}, later...)); err != nil {
+			return nil, fmt.Errorf("expenseRepo.BulkStore: %w", err)
 		}
 
 		return expns, nil
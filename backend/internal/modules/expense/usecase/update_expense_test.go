@@ -13,7 +13,9 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
 
@@ -24,6 +26,7 @@ func TestUpdateExpense(t *testing.T) {
 	categoryRepo := mocks.NewMockcategoryRepository(t)
 	expenseRepo := mocks.NewMockexpenseRepository(t)
 	incomeRepo := mocks.NewMockincomeRepository(t)
+	tagRepo := mocks.NewMocktagRepository(t)
 
 	grp := group.New(group.Attributes{
 		ID:   group.ID{Value: 1},
@@ -54,6 +57,14 @@ func TestUpdateExpense(t *testing.T) {
 		GroupID:        &group.ID{Value: 2},
 	})
 
+	participant := user.New(user.Attributes{
+		ID:             user.ID{Value: 3},
+		Name:           "participant",
+		Email:          "participant@email.com",
+		ProfilePicture: nil,
+		GroupID:        &grp.ID,
+	})
+
 	catgry := category.New(category.Attributes{
 		ID:   category.ID{Value: 1},
 		Name: "test category",
@@ -67,13 +78,13 @@ func TestUpdateExpense(t *testing.T) {
 		Description: "description",
 		GroupID:     grp.ID,
 		CategoryID:  catgry.ID,
-		SplitRatio:  expense.NewEqualSplitRatio(),
+		SplitRatio:  expense.NewEqualSplitRatio(payer.ID, receiver.ID),
 		PayerID:     payer.ID,
 		ReceiverID:  receiver.ID,
 	})
 	assert.Nil(t, err)
 
-	updateExpense := usecase.NewUpdateExpense(expenseRepo, userRepo, categoryRepo, incomeRepo)
+	updateExpense := usecase.NewUpdateExpense(expenseRepo, userRepo, categoryRepo, incomeRepo, tagRepo)
 
 	t.Run("should return error if expenseRepo fails", func(t *testing.T) {
 		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(nil, errors.New("test error")).Once()
@@ -107,6 +118,23 @@ func TestUpdateExpense(t *testing.T) {
 		assert.EqualError(t, err, "expense not found")
 	})
 
+	t.Run("should return a version conflict if the expense changed", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
+
+		stale := expns.Version + 1
+		p := usecase.UpdateExpenseParams{
+			ID:      expns.ID,
+			Name:    &expns.Name,
+			Version: &stale,
+		}
+
+		res, err := updateExpense(ctx, p)
+		assert.Nil(t, res)
+		var conflict *ddd.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+		assert.Equal(t, expns.Version, conflict.CurrentVersion)
+	})
+
 	t.Run("should return error userRepo fails", func(t *testing.T) {
 		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
 		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(nil, errors.New("test error")).Once()
@@ -264,7 +292,7 @@ func TestUpdateExpense(t *testing.T) {
 		assert.Equal(t, newDescription, expns.Description)
 		assert.Equal(t, grp.ID, expns.GroupID)
 		assert.Equal(t, catgry.ID, expns.CategoryID)
-		assert.Equal(t, expense.NewProportionalSplitRatio(60, 40), expns.SplitRatio)
+		assert.Equal(t, expense.NewProportionalSplitRatio([]user.ID{payer.ID, receiver.ID}, map[user.ID]int{payer.ID: 60, receiver.ID: 40}), expns.SplitRatio)
 		assert.Equal(t, payer.ID, expns.PayerID)
 		assert.Equal(t, receiver.ID, expns.ReceiverID)
 		assert.Nil(t, err)
@@ -298,9 +326,144 @@ func TestUpdateExpense(t *testing.T) {
 		assert.Equal(t, newDescription, expns.Description)
 		assert.Equal(t, grp.ID, expns.GroupID)
 		assert.Equal(t, catgry.ID, expns.CategoryID)
-		assert.Equal(t, expense.NewTransferRatio(), expns.SplitRatio)
+		assert.Equal(t, expense.NewTransferRatio(payer.ID, receiver.ID), expns.SplitRatio)
 		assert.Equal(t, payer.ID, expns.PayerID)
 		assert.Equal(t, receiver.ID, expns.ReceiverID)
 		assert.Nil(t, err)
 	})
+
+	t.Run("happy path adding a participant", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, participant.ID).Return(participant, nil).Once()
+		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		p := usecase.UpdateExpenseParams{
+			ID:           expns.ID,
+			SplitType:    &expense.SplitTypes.Equal,
+			Participants: []user.ID{payer.ID, receiver.ID, participant.ID},
+		}
+
+		expns, err := updateExpense(ctx, p)
+		assert.Nil(t, err)
+		assert.Equal(t, expense.NewEqualSplitRatio(payer.ID, receiver.ID, participant.ID), expns.SplitRatio)
+		assert.Equal(t, payer.ID, expns.PayerID)
+		assert.Equal(t, receiver.ID, expns.ReceiverID)
+	})
+
+	t.Run("should return error when switching to a custom split without shares", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
+
+		p := usecase.UpdateExpenseParams{
+			ID:        expns.ID,
+			SplitType: &expense.SplitTypes.Exact,
+		}
+
+		expns, err := updateExpense(ctx, p)
+		assert.Nil(t, expns)
+		assert.EqualError(t, err, "missing split shares")
+	})
+
+	t.Run("happy path with weighted split keeping the weights when amount changes", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Times(2)
+		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Times(2)
+
+		expns, err := updateExpense(ctx, usecase.UpdateExpenseParams{
+			ID:        expns.ID,
+			SplitType: &expense.SplitTypes.Weighted,
+			Shares: []expense.Share{
+				{UserID: payer.ID, Weight: 2},
+				{UserID: receiver.ID, Weight: 1},
+			},
+		})
+		assert.Nil(t, err)
+
+		newAmount := 100
+		expns, err = updateExpense(ctx, usecase.UpdateExpenseParams{
+			ID:     expns.ID,
+			Amount: &newAmount,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expense.SplitRatio{
+			Shares: []expense.Share{
+				{UserID: payer.ID, Percent: 67, Amount: 67, Weight: 2},
+				{UserID: receiver.ID, Percent: 33, Amount: 33, Weight: 1},
+			},
+		}, expns.SplitRatio)
+	})
+
+	t.Run("should propagate changes to the following installments", func(t *testing.T) {
+		var installments []expense.Expense
+		for number := 1; number <= 3; number++ {
+			installment, err := expense.New(expense.Attributes{
+				ID:          expense.ID{Value: 10 + number},
+				Name:        "name",
+				Amount:      100,
+				GroupID:     grp.ID,
+				CategoryID:  catgry.ID,
+				SplitType:   expense.SplitTypes.Equal,
+				SplitRatio:  expense.NewEqualSplitRatio(payer.ID, receiver.ID),
+				PayerID:     payer.ID,
+				ReceiverID:  receiver.ID,
+				Installment: &expense.Installment{PurchaseID: "purchase", Number: number, Total: 3},
+			})
+			assert.Nil(t, err)
+			installments = append(installments, *installment)
+		}
+
+		second := installments[1]
+		expenseRepo.EXPECT().GetByID(ctx, second.ID).Return(&second, nil).Once()
+		expenseRepo.EXPECT().GetByPurchaseID(ctx, "purchase").Return(installments, nil).Once()
+		expenseRepo.EXPECT().BulkStore(ctx, mock.MatchedBy(func(expenses []expense.Expense) bool {
+			return len(expenses) == 2 &&
+				expenses[0].ID == second.ID && expenses[0].Name == "new name" &&
+				expenses[1].ID == installments[2].ID && expenses[1].Name == "new name" &&
+				expenses[1].CreatedAt.Equal(installments[2].CreatedAt)
+		})).Return(nil).Once()
+
+		newName := "new name"
+		expns, err := updateExpense(ctx, usecase.UpdateExpenseParams{
+			ID:   second.ID,
+			Name: &newName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, newName, expns.Name)
+	})
+
+	t.Run("should return error if a tag is not found", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 9}).Return(nil, nil).Once()
+
+		tagIDs := []tag.ID{{Value: 9}}
+		res, err := updateExpense(ctx, usecase.UpdateExpenseParams{
+			ID:     expns.ID,
+			TagIDs: &tagIDs,
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "tag 9 not found")
+	})
+
+	t.Run("happy path replacing the tags", func(t *testing.T) {
+		trip, _ := tag.New(tag.Attributes{ID: tag.ID{Value: 3}, GroupID: grp.ID, Name: "viagem"})
+		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, trip.ID).Return(trip, nil).Once()
+		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		tagIDs := []tag.ID{trip.ID}
+		res, err := updateExpense(ctx, usecase.UpdateExpenseParams{
+			ID:     expns.ID,
+			TagIDs: &tagIDs,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []tag.ID{trip.ID}, res.TagIDs)
+
+		expenseRepo.EXPECT().GetByID(ctx, expns.ID).Return(expns, nil).Once()
+		expenseRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		res, err = updateExpense(ctx, usecase.UpdateExpenseParams{
+			ID:     expns.ID,
+			TagIDs: &[]tag.ID{},
+		})
+		assert.Nil(t, err)
+		assert.Empty(t, res.TagIDs)
+	})
 }
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/civil"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	UpdateImportCandidateParams struct {
+		ID         expense.ImportCandidateID
+		GroupID    group.ID
+		Name       *string
+		Amount     *int
+		Date       *civil.Date
+		CategoryID *category.ID
+	}
+
+	UpdateImportCandidate func(ctx context.Context, p UpdateImportCandidateParams) (*expense.ImportCandidate, error)
+)
+
+func NewUpdateImportCandidate(candidateRepo expense.ImportCandidateRepository, categoryRepo category.Repository) UpdateImportCandidate {
+	return func(ctx context.Context, p UpdateImportCandidateParams) (*expense.ImportCandidate, error) {
+		candidate, err := getGroupImportCandidate(ctx, candidateRepo, p.ID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.CategoryID != nil {
+			cat, err := categoryRepo.GetByID(ctx, *p.CategoryID)
+			if err != nil {
+				return nil, fmt.Errorf("categoryRepo.GetByID: %w", err)
+			}
+
+			if cat == nil {
+				return nil, except.NotFoundError("category not found")
+			}
+		}
+
+		if err := candidate.Update(expense.ImportCandidateUpdateAttributes{
+			Name:       p.Name,
+			Amount:     p.Amount,
+			Date:       p.Date,
+			CategoryID: p.CategoryID,
+		}); err != nil {
+			if errors.Is(err, expense.ErrImportCandidateReviewed) {
+				return nil, except.UnprocessableEntityError(err.Error())
+			}
+			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("candidate.Update: %w", err))
+		}
+
+		if err := candidateRepo.Store(ctx, candidate); err != nil {
+			return nil, fmt.Errorf("candidateRepo.Store: %w", err)
+		}
+
+		return candidate, nil
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	UpdateScheduledExpenseParams struct {
+		ID              expense.ScheduledExpenseID
+		GroupID         group.ID
+		Name            *string
+		Amount          *int
+		Description     *string
+		CategoryID      *category.ID
+		SplitType       *expense.SplitType
+		PayerID         *user.ID
+		ReceiverID      *user.ID
+		FrequencyInDays *int
+		Recurrence      *expense.Recurrence
+	}
+
+	UpdateScheduledExpense func(ctx context.Context, p UpdateScheduledExpenseParams) (*expense.ScheduledExpense, error)
+)
+
+func NewUpdateScheduledExpense(
+	scheduledExpenseRepo expense.ScheduledExpenseRepository,
+	userRepo user.Repository,
+	categoryRepo category.Repository,
+) UpdateScheduledExpense {
+	return func(ctx context.Context, p UpdateScheduledExpenseParams) (*expense.ScheduledExpense, error) {
+		scheduledExpense, err := getGroupScheduledExpense(ctx, scheduledExpenseRepo, ScheduledExpenseParams{ID: p.ID, GroupID: p.GroupID})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, userID := range []*user.ID{p.PayerID, p.ReceiverID} {
+			if userID == nil {
+				continue
+			}
+
+			usr, err := userRepo.GetByID(ctx, *userID)
+			if err != nil {
+				return nil, fmt.Errorf("userRepo.GetByID: %w", err)
+			}
+
+			if usr == nil {
+				return nil, except.NotFoundError("user not found")
+			}
+
+			if usr.GroupID == nil || *usr.GroupID != scheduledExpense.GroupID {
+				return nil, except.UnprocessableEntityError("group mismatch")
+			}
+		}
+
+		if p.CategoryID != nil {
+			catgry, err := categoryRepo.GetByID(ctx, *p.CategoryID)
+			if err != nil {
+				return nil, fmt.Errorf("categoryRepo.GetByID: %w", err)
+			}
+
+			if catgry == nil {
+				return nil, except.NotFoundError("category not found")
+			}
+		}
+
+		if err := scheduledExpense.Update(expense.ScheduledExpenseUpdateAttributes{
+			Name:            p.Name,
+			Amount:          p.Amount,
+			Description:     p.Description,
+			CategoryID:      p.CategoryID,
+			SplitType:       p.SplitType,
+			PayerID:         p.PayerID,
+			ReceiverID:      p.ReceiverID,
+			FrequencyInDays: p.FrequencyInDays,
+			Recurrence:      p.Recurrence,
+		}); err != nil {
+			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("scheduledExpense.Update: %w", err))
+		}
+
+		if err := scheduledExpenseRepo.Store(ctx, scheduledExpense); err != nil {
+			return nil, fmt.Errorf("scheduledExpenseRepo.Store: %w", err)
+		}
+
+		return scheduledExpense, nil
+	}
+}
@@ -0,0 +1,90 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestUpdateScheduledExpense(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	scheduledExpenseRepo := mocks.NewMockexpenseScheduledExpenseRepository(t)
+	userRepo := mocks.NewMockuserRepository(t)
+	categoryRepo := mocks.NewMockcategoryRepository(t)
+	updateScheduledExpense := usecase.NewUpdateScheduledExpense(scheduledExpenseRepo, userRepo, categoryRepo)
+
+	id := expense.ScheduledExpenseID{Value: 1}
+	grp := group.ID{Value: 1}
+	outsider := user.New(user.Attributes{
+		ID:      user.ID{Value: 3},
+		Name:    "outsider",
+		Email:   "outsider@email.com",
+		GroupID: &group.ID{Value: 2},
+	})
+	name, amount, invalidAmount := "new rent", 1500, -1
+
+	t.Run("should return error if payer is from another group", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, id).Return(newScheduledExpense(t), nil).Once()
+		userRepo.EXPECT().GetByID(ctx, outsider.ID).Return(outsider, nil).Once()
+
+		result, err := updateScheduledExpense(ctx, usecase.UpdateScheduledExpenseParams{ID: id, GroupID: grp, PayerID: &outsider.ID})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "group mismatch")
+	})
+
+	t.Run("should return error if category is not found", func(t *testing.T) {
+		categoryID := category.ID{Value: 9}
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, id).Return(newScheduledExpense(t), nil).Once()
+		categoryRepo.EXPECT().GetByID(ctx, categoryID).Return(nil, nil).Once()
+
+		result, err := updateScheduledExpense(ctx, usecase.UpdateScheduledExpenseParams{ID: id, GroupID: grp, CategoryID: &categoryID})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "category not found")
+	})
+
+	t.Run("should return error if the update is invalid", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, id).Return(newScheduledExpense(t), nil).Once()
+
+		result, err := updateScheduledExpense(ctx, usecase.UpdateScheduledExpenseParams{ID: id, GroupID: grp, Amount: &invalidAmount})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "Unprocessable Entity: internal=scheduledExpense.Update: scheduled expense validation failed: amount must be greater than zero")
+	})
+
+	t.Run("should return error if store fails", func(t *testing.T) {
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, id).Return(newScheduledExpense(t), nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+
+		result, err := updateScheduledExpense(ctx, usecase.UpdateScheduledExpenseParams{ID: id, GroupID: grp, Name: &name})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "scheduledExpenseRepo.Store: test error")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		recurrence := &expense.Recurrence{Frequency: expense.Frequencies.Monthly, Interval: 1, DayOfMonth: 5}
+		scheduledExpenseRepo.EXPECT().GetByID(ctx, id).Return(newScheduledExpense(t), nil).Once()
+		scheduledExpenseRepo.EXPECT().Store(ctx, mock.MatchedBy(func(se *expense.ScheduledExpense) bool {
+			return se.Name == name && se.Amount == amount && se.Recurrence == recurrence
+		})).Return(nil).Once()
+
+		result, err := updateScheduledExpense(ctx, usecase.UpdateScheduledExpenseParams{
+			ID:         id,
+			GroupID:    grp,
+			Name:       &name,
+			Amount:     &amount,
+			Recurrence: recurrence,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Version)
+	})
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/thumbnail"
+	"github.com/Beigelman/nossas-despesas/internal/shared/service"
+)
+
+// ThumbnailSize is the side, in pixels, of the square the previews of images fit in.
+const ThumbnailSize = 320
+
+type (
+	UploadAttachmentParams struct {
+		GroupID   group.ID
+		ExpenseID expense.ID
+		UserID    user.ID
+		FileName  string
+		Content   []byte
+	}
+
+	UploadAttachment func(ctx context.Context, p UploadAttachmentParams) (*expense.Attachment, error)
+)
+
+// NewUploadAttachment keeps a file in the storage and attaches it to the expense. The type of the
+// file is detected from its content rather than trusted from the client, and images get a
+// thumbnail so lists of receipts don't download every photo in full.
+func NewUploadAttachment(
+	expenseRepo expense.Repository,
+	attachmentRepo expense.AttachmentRepository,
+	fileStorage service.FileStorage,
+) UploadAttachment {
+	return func(ctx context.Context, p UploadAttachmentParams) (*expense.Attachment, error) {
+		if _, err := getGroupExpenses(ctx, expenseRepo, []expense.ID{p.ExpenseID}, p.GroupID); err != nil {
+			return nil, err
+		}
+
+		attachment, err := expense.NewAttachment(expense.AttachmentAttributes{
+			ID:          attachmentRepo.GetNextID(),
+			ExpenseID:   p.ExpenseID,
+			GroupID:     p.GroupID,
+			FileName:    p.FileName,
+			ContentType: http.DetectContentType(p.Content),
+			Size:        len(p.Content),
+			UploadedBy:  p.UserID,
+		})
+		if err != nil {
+			return nil, except.UnprocessableEntityError(err.Error())
+		}
+
+		if err := fileStorage.Put(ctx, attachment.StorageKey, p.Content, attachment.ContentType); err != nil {
+			return nil, fmt.Errorf("fileStorage.Put: %w", err)
+		}
+
+		if attachment.IsImage() {
+			putThumbnail(ctx, fileStorage, attachment, p.Content)
+		}
+
+		if err := attachmentRepo.Store(ctx, attachment); err != nil {
+			deleteAttachmentFiles(ctx, fileStorage, *attachment)
+			return nil, fmt.Errorf("attachmentRepo.Store: %w", err)
+		}
+
+		return attachment, nil
+	}
+}
+
+// putThumbnail keeps a preview of the image along with it. The upload doesn't fail without one:
+// formats the thumbnail package can't decode, such as WebP, are simply shown in full.
+func putThumbnail(ctx context.Context, fileStorage service.FileStorage, attachment *expense.Attachment, content []byte) {
+	preview, err := thumbnail.Generate(content, ThumbnailSize)
+	if err != nil {
+		if !errors.Is(err, thumbnail.ErrUnsupportedImage) {
+			slog.WarnContext(ctx, "failed to generate the thumbnail of an attachment", "attachment_id", attachment.ID.Value, "error", err)
+		}
+		return
+	}
+
+	key := attachment.SetThumbnail()
+	if err := fileStorage.Put(ctx, key, preview, thumbnail.ContentType); err != nil {
+		slog.WarnContext(ctx, "failed to store the thumbnail of an attachment", "attachment_id", attachment.ID.Value, "error", err)
+		attachment.ThumbnailKey = nil
+	}
+}
@@ -0,0 +1,166 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/storage"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/thumbnail"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func pngImage(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestUploadAttachment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	attachmentRepo := mocks.NewMockexpenseAttachmentRepository(t)
+	fileStorage := mocks.NewMockserviceFileStorage(t)
+	uploadAttachment := usecase.NewUploadAttachment(expenseRepo, attachmentRepo, fileStorage)
+	params := usecase.UploadAttachmentParams{
+		GroupID:   group.ID{Value: 1},
+		ExpenseID: expense.ID{Value: 1},
+		UserID:    user.ID{Value: 7},
+		FileName:  "recibo.png",
+		Content:   pngImage(t, 640, 480),
+	}
+
+	t.Run("should return error if the expense belongs to another group", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, params.ExpenseID).Return(newGroupExpense(1, 2), nil).Once()
+
+		attachment, err := uploadAttachment(ctx, params)
+		assert.Nil(t, attachment)
+		assert.EqualError(t, err, "expense 1 not found")
+	})
+
+	t.Run("should return error if the file type is not supported", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, params.ExpenseID).Return(newGroupExpense(1, 1), nil).Once()
+		attachmentRepo.EXPECT().GetNextID().Return(expense.AttachmentID{Value: 1}).Once()
+
+		text := params
+		text.Content = []byte("not a receipt")
+		attachment, err := uploadAttachment(ctx, text)
+		assert.Nil(t, attachment)
+		assert.EqualError(t, err, "attachment validation failed: unsupported file type text/plain; charset=utf-8")
+	})
+
+	t.Run("should store an image with its thumbnail", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, params.ExpenseID).Return(newGroupExpense(1, 1), nil).Once()
+		attachmentRepo.EXPECT().GetNextID().Return(expense.AttachmentID{Value: 3}).Once()
+		fileStorage.EXPECT().Put(ctx, "attachments/1/1/3", params.Content, "image/png").Return(nil).Once()
+		fileStorage.EXPECT().Put(ctx, "attachments/1/1/3-thumbnail", mock.MatchedBy(func(content []byte) bool {
+			preview, _, err := image.Decode(bytes.NewReader(content))
+			return err == nil && preview.Bounds().Dx() == usecase.ThumbnailSize && preview.Bounds().Dy() == 240
+		}), thumbnail.ContentType).Return(nil).Once()
+		attachmentRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		attachment, err := uploadAttachment(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, "image/png", attachment.ContentType)
+		assert.Equal(t, len(params.Content), attachment.Size)
+		assert.Equal(t, "attachments/1/1/3-thumbnail", *attachment.ThumbnailKey)
+	})
+
+	t.Run("should store a pdf without thumbnail", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, params.ExpenseID).Return(newGroupExpense(1, 1), nil).Once()
+		attachmentRepo.EXPECT().GetNextID().Return(expense.AttachmentID{Value: 4}).Once()
+		fileStorage.EXPECT().Put(ctx, "attachments/1/1/4", mock.Anything, "application/pdf").Return(nil).Once()
+		attachmentRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		pdf := params
+		pdf.FileName = "nota-fiscal.pdf"
+		pdf.Content = []byte("%PDF-1.7\n")
+		attachment, err := uploadAttachment(ctx, pdf)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/pdf", attachment.ContentType)
+		assert.Nil(t, attachment.ThumbnailKey)
+	})
+
+	t.Run("should delete the files if the attachment cannot be stored", func(t *testing.T) {
+		expenseRepo.EXPECT().GetByID(ctx, params.ExpenseID).Return(newGroupExpense(1, 1), nil).Once()
+		attachmentRepo.EXPECT().GetNextID().Return(expense.AttachmentID{Value: 5}).Once()
+		fileStorage.EXPECT().Put(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+		attachmentRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("db error")).Once()
+		fileStorage.EXPECT().Delete(ctx, "attachments/1/1/5").Return(nil).Once()
+		fileStorage.EXPECT().Delete(ctx, "attachments/1/1/5-thumbnail").Return(nil).Once()
+
+		attachment, err := uploadAttachment(ctx, params)
+		assert.Nil(t, attachment)
+		assert.EqualError(t, err, "attachmentRepo.Store: db error")
+	})
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	attachmentRepo := mocks.NewMockexpenseAttachmentRepository(t)
+	fileStorage := mocks.NewMockserviceFileStorage(t)
+	downloadAttachment := usecase.NewDownloadAttachment(attachmentRepo, fileStorage)
+	params := usecase.DownloadAttachmentParams{
+		GroupID:      group.ID{Value: 1},
+		ExpenseID:    expense.ID{Value: 1},
+		AttachmentID: expense.AttachmentID{Value: 3},
+	}
+
+	newAttachment := func(t *testing.T) *expense.Attachment {
+		attachment, err := expense.NewAttachment(expense.AttachmentAttributes{
+			ID:          params.AttachmentID,
+			ExpenseID:   params.ExpenseID,
+			GroupID:     params.GroupID,
+			FileName:    "recibo.png",
+			ContentType: "image/png",
+			Size:        1024,
+			UploadedBy:  user.ID{Value: 7},
+		})
+		assert.NoError(t, err)
+		attachment.SetThumbnail()
+		return attachment
+	}
+
+	t.Run("should return error if the attachment belongs to another expense", func(t *testing.T) {
+		attachment := newAttachment(t)
+		attachment.ExpenseID = expense.ID{Value: 2}
+		attachmentRepo.EXPECT().GetByID(ctx, params.AttachmentID).Return(attachment, nil).Once()
+
+		result, err := downloadAttachment(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "attachment not found")
+	})
+
+	t.Run("should open the thumbnail", func(t *testing.T) {
+		attachmentRepo.EXPECT().GetByID(ctx, params.AttachmentID).Return(newAttachment(t), nil).Once()
+		fileStorage.EXPECT().Get(ctx, "attachments/1/1/3-thumbnail").Return(io.NopCloser(bytes.NewReader(nil)), nil).Once()
+
+		thumb := params
+		thumb.Thumbnail = true
+		result, err := downloadAttachment(ctx, thumb)
+		assert.NoError(t, err)
+		assert.Equal(t, thumbnail.ContentType, result.ContentType)
+	})
+
+	t.Run("should return error if the file is missing", func(t *testing.T) {
+		attachmentRepo.EXPECT().GetByID(ctx, params.AttachmentID).Return(newAttachment(t), nil).Once()
+		fileStorage.EXPECT().Get(ctx, "attachments/1/1/3").Return(nil, storage.ErrNotFound).Once()
+
+		result, err := downloadAttachment(ctx, params)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "attachment file not found")
+	})
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetGroupBalancePix func(ctx *fiber.Ctx) error
+
+	GetGroupBalancePixResponse struct {
+		PayerID    int    `json:"payer_id"`
+		ReceiverID int    `json:"receiver_id"`
+		Amount     int    `json:"amount"`
+		PixKey     string `json:"pix_key"`
+		BRCode     string `json:"br_code"`
+		QRCode     []byte `json:"qr_code_png"`
+	}
+)
+
+func NewGetGroupBalancePix(getGroupBalance postgres.GetGroupBalance, generatePixCharge usecase.GeneratePixCharge) GetGroupBalancePix {
+	return func(ctx *fiber.Ctx) error {
+		userID, ok := ctx.Locals("user_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid user id")
+		}
+
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var receiverID *user.ID
+		if id := ctx.QueryInt("receiver_id"); id != 0 {
+			receiverID = &user.ID{Value: id}
+		}
+
+		balances, err := getGroupBalance(ctx.Context(), groupID)
+		if err != nil {
+			return fmt.Errorf("query.GetGroupBalance: %w", err)
+		}
+
+		memberBalances := make([]usecase.MemberBalance, 0, len(balances))
+		for _, b := range balances {
+			memberBalances = append(memberBalances, usecase.MemberBalance{
+				UserID: user.ID{Value: b.UserID},
+				Amount: int(math.Round(float64(b.Balance))),
+			})
+		}
+
+		charge, err := generatePixCharge(ctx.Context(), usecase.GeneratePixChargeInput{
+			GroupID:    group.ID{Value: groupID},
+			PayerID:    user.ID{Value: userID},
+			ReceiverID: receiverID,
+			Balances:   memberBalances,
+		})
+		if err != nil {
+			return fmt.Errorf("generatePixCharge: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, GetGroupBalancePixResponse{
+			PayerID:    charge.PayerID.Value,
+			ReceiverID: charge.ReceiverID.Value,
+			Amount:     charge.Amount,
+			PixKey:     charge.PixKey,
+			BRCode:     charge.BRCode,
+			QRCode:     charge.QRCode,
+		}))
+	}
+}
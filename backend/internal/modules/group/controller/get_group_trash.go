@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	nossasdespesas "github.com/Beigelman/nossas-despesas"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetGroupTrash func(ctx *fiber.Ctx) error
+
+	TrashItemResponse struct {
+		postgres.TrashItem
+		// PurgeAt is when the item stops being restorable.
+		PurgeAt time.Time `json:"purge_at"`
+	}
+
+	GetGroupTrashResponse struct {
+		GroupID int                 `json:"group_id"`
+		Items   []TrashItemResponse `json:"items"`
+	}
+)
+
+func NewGetGroupTrash(getGroupTrash postgres.GetGroupTrash, cfg *nossasdespesas.Config) GetGroupTrash {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		items, err := getGroupTrash(ctx.Context(), groupID)
+		if err != nil {
+			return fmt.Errorf("query.GetGroupTrash: %w", err)
+		}
+
+		response := GetGroupTrashResponse{GroupID: groupID, Items: make([]TrashItemResponse, 0, len(items))}
+		for _, item := range items {
+			response.Items = append(response.Items, TrashItemResponse{
+				TrashItem: item,
+				PurgeAt:   item.DeletedAt.Add(cfg.TrashRetention()),
+			})
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, response))
+	}
+}
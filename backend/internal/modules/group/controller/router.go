@@ -14,6 +14,8 @@ func Router(
 	inviteUserToGroupHandler InviteUserToGroup,
 	acceptGroupInviteHandler AcceptGroupInvite,
 	getGroupBalanceHandler GetGroupBalance,
+	getGroupBalancePixHandler GetGroupBalancePix,
+	getGroupTrashHandler GetGroupTrash,
 ) {
 	// Api group
 	api := server.Group("api")
@@ -24,6 +26,8 @@ func Router(
 	group.Get("/", getGroupHandler)
 	group.Post("/", createGroupHandler)
 	group.Get("/balance", getGroupBalanceHandler)
+	group.Get("/balance/pix", getGroupBalancePixHandler)
+	group.Get("/trash", getGroupTrashHandler)
 	// Invite Router
 	invite := group.Group("invite", authMiddleware)
 	invite.Post("/", inviteUserToGroupHandler)
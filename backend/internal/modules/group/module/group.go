@@ -17,11 +17,15 @@ var Module = eon.NewModule("Group", func(ctx context.Context, c *di.Container, l
 	di.Provide(c, usecase.NewCreateGroup)
 	di.Provide(c, usecase.NewInviteUserToGroup)
 	di.Provide(c, usecase.NewAcceptGroupInvite)
+	di.Provide(c, usecase.NewGeneratePixCharge)
 	di.Provide(c, postgres.NewGetGroup)
 	di.Provide(c, postgres.NewGetGroupBalance)
+	di.Provide(c, postgres.NewGetGroupTrash)
 	di.Provide(c, controller.NewInviteUserToGroup)
 	di.Provide(c, controller.NewAcceptGroupInvite)
 	di.Provide(c, controller.NewGetGroupBalance)
+	di.Provide(c, controller.NewGetGroupBalancePix)
+	di.Provide(c, controller.NewGetGroupTrash)
 	di.Provide(c, controller.NewCreateGroup)
 	di.Provide(c, controller.NewGetGroup)
 	// Register routes
@@ -18,6 +18,7 @@ type (
 		Email          string    `db:"email" json:"email"`
 		GroupID        int       `db:"group_id" json:"group_id"`
 		ProfilePicture *string   `db:"profile_picture" json:"profile_picture,omitempty"`
+		PixKey         *string   `db:"pix_key" json:"pix_key,omitempty"`
 		CreatedAt      time.Time `db:"created_at" json:"created_at"`
 		UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
 	}
@@ -61,6 +62,7 @@ func NewGetGroup(db *db.Client) GetGroup {
 				email,
 				group_id,
 				profile_picture,
+				pix_key,
 				created_at,
 				updated_at 
 			FROM users
@@ -25,10 +25,11 @@ func NewGetGroupBalance(db *db.Client) GetGroupBalance {
 			    SELECT
 			        id,
 			        CASE WHEN refund_amount_cents IS NULL THEN amount_cents ELSE amount_cents - refund_amount_cents END AS amount_cents,
+			        amount_cents AS gross_amount_cents,
 			        group_id,
 			        split_ratio,
+			        split_type,
 			        payer_id,
-			        receiver_id,
 			        deleted_at
 			    FROM expenses_latest
 			    WHERE group_id = $1
@@ -39,14 +40,31 @@ func NewGetGroupBalance(db *db.Client) GetGroupBalance {
 			        balance,
 			        type
 			    FROM (
-					SELECT payer_id AS user_id, SUM((amount_cents * (split_ratio->>'receiver')::numeric / 100)) AS balance, 'payer' as type
+					SELECT payer_id AS user_id, SUM(amount_cents) AS balance, 'payer' as type
 					FROM base
 					GROUP BY payer_id
 	
 					UNION ALL
 	
-					SELECT receiver_id AS user_id, SUM((amount_cents * (split_ratio->>'receiver')::numeric / 100)) AS balance, 'receiver' as type
-					FROM base
+					SELECT (share->>'user_id')::int AS user_id, SUM(CASE
+						WHEN split_type IN ('exact', 'weighted') THEN COALESCE((share->>'amount')::numeric, 0) * amount_cents / gross_amount_cents
+						ELSE amount_cents * (share->>'percent')::numeric / 100
+					END) AS balance, 'participant' as type
+					FROM base, jsonb_array_elements(split_ratio->'shares') AS share
+					GROUP BY 1
+
+					UNION ALL
+
+					SELECT payer_id AS user_id, SUM(amount_cents) AS balance, 'payer' as type
+					FROM settlements
+					WHERE group_id = $1 AND deleted_at IS NULL
+					GROUP BY payer_id
+
+					UNION ALL
+
+					SELECT receiver_id AS user_id, SUM(amount_cents) AS balance, 'participant' as type
+					FROM settlements
+					WHERE group_id = $1 AND deleted_at IS NULL
 					GROUP BY receiver_id
 			 	) AS balances
 			)
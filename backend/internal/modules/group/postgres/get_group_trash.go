@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	TrashItem struct {
+		Type      string    `db:"type" json:"type"`
+		ID        int       `db:"id" json:"id"`
+		Name      string    `db:"name" json:"name"`
+		Amount    int       `db:"amount_cents" json:"amount"`
+		UserID    *int      `db:"user_id" json:"user_id,omitempty"`
+		DeletedAt time.Time `db:"deleted_at" json:"deleted_at"`
+		DeletedBy *int      `db:"deleted_by" json:"deleted_by,omitempty"`
+	}
+
+	// GetGroupTrash lists the expenses and incomes of the group that were deleted and can still be
+	// restored, most recently deleted first.
+	GetGroupTrash func(ctx context.Context, groupID int) ([]TrashItem, error)
+)
+
+func NewGetGroupTrash(db *db.Client) GetGroupTrash {
+	dbClient := db.Conn()
+	return func(ctx context.Context, groupID int) ([]TrashItem, error) {
+		items := []TrashItem{}
+		if err := dbClient.SelectContext(ctx, &items, `
+			SELECT
+				'expense' AS type,
+				id,
+				name,
+				amount_cents,
+				NULL::bigint AS user_id,
+				deleted_at,
+				updated_by AS deleted_by
+			FROM expenses_latest
+			WHERE group_id = $1
+			AND deleted_at IS NOT NULL
+
+			UNION ALL
+
+			SELECT
+				'income' AS type,
+				inc.id,
+				inc.type AS name,
+				inc.amount_cents,
+				inc.user_id,
+				inc.deleted_at,
+				NULL::bigint AS deleted_by
+			FROM incomes inc
+			JOIN users u ON u.id = inc.user_id
+			WHERE u.group_id = $1
+			AND inc.deleted_at IS NOT NULL
+
+			ORDER BY deleted_at DESC, type, id
+		`, groupID); err != nil {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		return items, nil
+	}
+}
@@ -5,27 +5,24 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
-
-	"github.com/jmoiron/sqlx"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 )
 
 type GroupRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
 func NewGroupRepository(db *db.Client) group.Repository {
-	return &GroupRepository{db: db.Conn()}
+	return &GroupRepository{db: db}
 }
 
 // GetNextID implements group.UserRepository.
 func (repo *GroupRepository) GetNextID() group.ID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT nextval('groups_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT nextval('groups_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.Select: %w", err))
 	}
 
@@ -36,7 +33,7 @@ func (repo *GroupRepository) GetNextID() group.ID {
 func (repo *GroupRepository) GetByID(ctx context.Context, id group.ID) (*group.Group, error) {
 	var model GroupModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, name, created_at, updated_at, deleted_at, version
 		FROM groups WHERE id = $1
 		AND deleted_at IS NULL
@@ -56,7 +53,7 @@ func (repo *GroupRepository) GetByID(ctx context.Context, id group.ID) (*group.G
 func (repo *GroupRepository) GetByName(ctx context.Context, name string) (*group.Group, error) {
 	var model GroupModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, name, created_at, updated_at, deleted_at, version
 		FROM groups WHERE name = $1
 		AND deleted_at IS NULL
@@ -76,32 +73,41 @@ func (repo *GroupRepository) GetByName(ctx context.Context, name string) (*group
 func (repo *GroupRepository) Store(ctx context.Context, entity *group.Group) error {
 	model := groupToModel(entity)
 
-	if err := repo.create(ctx, model); err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			if err := repo.update(ctx, model); err != nil {
-				return fmt.Errorf("repo.update: %w", err)
-			}
-			return nil
-		}
+	created, err := repo.create(ctx, model)
+	if err != nil {
 		return fmt.Errorf("repo.create: %w", err)
 	}
 
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+		entity.Version = model.Version + 1
+	}
+
 	return nil
 }
 
-func (repo *GroupRepository) create(ctx context.Context, model GroupModel) error {
-	if _, err := repo.db.NamedExecContext(ctx, `
+func (repo *GroupRepository) create(ctx context.Context, model GroupModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		INSERT INTO groups (id, name, created_at, updated_at, deleted_at, version)
 		VALUES (:id, :name, :created_at, :updated_at, :deleted_at, :version)
-	`, &model); err != nil {
-		return fmt.Errorf("db.Insert: %w", err)
+		ON CONFLICT (id) DO NOTHING
+	`, &model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
 }
 
 func (repo *GroupRepository) update(ctx context.Context, model GroupModel) error {
-	result, err := repo.db.NamedExecContext(ctx, `
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		UPDATE groups SET name = :name, updated_at = :updated_at, deleted_at = :deleted_at, version = version + 1
 		WHERE id = :id AND version = :version
 	`, &model)
@@ -115,7 +121,7 @@ func (repo *GroupRepository) update(ctx context.Context, model GroupModel) error
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("db.Update: sql: no rows affected")
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "groups", model.ID))
 	}
 
 	return nil
@@ -5,26 +5,23 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
-
-	"github.com/jmoiron/sqlx"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 )
 
 type GroupInviteRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
 func NewGroupInviteRepository(db *db.Client) group.InviteRepository {
-	return &GroupInviteRepository{db: db.Conn()}
+	return &GroupInviteRepository{db: db}
 }
 
 func (repo *GroupInviteRepository) GetNextID() group.InviteID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT nextval('group_invites_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT nextval('group_invites_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.Select: %w", err))
 	}
 
@@ -34,7 +31,7 @@ func (repo *GroupInviteRepository) GetNextID() group.InviteID {
 func (repo *GroupInviteRepository) GetByID(ctx context.Context, id group.InviteID) (*group.Invite, error) {
 	var model GroupInviteModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, email, group_id, status, token, expires_at, created_at, updated_at, deleted_at, version
 		FROM group_invites WHERE id = $1
 		AND deleted_at IS NULL
@@ -51,7 +48,7 @@ func (repo *GroupInviteRepository) GetByID(ctx context.Context, id group.InviteI
 func (repo *GroupInviteRepository) GetByToken(ctx context.Context, token string) (*group.Invite, error) {
 	var model GroupInviteModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, email, group_id, status, token, expires_at, created_at, updated_at, deleted_at, version
 		FROM group_invites WHERE token = $1
 		AND deleted_at IS NULL
@@ -68,7 +65,7 @@ func (repo *GroupInviteRepository) GetByToken(ctx context.Context, token string)
 func (repo *GroupInviteRepository) GetGroupInvitesByEmail(ctx context.Context, groupID group.ID, email string) ([]group.Invite, error) {
 	var models []GroupInviteModel
 
-	if err := repo.db.SelectContext(ctx, &models, `
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &models, `
 		SELECT id, email, group_id, status, token, expires_at, created_at, updated_at, deleted_at, version
 		FROM group_invites WHERE email = $1
 		and group_id = $2
@@ -91,32 +88,40 @@ func (repo *GroupInviteRepository) GetGroupInvitesByEmail(ctx context.Context, g
 
 func (repo *GroupInviteRepository) Store(ctx context.Context, entity *group.Invite) error {
 	model := groupInviteToModel(entity)
-	if err := repo.create(ctx, model); err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			if err := repo.update(ctx, model); err != nil {
-				return fmt.Errorf("repo.update: %w", err)
-			}
-			return nil
-		}
+	created, err := repo.create(ctx, model)
+	if err != nil {
 		return fmt.Errorf("repo.create: %w", err)
 	}
 
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func (repo *GroupInviteRepository) create(ctx context.Context, model GroupInviteModel) error {
-	if _, err := repo.db.NamedExecContext(ctx, `
+func (repo *GroupInviteRepository) create(ctx context.Context, model GroupInviteModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		INSERT INTO group_invites (id, email, group_id, status, token, expires_at, created_at, updated_at, deleted_at, version)
 		VALUES (:id, :email, :group_id, :status, :token, :expires_at, :created_at, :updated_at, :deleted_at, :version)
-	`, model); err != nil {
-		return fmt.Errorf("db.Insert: %w", err)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
 }
 
 func (repo *GroupInviteRepository) update(ctx context.Context, model GroupInviteModel) error {
-	result, err := repo.db.NamedExecContext(ctx, `
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
 		UPDATE group_invites SET status = :status, updated_at = :updated_at, deleted_at = :deleted_at, version = version + 1
 		WHERE id = :id AND version = :version
 	`, model)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 )
 
@@ -21,6 +22,7 @@ type (
 func NewAcceptGroupInvite(
 	userRepository user.Repository,
 	groupInviteRepository group.InviteRepository,
+	unitOfWork db.UnitOfWork,
 ) AcceptGroupInvite {
 	return func(ctx context.Context, input AcceptGroupInviteInput) error {
 		usr, err := userRepository.GetByEmail(ctx, input.Email)
@@ -49,12 +51,22 @@ func NewAcceptGroupInvite(
 			return except.UnprocessableEntityError("invalid invite email")
 		}
 
+		if err := groupInvite.Accept(); err != nil {
+			return except.UnprocessableEntityError("invalid invite").SetInternal(err)
+		}
+
 		usr.AssignGroup(groupInvite.GroupID)
 
-		if err := userRepository.Store(ctx, usr); err != nil {
-			return fmt.Errorf("userRepository.Store: %w", err)
-		}
+		return unitOfWork(ctx, func(ctx context.Context) error {
+			if err := userRepository.Store(ctx, usr); err != nil {
+				return fmt.Errorf("userRepository.Store: %w", err)
+			}
+
+			if err := groupInviteRepository.Store(ctx, groupInvite); err != nil {
+				return fmt.Errorf("groupInviteRepository.Store: %w", err)
+			}
 
-		return nil
+			return nil
+		})
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
 
@@ -22,7 +23,7 @@ func TestAcceptGroupInvite(t *testing.T) {
 	userRepo := mocks.NewMockuserRepository(t)
 	groupInviteRepo := mocks.NewMockgroupInviteRepository(t)
 
-	acceptGroupInvite := usecase.NewAcceptGroupInvite(userRepo, groupInviteRepo)
+	acceptGroupInvite := usecase.NewAcceptGroupInvite(userRepo, groupInviteRepo, dbtest.UnitOfWork)
 	groupID := group.ID{Value: 1}
 	userID := user.ID{Value: 1}
 	input := usecase.AcceptGroupInviteInput{
@@ -77,7 +78,7 @@ func TestAcceptGroupInvite(t *testing.T) {
 	})
 
 	t.Run("if user repo fails to store return error", func(t *testing.T) {
-		assert.NoError(t, groupInvite.Sent())
+		groupInvite.Status = group.InviteStatuses.Sent
 		groupInvite.Email = input.Email
 		groupInviteRepo.EXPECT().GetByToken(ctx, input.Token).Return(groupInvite, nil).Once()
 		userRepo.EXPECT().GetByEmail(ctx, input.Email).Return(userWithOutGroup, nil).Once()
@@ -85,13 +86,27 @@ func TestAcceptGroupInvite(t *testing.T) {
 		assert.Error(t, acceptGroupInvite(ctx, input), "userRepository.Store: test error")
 	})
 
-	t.Run("if everything is ok return nil", func(t *testing.T) {
-		assert.NoError(t, groupInvite.Sent())
-		groupInvite.Email = input.Email
+	t.Run("if groupInvite repo fails to store return error", func(t *testing.T) {
+		groupInvite.Status = group.InviteStatuses.Sent
 		userWithOutGroup.GroupID = nil
 		groupInviteRepo.EXPECT().GetByToken(ctx, input.Token).Return(groupInvite, nil).Once()
 		userRepo.EXPECT().GetByEmail(ctx, input.Email).Return(userWithOutGroup, nil).Once()
 		userRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+		groupInviteRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+		assert.ErrorContains(t, acceptGroupInvite(ctx, input), "groupInviteRepository.Store: test error")
+	})
+
+	t.Run("if everything is ok return nil", func(t *testing.T) {
+		groupInvite.Status = group.InviteStatuses.Sent
+		userWithOutGroup.GroupID = nil
+		groupInviteRepo.EXPECT().GetByToken(ctx, input.Token).Return(groupInvite, nil).Once()
+		userRepo.EXPECT().GetByEmail(ctx, input.Email).Return(userWithOutGroup, nil).Once()
+		userRepo.EXPECT().Store(ctx, mock.MatchedBy(func(u *user.User) bool {
+			return u.GroupID != nil && *u.GroupID == groupID
+		})).Return(nil).Once()
+		groupInviteRepo.EXPECT().Store(ctx, mock.MatchedBy(func(invite *group.Invite) bool {
+			return invite.Status == group.InviteStatuses.Accepted
+		})).Return(nil).Once()
 		assert.NoError(t, acceptGroupInvite(ctx, input))
 	})
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 )
 
@@ -16,7 +17,7 @@ type CreateGroupInput struct {
 
 type CreateGroup func(ctx context.Context, params CreateGroupInput) (*group.Group, error)
 
-func NewCreateGroup(userRepo user.Repository, groupRepo group.Repository) CreateGroup {
+func NewCreateGroup(userRepo user.Repository, groupRepo group.Repository, unitOfWork db.UnitOfWork) CreateGroup {
 	return func(ctx context.Context, params CreateGroupInput) (*group.Group, error) {
 		usr, err := userRepo.GetByID(ctx, params.UserID)
 		if err != nil {
@@ -32,14 +33,20 @@ func NewCreateGroup(userRepo user.Repository, groupRepo group.Repository) Create
 			Name: params.Name,
 		})
 
-		if err := groupRepo.Store(ctx, newGroup); err != nil {
-			return nil, fmt.Errorf("groupRepo.Store: %w", err)
-		}
-
 		usr.AssignGroup(newGroup.ID)
 
-		if err := userRepo.Store(ctx, usr); err != nil {
-			return nil, fmt.Errorf("userRepo.Store: %w", err)
+		if err := unitOfWork(ctx, func(ctx context.Context) error {
+			if err := groupRepo.Store(ctx, newGroup); err != nil {
+				return fmt.Errorf("groupRepo.Store: %w", err)
+			}
+
+			if err := userRepo.Store(ctx, usr); err != nil {
+				return fmt.Errorf("userRepo.Store: %w", err)
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
 		}
 
 		return newGroup, nil
@@ -11,6 +11,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/group/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
 
@@ -25,7 +26,7 @@ func TestCreateGroup(t *testing.T) {
 		Email: "",
 	})
 
-	useCase := usecase.NewCreateGroup(userRepo, groupRepo)
+	useCase := usecase.NewCreateGroup(userRepo, groupRepo, dbtest.UnitOfWork)
 
 	t.Run("userRepo.GetByID returns error", func(t *testing.T) {
 		userRepo.EXPECT().GetByID(ctx, usr.ID).Return(nil, errors.New("test error")).Once()
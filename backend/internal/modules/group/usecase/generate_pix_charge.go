@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pix"
+)
+
+const qrCodeSize = 256
+
+type (
+	MemberBalance struct {
+		UserID user.ID
+		Amount int
+	}
+
+	GeneratePixChargeInput struct {
+		GroupID    group.ID
+		PayerID    user.ID
+		ReceiverID *user.ID
+		Balances   []MemberBalance
+	}
+
+	PixCharge struct {
+		PayerID    user.ID
+		ReceiverID user.ID
+		Amount     int
+		PixKey     string
+		BRCode     string
+		QRCode     []byte
+	}
+
+	GeneratePixCharge func(ctx context.Context, input GeneratePixChargeInput) (*PixCharge, error)
+)
+
+func NewGeneratePixCharge(userRepo user.Repository) GeneratePixCharge {
+	return func(ctx context.Context, input GeneratePixChargeInput) (*PixCharge, error) {
+		var owed *transfer
+		for _, t := range suggestTransfers(input.Balances) {
+			if t.payerID == input.PayerID && (input.ReceiverID == nil || t.receiverID == *input.ReceiverID) {
+				owed = &t
+				break
+			}
+		}
+
+		if owed == nil {
+			return nil, except.UnprocessableEntityError("nothing to pay")
+		}
+
+		receiver, err := userRepo.GetByID(ctx, owed.receiverID)
+		if err != nil {
+			return nil, fmt.Errorf("userRepo.GetByID: %w", err)
+		}
+
+		if receiver == nil || receiver.GroupID == nil || *receiver.GroupID != input.GroupID {
+			return nil, except.NotFoundError("receiver not found")
+		}
+
+		if receiver.PixKey == nil {
+			return nil, except.UnprocessableEntityError("receiver has no pix key")
+		}
+
+		brCode, err := pix.Payload{
+			Key:    *receiver.PixKey,
+			Name:   receiver.Name,
+			Amount: owed.amount,
+		}.BRCode()
+		if err != nil {
+			return nil, except.UnprocessableEntityError("invalid pix payload").SetInternal(err)
+		}
+
+		qrCode, err := pix.QRCode(brCode, qrCodeSize)
+		if err != nil {
+			return nil, fmt.Errorf("pix.QRCode: %w", err)
+		}
+
+		return &PixCharge{
+			PayerID:    owed.payerID,
+			ReceiverID: owed.receiverID,
+			Amount:     owed.amount,
+			PixKey:     *receiver.PixKey,
+			BRCode:     brCode,
+			QRCode:     qrCode,
+		}, nil
+	}
+}
+
+type transfer struct {
+	payerID    user.ID
+	receiverID user.ID
+	amount     int
+}
+
+// suggestTransfers pairs the members that owe money with the ones that are owed, largest
+// amounts first, so the group squares up with as few payments as possible.
+func suggestTransfers(balances []MemberBalance) []transfer {
+	var debtors, creditors []MemberBalance
+	for _, b := range balances {
+		switch {
+		case b.Amount < 0:
+			debtors = append(debtors, MemberBalance{UserID: b.UserID, Amount: -b.Amount})
+		case b.Amount > 0:
+			creditors = append(creditors, b)
+		}
+	}
+
+	byAmount := func(a, b MemberBalance) int {
+		if c := cmp.Compare(b.Amount, a.Amount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID.Value, b.UserID.Value)
+	}
+	slices.SortFunc(debtors, byAmount)
+	slices.SortFunc(creditors, byAmount)
+
+	var transfers []transfer
+	for i, j := 0, 0; i < len(debtors) && j < len(creditors); {
+		amount := min(debtors[i].Amount, creditors[j].Amount)
+		transfers = append(transfers, transfer{
+			payerID:    debtors[i].UserID,
+			receiverID: creditors[j].UserID,
+			amount:     amount,
+		})
+
+		debtors[i].Amount -= amount
+		creditors[j].Amount -= amount
+		if debtors[i].Amount == 0 {
+			i++
+		}
+		if creditors[j].Amount == 0 {
+			j++
+		}
+	}
+
+	return transfers
+}
@@ -0,0 +1,90 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestGeneratePixCharge(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userRepo := mocks.NewMockuserRepository(t)
+	useCase := usecase.NewGeneratePixCharge(userRepo)
+
+	groupID := group.ID{Value: 1}
+	newReceiver := func(pixKey *string) *user.User {
+		usr := user.New(user.Attributes{ID: user.ID{Value: 2}, Name: "Maria", Email: "maria@email.com", GroupID: &groupID})
+		usr.PixKey = pixKey
+		return usr
+	}
+	pixKey := "maria@email.com"
+
+	input := usecase.GeneratePixChargeInput{
+		GroupID: groupID,
+		PayerID: user.ID{Value: 1},
+		Balances: []usecase.MemberBalance{
+			{UserID: user.ID{Value: 1}, Amount: -3000},
+			{UserID: user.ID{Value: 2}, Amount: 2000},
+			{UserID: user.ID{Value: 3}, Amount: 1000},
+		},
+	}
+
+	t.Run("nothing to pay", func(t *testing.T) {
+		res, err := useCase(ctx, usecase.GeneratePixChargeInput{
+			GroupID:  groupID,
+			PayerID:  user.ID{Value: 2},
+			Balances: input.Balances,
+		})
+		assert.ErrorContains(t, err, "nothing to pay")
+		assert.Nil(t, res)
+	})
+
+	t.Run("userRepo.GetByID returns error", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 2}).Return(nil, errors.New("test error")).Once()
+		res, err := useCase(ctx, input)
+		assert.ErrorContains(t, err, "userRepo.GetByID: test error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("receiver without pix key", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 2}).Return(newReceiver(nil), nil).Once()
+		res, err := useCase(ctx, input)
+		assert.ErrorContains(t, err, "receiver has no pix key")
+		assert.Nil(t, res)
+	})
+
+	t.Run("charges the largest creditor first", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 2}).Return(newReceiver(&pixKey), nil).Once()
+		res, err := useCase(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID{Value: 2}, res.ReceiverID)
+		assert.Equal(t, 2000, res.Amount)
+		assert.Equal(t, pixKey, res.PixKey)
+		assert.Contains(t, res.BRCode, "540520.00")
+		assert.Equal(t, []byte("\x89PNG"), res.QRCode[:4])
+	})
+
+	t.Run("charges the requested receiver", func(t *testing.T) {
+		receiverID := user.ID{Value: 3}
+		receiver := user.New(user.Attributes{ID: receiverID, Name: "Jose", Email: "jose@email.com", GroupID: &groupID})
+		receiver.PixKey = &pixKey
+		userRepo.EXPECT().GetByID(ctx, receiverID).Return(receiver, nil).Once()
+		res, err := useCase(ctx, usecase.GeneratePixChargeInput{
+			GroupID:    groupID,
+			PayerID:    input.PayerID,
+			ReceiverID: &receiverID,
+			Balances:   input.Balances,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1000, res.Amount)
+		assert.Contains(t, res.BRCode, "540510.00")
+	})
+}
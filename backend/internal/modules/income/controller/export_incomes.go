@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/income/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/spreadsheet"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	ExportIncomes func(ctx *fiber.Ctx) error
+
+	ExportIncomesRequest struct {
+		Format    string   `query:"format" validate:"omitempty,oneof=csv xlsx"`
+		Locale    string   `query:"locale" validate:"omitempty,oneof=pt-BR"`
+		UserID    int      `query:"user_id" validate:"gte=0"`
+		Types     []string `query:"type" validate:"dive,oneof=salary benefit vacation thirteenth_salary other"`
+		StartDate string   `query:"start_date" validate:"omitempty,datetime=2006-01-02"`
+		EndDate   string   `query:"end_date" validate:"omitempty,datetime=2006-01-02"`
+		Sort      string   `query:"sort" validate:"omitempty,oneof=date amount"`
+		Order     string   `query:"order" validate:"omitempty,oneof=asc desc"`
+	}
+)
+
+var (
+	exportIncomesHeaders = map[spreadsheet.Locale][]string{
+		spreadsheet.Locales.Default: {"Date", "Member", "Type", "Amount"},
+		spreadsheet.Locales.PtBR:    {"Data", "Membro", "Tipo", "Valor"},
+	}
+
+	incomeTypesPtBR = map[string]string{
+		"salary":            "Salário",
+		"benefit":           "Benefício",
+		"thirteenth_salary": "13º salário",
+		"vacation":          "Férias",
+		"other":             "Outros",
+	}
+)
+
+// NewExportIncomes streams the incomes of the group as a CSV or XLSX file, filtered and sorted
+// the same way as the expense export.
+func NewExportIncomes(exportIncomes postgres.ExportIncomes) ExportIncomes {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var req ExportIncomesRequest
+		if err := ctx.QueryParser(&req); err != nil {
+			return except.BadRequestError("invalid query params").SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid query params").SetInternal(err)
+		}
+
+		format := spreadsheet.Formats.CSV
+		if req.Format != "" {
+			format = spreadsheet.Format(req.Format)
+		}
+		locale := spreadsheet.Locale(req.Locale)
+
+		input := postgres.ExportIncomesInput{
+			GroupID:      groupID,
+			UserID:       req.UserID,
+			Types:        req.Types,
+			SortByAmount: req.Sort == "amount",
+			Ascending:    req.Order == "asc",
+		}
+
+		// Dates were validated above, the end date is inclusive
+		if req.StartDate != "" {
+			startDate, _ := time.Parse(time.DateOnly, req.StartDate)
+			input.StartDate = &startDate
+		}
+		if req.EndDate != "" {
+			endDate, _ := time.Parse(time.DateOnly, req.EndDate)
+			endDate = endDate.AddDate(0, 0, 1)
+			input.EndDate = &endDate
+		}
+
+		return api.StreamAttachment(ctx, "incomes."+string(format), format.ContentType(), func(c context.Context, w io.Writer) error {
+			writer, err := spreadsheet.NewWriter(w, format, locale, "Incomes", exportIncomesHeaders[locale])
+			if err != nil {
+				return fmt.Errorf("spreadsheet.NewWriter: %w", err)
+			}
+
+			if err := exportIncomes(c, input, func(row postgres.IncomeExportRow) error {
+				incomeType := row.Type
+				if locale == spreadsheet.Locales.PtBR && incomeTypesPtBR[incomeType] != "" {
+					incomeType = incomeTypesPtBR[incomeType]
+				}
+
+				return writer.Write([]any{spreadsheet.Date(row.CreatedAt), row.UserName, incomeType, spreadsheet.Money(row.AmountCents)})
+			}); err != nil {
+				return fmt.Errorf("query.ExportIncomes: %w", err)
+			}
+
+			return writer.Close()
+		})
+	}
+}
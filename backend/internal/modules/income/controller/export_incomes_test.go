@@ -0,0 +1,97 @@
+package controller_test
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/income/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+)
+
+func TestExportIncomesHandler(t *testing.T) {
+	t.Parallel()
+
+	rows := []postgres.IncomeExportRow{
+		{ID: 1, CreatedAt: time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC), UserName: "Ana", Type: "salary", AmountCents: 750000},
+	}
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedBody   string
+		assertInput    func(t *testing.T, input postgres.ExportIncomesInput)
+	}{
+		{
+			name:           "should stream a csv with the default formatting",
+			query:          "",
+			expectedStatus: 200,
+			expectedBody:   "Date,Member,Type,Amount\n2025-03-05,Ana,salary,7500.00\n",
+		},
+		{
+			name:           "should stream a pt-BR csv with the filters",
+			query:          "locale=pt-BR&user_id=2&type=salary&type=vacation&start_date=2025-03-01&end_date=2025-03-31&sort=amount&order=asc",
+			expectedStatus: 200,
+			expectedBody:   "Data;Membro;Tipo;Valor\n05/03/2025;Ana;Salário;7.500,00\n",
+			assertInput: func(t *testing.T, input postgres.ExportIncomesInput) {
+				assert.Equal(t, 1, input.GroupID)
+				assert.Equal(t, 2, input.UserID)
+				assert.Equal(t, []string{"salary", "vacation"}, input.Types)
+				assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), *input.StartDate)
+				assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), *input.EndDate)
+				assert.True(t, input.SortByAmount)
+				assert.True(t, input.Ascending)
+			},
+		},
+		{
+			name:           "should return 400 if type is invalid",
+			query:          "type=lottery",
+			expectedStatus: 400,
+		},
+	}
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var input *postgres.ExportIncomesInput
+			exportIncomes := func(ctx context.Context, in postgres.ExportIncomesInput, each func(row postgres.IncomeExportRow) error) error {
+				input = &in
+				for _, row := range rows {
+					if err := each(row); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+			app.Get("/incomes/export", func(c *fiber.Ctx) error {
+				c.Locals("group_id", 1)
+				return c.Next()
+			}, controller.NewExportIncomes(exportIncomes))
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/incomes/export?"+tc.query, nil))
+			assert.Nil(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, string(body))
+			}
+			if tc.assertInput != nil {
+				tc.assertInput(t, *input)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	nossasdespesas "github.com/Beigelman/nossas-despesas"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
+)
+
+type PurgeDeletedIncomesJob func(ctx context.Context) error
+
+func NewPurgeDeletedIncomesJob(purgeDeletedIncomes usecase.PurgeDeletedIncomes, cfg *nossasdespesas.Config) PurgeDeletedIncomesJob {
+	return func(ctx context.Context) error {
+		purged, err := purgeDeletedIncomes(ctx, time.Now().Add(-cfg.TrashRetention()))
+		if err != nil {
+			return fmt.Errorf("PurgeDeletedIncomes: %w", err)
+		}
+
+		slog.InfoContext(ctx, "Deleted incomes purged", "count", purged)
+
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	RestoreIncome func(ctx *fiber.Ctx) error
+
+	RestoreIncomeResponse struct {
+		ID      int `json:"id"`
+		Version int `json:"version"`
+	}
+)
+
+func NewRestoreIncome(restoreIncome usecase.RestoreIncome) RestoreIncome {
+	return func(ctx *fiber.Ctx) error {
+		userID, ok := ctx.Locals("user_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid user id")
+		}
+
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		incomeID, err := strconv.Atoi(ctx.Params("income_id"))
+		if err != nil {
+			return except.BadRequestError("invalid income id")
+		}
+
+		inc, err := restoreIncome(ctx.Context(), usecase.RestoreIncomeParams{
+			ID:      income.ID{Value: incomeID},
+			UserID:  user.ID{Value: userID},
+			GroupID: group.ID{Value: groupID},
+		})
+		if err != nil {
+			return fmt.Errorf("restoreIncome: %w", err)
+		}
+
+		api.SetETag(ctx, inc.Version)
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, RestoreIncomeResponse{ID: inc.ID.Value, Version: inc.Version}),
+		)
+	}
+}
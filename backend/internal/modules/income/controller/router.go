@@ -12,8 +12,10 @@ func Router(
 	createIncomeHandler CreateIncome,
 	updateIncomeHandler UpdateIncome,
 	deleteIncomeHandler DeleteIncome,
+	restoreIncomeHandler RestoreIncome,
 	getMonthlyIncomeHandler GetMonthlyIncome,
 	getIncomesPerPeriodHandler GetIncomesPerPeriod,
+	exportIncomesHandler ExportIncomes,
 ) {
 	// Api group
 	api := server.Group("api")
@@ -25,5 +27,7 @@ func Router(
 	income.Post("/", createIncomeHandler)
 	income.Patch("/:income_id", updateIncomeHandler)
 	income.Delete("/:income_id", deleteIncomeHandler)
+	income.Post("/:income_id/restore", restoreIncomeHandler)
 	income.Get("/insights", getIncomesPerPeriodHandler)
+	income.Get("/export", exportIncomesHandler)
 }
@@ -27,7 +27,8 @@ type (
 	}
 
 	UpdateIncomeResponse struct {
-		ID int `json:"id"`
+		ID      int `json:"id"`
+		Version int `json:"version"`
 	}
 )
 
@@ -49,6 +50,11 @@ func NewUpdateIncome(updateIncome usecase.UpdateIncome) UpdateIncome {
 			return except.BadRequestError("invalid income id")
 		}
 
+		version, err := api.IfMatch(ctx)
+		if err != nil {
+			return err
+		}
+
 		var req UpdateIncomeRequest
 		if err := ctx.BodyParser(&req); err != nil {
 			return except.UnprocessableEntityError().SetInternal(err)
@@ -71,13 +77,16 @@ func NewUpdateIncome(updateIncome usecase.UpdateIncome) UpdateIncome {
 			}(),
 			Amount:    req.Amount,
 			CreatedAt: req.CreatedAt,
+			Version:   version,
 		})
 		if err != nil {
 			return fmt.Errorf("updateIncome: %w", err)
 		}
 
+		api.SetETag(ctx, inc.Version)
+
 		return ctx.Status(http.StatusCreated).JSON(
-			api.NewResponse(http.StatusCreated, UpdateIncomeResponse{ID: inc.ID.Value}),
+			api.NewResponse(http.StatusCreated, UpdateIncomeResponse{ID: inc.ID.Value, Version: inc.Version}),
 		)
 	}
 }
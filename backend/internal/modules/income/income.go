@@ -6,6 +6,7 @@ import (
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 type Type string
@@ -91,7 +92,20 @@ func (i *Income) Delete() {
 	i.DeletedAt = &now
 }
 
+// Restore takes a deleted income out of the trash.
+func (i *Income) Restore() {
+	i.UpdatedAt = time.Now()
+	i.DeletedAt = nil
+}
+
 type Repository interface {
 	ddd.Repository[ID, Income]
 	GetUserMonthlyIncomes(ctx context.Context, userID user.ID, date *time.Time) ([]Income, error)
+	// GetDeletedByID returns the income only while it is in the trash.
+	GetDeletedByID(ctx context.Context, id ID) (*Income, error)
+	// PurgeDeleted permanently removes the incomes deleted before the given time and returns how
+	// many were removed.
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error)
+	// StoreWithEvents persists the income and writes the events to the outbox atomically.
+	StoreWithEvents(ctx context.Context, income *Income, events ...outbox.Event) error
 }
@@ -3,6 +3,7 @@ package income
 import (
 	"context"
 
+	nossasdespesas "github.com/Beigelman/nossas-despesas"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income/controller"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income/postgres"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
@@ -16,13 +17,30 @@ var Module = eon.NewModule("Income", func(ctx context.Context, c *di.Container,
 	di.Provide(c, usecase.NewCreateIncome)
 	di.Provide(c, usecase.NewUpdateIncome)
 	di.Provide(c, usecase.NewDeleteIncome)
+	di.Provide(c, usecase.NewRestoreIncome)
+	di.Provide(c, usecase.NewPurgeDeletedIncomes)
 	di.Provide(c, postgres.NewGetIncomesPerPeriod)
 	di.Provide(c, postgres.NewGetMonthlyIncome)
+	di.Provide(c, postgres.NewExportIncomes)
 	di.Provide(c, controller.NewCreateIncome)
 	di.Provide(c, controller.NewUpdateIncome)
 	di.Provide(c, controller.NewDeleteIncome)
+	di.Provide(c, controller.NewRestoreIncome)
+	di.Provide(c, controller.NewPurgeDeletedIncomesJob)
 	di.Provide(c, controller.NewGetMonthlyIncome)
 	di.Provide(c, controller.NewGetIncomesPerPeriod)
+	di.Provide(c, controller.NewExportIncomes)
 	// Register routes
 	lc.OnBooted(eon.HookOrders.APPEND, func() error { return di.Call(c, controller.Router) })
+	// Purge the incomes that stayed in the trash longer than the retention period
+	lc.OnBooted(eon.HookOrders.APPEND, func() error {
+		cfg := di.Resolve[*nossasdespesas.Config](c)
+		lc.Schedule(eon.Job{
+			Name:     "purge-incomes-trash",
+			Schedule: cfg.PurgeTrashSchedule(),
+			Locker:   di.Resolve[eon.Locker](c),
+			Run:      eon.JobFn(di.Resolve[controller.PurgeDeletedIncomesJob](c)),
+		})
+		return nil
+	})
 })
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	IncomeExportRow struct {
+		ID          int       `db:"id"`
+		CreatedAt   time.Time `db:"created_at"`
+		UserName    string    `db:"user_name"`
+		Type        string    `db:"type"`
+		AmountCents int       `db:"amount_cents"`
+	}
+
+	ExportIncomesInput struct {
+		GroupID int
+		UserID  int
+		Types   []string
+		// StartDate is inclusive and EndDate exclusive.
+		StartDate    *time.Time
+		EndDate      *time.Time
+		SortByAmount bool
+		Ascending    bool
+	}
+
+	// ExportIncomes calls each for every income of the group matching the input, as rows are read
+	// from the database.
+	ExportIncomes func(ctx context.Context, input ExportIncomesInput, each func(row IncomeExportRow) error) error
+)
+
+func NewExportIncomes(db *db.Client) ExportIncomes {
+	dbClient := db.Conn()
+	return func(ctx context.Context, input ExportIncomesInput, each func(row IncomeExportRow) error) error {
+		var args []any
+		arg := func(value any) string {
+			args = append(args, value)
+			return fmt.Sprintf("$%d", len(args))
+		}
+
+		conditions := []string{
+			"u.group_id = " + arg(input.GroupID),
+			"inc.deleted_at IS NULL",
+		}
+		if input.UserID != 0 {
+			conditions = append(conditions, "inc.user_id = "+arg(input.UserID))
+		}
+		if len(input.Types) > 0 {
+			conditions = append(conditions, "inc.type = ANY("+arg(input.Types)+")")
+		}
+		if input.StartDate != nil {
+			conditions = append(conditions, "inc.created_at >= "+arg(*input.StartDate))
+		}
+		if input.EndDate != nil {
+			conditions = append(conditions, "inc.created_at < "+arg(*input.EndDate))
+		}
+
+		column, direction := "inc.created_at", "DESC"
+		if input.SortByAmount {
+			column = "inc.amount_cents"
+		}
+		if input.Ascending {
+			direction = "ASC"
+		}
+
+		rows, err := dbClient.QueryxContext(ctx, fmt.Sprintf(`
+			SELECT
+				inc.id,
+				inc.created_at,
+				u.name AS user_name,
+				inc.type,
+				inc.amount_cents
+			FROM incomes inc
+			INNER JOIN users u ON inc.user_id = u.id
+			WHERE %s
+			ORDER BY %s %s, inc.id %s
+		`, strings.Join(conditions, " AND "), column, direction, direction), args...)
+		if err != nil {
+			return fmt.Errorf("db.QueryxContext: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var row IncomeExportRow
+			if err := rows.StructScan(&row); err != nil {
+				return fmt.Errorf("rows.StructScan: %w", err)
+			}
+
+			if err := each(row); err != nil {
+				return err
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("rows.Err: %w", err)
+		}
+
+		return nil
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -13,20 +12,21 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 type IncomeRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
 func NewIncomeRepository(db *db.Client) income.Repository {
-	return &IncomeRepository{db: db.Conn()}
+	return &IncomeRepository{db: db}
 }
 
 func (repo *IncomeRepository) GetNextID() income.ID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT nextval('incomes_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT nextval('incomes_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.Select: %w", err))
 	}
 
@@ -36,7 +36,7 @@ func (repo *IncomeRepository) GetNextID() income.ID {
 func (repo *IncomeRepository) GetByID(ctx context.Context, id income.ID) (*income.Income, error) {
 	var model IncomeModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
 		SELECT id, user_id, amount_cents, type, created_at, updated_at, deleted_at, version
 		FROM incomes WHERE id = $1
 		AND deleted_at IS NULL
@@ -52,6 +52,39 @@ func (repo *IncomeRepository) GetByID(ctx context.Context, id income.ID) (*incom
 	return toEntity(model), nil
 }
 
+func (repo *IncomeRepository) GetDeletedByID(ctx context.Context, id income.ID) (*income.Income, error) {
+	var model IncomeModel
+
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
+		SELECT id, user_id, amount_cents, type, created_at, updated_at, deleted_at, version
+		FROM incomes WHERE id = $1
+		AND deleted_at IS NOT NULL
+	`, id.Value).StructScan(&model); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("db.Select: %w", err)
+	}
+
+	return toEntity(model), nil
+}
+
+func (repo *IncomeRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	result, err := repo.db.Executor(ctx).ExecContext(ctx, `
+		DELETE FROM incomes WHERE deleted_at IS NOT NULL AND deleted_at < $1
+	`, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("db.Delete: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("db.Delete: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
 func (repo *IncomeRepository) GetUserMonthlyIncomes(ctx context.Context, userID user.ID, date *time.Time) ([]income.Income, error) {
 	var incomes []IncomeModel
 
@@ -60,7 +93,7 @@ func (repo *IncomeRepository) GetUserMonthlyIncomes(ctx context.Context, userID
 		d = *date
 	}
 
-	if err := repo.db.SelectContext(ctx, &incomes, `
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &incomes, `
 		SELECT id, user_id, amount_cents, type, created_at, updated_at, deleted_at, version
 		FROM incomes WHERE user_id = $1
 		AND EXTRACT(month FROM created_at) = $2
@@ -82,33 +115,55 @@ func (repo *IncomeRepository) GetUserMonthlyIncomes(ctx context.Context, userID
 }
 
 func (repo *IncomeRepository) Store(ctx context.Context, entity *income.Income) error {
-	model := toModel(entity)
-	if err := repo.create(ctx, model); err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			if err := repo.update(ctx, model); err != nil {
+	return repo.StoreWithEvents(ctx, entity)
+}
+
+func (repo *IncomeRepository) StoreWithEvents(ctx context.Context, entity *income.Income, events ...outbox.Event) error {
+	return repo.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		model := toModel(entity)
+
+		created, err := repo.create(ctx, tx, model)
+		if err != nil {
+			return fmt.Errorf("repo.create: %w", err)
+		}
+
+		if !created {
+			if err := repo.update(ctx, tx, model); err != nil {
 				return fmt.Errorf("repo.update: %w", err)
 			}
-			return nil
+			entity.Version = model.Version + 1
 		}
-		return fmt.Errorf("repo.create: %w", err)
-	}
 
-	return nil
+		if err := outbox.Write(ctx, tx, events...); err != nil {
+			return fmt.Errorf("outbox.Write: %w", err)
+		}
+
+		return nil
+	})
 }
 
-func (repo *IncomeRepository) create(ctx context.Context, model IncomeModel) error {
-	if _, err := repo.db.NamedExecContext(ctx, `
+// create inserts the income unless it already exists, reporting whether it did. Conflicts are not
+// raised as errors since they would abort the ongoing transaction.
+func (repo *IncomeRepository) create(ctx context.Context, tx *sqlx.Tx, model IncomeModel) (bool, error) {
+	result, err := tx.NamedExecContext(ctx, `
 		INSERT INTO incomes (id, user_id, amount_cents, type, created_at, updated_at, deleted_at, version)
 		VALUES (:id, :user_id, :amount_cents, :type, :created_at, :updated_at, :deleted_at, :version)
-	`, model); err != nil {
-		return fmt.Errorf("db.Insert: %w", err)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
 }
 
-func (repo *IncomeRepository) update(ctx context.Context, model IncomeModel) error {
-	result, err := repo.db.NamedExecContext(ctx, `
+func (repo *IncomeRepository) update(ctx context.Context, tx *sqlx.Tx, model IncomeModel) error {
+	result, err := tx.NamedExecContext(ctx, `
 		UPDATE incomes SET amount_cents = :amount_cents, type = :type, created_at = :created_at, updated_at = :updated_at, deleted_at = :deleted_at, version = version + 1
 		WHERE id = :id and version = :version
 	`, model)
@@ -122,7 +177,7 @@ func (repo *IncomeRepository) update(ctx context.Context, model IncomeModel) err
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("db.Update: sql: no rows affected")
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "incomes", model.ID))
 	}
 
 	return nil
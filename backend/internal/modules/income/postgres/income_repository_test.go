@@ -11,6 +11,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 )
 
 var userID = user.ID{Value: 1}
@@ -39,7 +40,7 @@ func (s *IncomeRepositoryTestSuite) SetupSuite() {
 }
 
 func (s *IncomeRepositoryTestSuite) TearDownTest() {
-	err := s.db.Clean("incomes")
+	err := s.db.Clean("incomes", "outbox_events")
 	s.NoError(err)
 }
 
@@ -55,6 +56,29 @@ func (s *IncomeRepositoryTestSuite) TestPgUserRepo_Store() {
 	s.NoError(s.repository.Store(s.ctx, inc))
 }
 
+func (s *IncomeRepositoryTestSuite) TestPgUserRepo_StoreWithEvents() {
+	inc := income.New(income.Attributes{
+		ID:     s.repository.GetNextID(),
+		Amount: 100,
+		UserID: userID,
+		Type:   income.Types.Salary,
+	})
+
+	s.NoError(s.repository.StoreWithEvents(s.ctx, inc, outbox.NewEvent("incomes.topic", map[string]string{"type": "income_created"})))
+
+	amount := 200
+	inc.Update(income.UpdateAttributes{Amount: &amount})
+	s.NoError(s.repository.StoreWithEvents(s.ctx, inc, outbox.NewEvent("incomes.topic", map[string]string{"type": "income_updated"})))
+
+	stored, err := s.repository.GetByID(s.ctx, inc.ID)
+	s.NoError(err)
+	s.Equal(200, stored.Amount)
+
+	var events []string
+	s.NoError(s.db.Conn().Select(&events, "SELECT payload->>'type' FROM outbox_events WHERE topic = 'incomes.topic' ORDER BY id"))
+	s.Equal([]string{"income_created", "income_updated"}, events)
+}
+
 func (s *IncomeRepositoryTestSuite) TestPgUserRepo_GetByID() {
 	id := s.repository.GetNextID()
 	expected := income.New(income.Attributes{
@@ -73,6 +97,62 @@ func (s *IncomeRepositoryTestSuite) TestPgUserRepo_GetByID() {
 	s.Equal(expected.Type, actual.Type)
 }
 
+func (s *IncomeRepositoryTestSuite) TestPgUserRepo_GetDeletedByID() {
+	inc := income.New(income.Attributes{
+		ID:     s.repository.GetNextID(),
+		Amount: 100,
+		UserID: userID,
+		Type:   income.Types.Salary,
+	})
+	s.NoError(s.repository.Store(s.ctx, inc))
+
+	deleted, err := s.repository.GetDeletedByID(s.ctx, inc.ID)
+	s.NoError(err)
+	s.Nil(deleted)
+
+	inc.Delete()
+	s.NoError(s.repository.Store(s.ctx, inc))
+
+	deleted, err = s.repository.GetDeletedByID(s.ctx, inc.ID)
+	s.NoError(err)
+	s.Equal(inc.ID, deleted.ID)
+	s.NotNil(deleted.DeletedAt)
+}
+
+func (s *IncomeRepositoryTestSuite) TestPgUserRepo_PurgeDeleted() {
+	newIncome := func() *income.Income {
+		inc := income.New(income.Attributes{
+			ID:     s.repository.GetNextID(),
+			Amount: 100,
+			UserID: userID,
+			Type:   income.Types.Salary,
+		})
+		s.NoError(s.repository.Store(s.ctx, inc))
+		return inc
+	}
+
+	kept := newIncome()
+	purged := newIncome()
+	purged.Delete()
+	s.NoError(s.repository.Store(s.ctx, purged))
+
+	count, err := s.repository.PurgeDeleted(s.ctx, time.Now().Add(-time.Hour))
+	s.NoError(err)
+	s.Equal(0, count)
+
+	count, err = s.repository.PurgeDeleted(s.ctx, time.Now())
+	s.NoError(err)
+	s.Equal(1, count)
+
+	deleted, err := s.repository.GetDeletedByID(s.ctx, purged.ID)
+	s.NoError(err)
+	s.Nil(deleted)
+
+	stored, err := s.repository.GetByID(s.ctx, kept.ID)
+	s.NoError(err)
+	s.NotNil(stored)
+}
+
 func (s *IncomeRepositoryTestSuite) TestPgUserRepo_GetUserMonthlyIncomes() {
 	thisMonth := time.Now()
 	lasMonth := time.Now().AddDate(0, -1, 0)
@@ -3,13 +3,13 @@ package usecase
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"time"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 )
 
@@ -28,7 +28,6 @@ type (
 func NewCreateIncome(
 	userRepo user.Repository,
 	incomeRepo income.Repository,
-	publisher pubsub.Publisher,
 ) CreateIncome {
 	return func(ctx context.Context, p CreateIncomeParams) (*income.Income, error) {
 		usr, err := userRepo.GetByID(ctx, p.UserID)
@@ -48,10 +47,6 @@ func NewCreateIncome(
 			CreatedAt: p.CreatedAt,
 		})
 
-		if err := incomeRepo.Store(ctx, inc); err != nil {
-			return nil, fmt.Errorf("incomeRepo.Store: %w", err)
-		}
-
 		event := pubsub.IncomeEvent{
 			Event: pubsub.Event{
 				SentAt:  time.Now(),
@@ -61,8 +56,8 @@ func NewCreateIncome(
 			},
 			Income: *inc,
 		}
-		if err := publisher.Publish(ctx, pubsub.IncomesTopic, event); err != nil {
-			slog.ErrorContext(ctx, "failed to publish income created event", "error", err)
+		if err := incomeRepo.StoreWithEvents(ctx, inc, outbox.NewEvent(pubsub.IncomesTopic, event)); err != nil {
+			return nil, fmt.Errorf("incomeRepo.StoreWithEvents: %w", err)
 		}
 
 		return inc, nil
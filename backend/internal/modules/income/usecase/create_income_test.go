@@ -12,6 +12,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
@@ -21,7 +22,6 @@ func TestCreateIncome(t *testing.T) {
 	ctx := context.Background()
 	userRepo := mocks.NewMockuserRepository(t)
 	incomeRepo := mocks.NewMockincomeRepository(t)
-	publisher := mocks.NewMockpubsubPublisher(t)
 
 	usr := user.New(user.Attributes{
 		ID:    user.ID{Value: 1},
@@ -37,7 +37,7 @@ func TestCreateIncome(t *testing.T) {
 		CreatedAt: nil,
 	}
 
-	useCase := usecase.NewCreateIncome(userRepo, incomeRepo, publisher)
+	useCase := usecase.NewCreateIncome(userRepo, incomeRepo)
 
 	t.Run("getUserByID returns error", func(t *testing.T) {
 		userRepo.EXPECT().GetByID(ctx, usr.ID).Return(nil, errors.New("test error")).Once()
@@ -56,17 +56,16 @@ func TestCreateIncome(t *testing.T) {
 	t.Run("store returns error", func(t *testing.T) {
 		incomeRepo.EXPECT().GetNextID().Return(income.ID{Value: 1}).Once()
 		userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, mock.Anything).Return(errors.New("test error")).Once()
 		inc, err := useCase(ctx, params)
-		assert.ErrorContains(t, err, "incomeRepo.Store: test error")
+		assert.ErrorContains(t, err, "incomeRepo.StoreWithEvents: test error")
 		assert.Nil(t, inc)
 	})
 
 	t.Run("success", func(t *testing.T) {
 		incomeRepo.EXPECT().GetNextID().Return(income.ID{Value: 1}).Once()
 		userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.IncomesTopic, mock.Anything).Return(nil).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, incomeEvent("income_created")).Return(nil).Once()
 		inc, err := useCase(ctx, params)
 		assert.NoError(t, err)
 		assert.NotNil(t, inc)
@@ -74,14 +73,12 @@ func TestCreateIncome(t *testing.T) {
 		assert.Equal(t, income.Types.Salary, inc.Type)
 		assert.Equal(t, 100, inc.Amount)
 	})
+}
 
-	t.Run("publish returns error (should not fail usecase)", func(t *testing.T) {
-		incomeRepo.EXPECT().GetNextID().Return(income.ID{Value: 1}).Once()
-		userRepo.EXPECT().GetByID(ctx, usr.ID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.IncomesTopic, mock.Anything).Return(errors.New("publish error")).Once()
-		inc, err := useCase(ctx, params)
-		assert.NoError(t, err)
-		assert.NotNil(t, inc)
+// incomeEvent matches the outbox event announcing an income change to the split ratio recalculation.
+func incomeEvent(eventType string) any {
+	return mock.MatchedBy(func(event outbox.Event) bool {
+		payload, ok := event.Payload.(pubsub.IncomeEvent)
+		return ok && event.Topic == pubsub.IncomesTopic && payload.Type == eventType
 	})
 }
@@ -3,13 +3,13 @@ package usecase
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"time"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 )
 
@@ -25,7 +25,6 @@ type (
 func NewDeleteIncome(
 	incomeRepo income.Repository,
 	userRepo user.Repository,
-	publisher pubsub.Publisher,
 ) DeleteIncome {
 	return func(ctx context.Context, p DeleteIncomeParams) (*income.Income, error) {
 		inc, err := incomeRepo.GetByID(ctx, p.ID)
@@ -48,10 +47,6 @@ func NewDeleteIncome(
 
 		inc.Delete()
 
-		if err := incomeRepo.Store(ctx, inc); err != nil {
-			return nil, fmt.Errorf("incomeRepo.Store: %w", err)
-		}
-
 		event := pubsub.IncomeEvent{
 			Event: pubsub.Event{
 				SentAt:  time.Now(),
@@ -61,8 +56,8 @@ func NewDeleteIncome(
 			},
 			Income: *inc,
 		}
-		if err := publisher.Publish(ctx, pubsub.IncomesTopic, event); err != nil {
-			slog.ErrorContext(ctx, "failed to publish income created event", "error", err)
+		if err := incomeRepo.StoreWithEvents(ctx, inc, outbox.NewEvent(pubsub.IncomesTopic, event)); err != nil {
+			return nil, fmt.Errorf("incomeRepo.StoreWithEvents: %w", err)
 		}
 
 		return inc, nil
@@ -12,7 +12,6 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
-	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
 
@@ -20,7 +19,6 @@ func TestDeleteIncome(t *testing.T) {
 	ctx := context.Background()
 	incomeRepo := mocks.NewMockincomeRepository(t)
 	userRepo := mocks.NewMockuserRepository(t)
-	publisher := mocks.NewMockpubsubPublisher(t)
 
 	usr := user.New(user.Attributes{
 		ID:    user.ID{Value: 1},
@@ -42,7 +40,7 @@ func TestDeleteIncome(t *testing.T) {
 		GroupID: group.ID{Value: 1},
 	}
 
-	useCase := usecase.NewDeleteIncome(incomeRepo, userRepo, publisher)
+	useCase := usecase.NewDeleteIncome(incomeRepo, userRepo)
 
 	t.Run("incomeRepo.GetByID returns error", func(t *testing.T) {
 		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(nil, errors.New("test error")).Once()
@@ -76,30 +74,19 @@ func TestDeleteIncome(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
-	t.Run("incomeRepo.Store returns error", func(t *testing.T) {
+	t.Run("incomeRepo.StoreWithEvents returns error", func(t *testing.T) {
 		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
 		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("store error")).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, mock.Anything).Return(errors.New("store error")).Once()
 		res, err := useCase(ctx, params)
-		assert.ErrorContains(t, err, "incomeRepo.Store: store error")
+		assert.ErrorContains(t, err, "incomeRepo.StoreWithEvents: store error")
 		assert.Nil(t, res)
 	})
 
 	t.Run("success", func(t *testing.T) {
 		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
 		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.IncomesTopic, mock.Anything).Return(nil).Once()
-		res, err := useCase(ctx, params)
-		assert.NoError(t, err)
-		assert.NotNil(t, res)
-	})
-
-	t.Run("publish returns error (should not fail usecase)", func(t *testing.T) {
-		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
-		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.IncomesTopic, mock.Anything).Return(errors.New("publish error")).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, incomeEvent("income_deleted")).Return(nil).Once()
 		res, err := useCase(ctx, params)
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
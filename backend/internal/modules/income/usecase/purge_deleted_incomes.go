@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+)
+
+type PurgeDeletedIncomes func(ctx context.Context, deletedBefore time.Time) (int, error)
+
+func NewPurgeDeletedIncomes(incomeRepo income.Repository) PurgeDeletedIncomes {
+	return func(ctx context.Context, deletedBefore time.Time) (int, error) {
+		purged, err := incomeRepo.PurgeDeleted(ctx, deletedBefore)
+		if err != nil {
+			return 0, fmt.Errorf("incomeRepo.PurgeDeleted: %w", err)
+		}
+
+		return purged, nil
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
+)
+
+type (
+	RestoreIncomeParams struct {
+		ID      income.ID
+		UserID  user.ID
+		GroupID group.ID
+	}
+	RestoreIncome func(ctx context.Context, p RestoreIncomeParams) (*income.Income, error)
+)
+
+func NewRestoreIncome(
+	incomeRepo income.Repository,
+	userRepo user.Repository,
+) RestoreIncome {
+	return func(ctx context.Context, p RestoreIncomeParams) (*income.Income, error) {
+		inc, err := incomeRepo.GetDeletedByID(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("incomeRepo.GetDeletedByID: %w", err)
+		}
+
+		if inc == nil {
+			return nil, except.NotFoundError("income not found in the trash")
+		}
+
+		usr, err := userRepo.GetByID(ctx, p.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("userRepo.GetByID: %w", err)
+		}
+
+		if usr == nil {
+			return nil, except.NotFoundError("user not found")
+		}
+
+		if !usr.HasFlag(user.EDIT_PARTNER_INCOME) && inc.UserID.Value != usr.ID.Value {
+			return nil, except.ForbiddenError("user mismatch")
+		}
+
+		inc.Restore()
+
+		// The income counts again in the split ratio of the month, so expenses are recalculated
+		event := pubsub.IncomeEvent{
+			Event: pubsub.Event{
+				SentAt:  time.Now(),
+				Type:    "income_restored",
+				UserID:  p.UserID,
+				GroupID: p.GroupID,
+			},
+			Income: *inc,
+		}
+		if err := incomeRepo.StoreWithEvents(ctx, inc, outbox.NewEvent(pubsub.IncomesTopic, event)); err != nil {
+			return nil, fmt.Errorf("incomeRepo.StoreWithEvents: %w", err)
+		}
+
+		return inc, nil
+	}
+}
@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income"
+	"github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestRestoreIncome(t *testing.T) {
+	ctx := context.Background()
+	incomeRepo := mocks.NewMockincomeRepository(t)
+	userRepo := mocks.NewMockuserRepository(t)
+
+	usr := user.New(user.Attributes{
+		ID:    user.ID{Value: 1},
+		Name:  "Test User",
+		Email: "email",
+	})
+
+	deletedIncome := func() *income.Income {
+		inc := income.New(income.Attributes{
+			ID:     income.ID{Value: 1},
+			UserID: usr.ID,
+			Amount: 100,
+			Type:   income.Types.Salary,
+		})
+		inc.Delete()
+		return inc
+	}
+
+	params := usecase.RestoreIncomeParams{
+		ID:      income.ID{Value: 1},
+		UserID:  usr.ID,
+		GroupID: group.ID{Value: 1},
+	}
+
+	useCase := usecase.NewRestoreIncome(incomeRepo, userRepo)
+
+	t.Run("incomeRepo.GetDeletedByID returns error", func(t *testing.T) {
+		incomeRepo.EXPECT().GetDeletedByID(ctx, params.ID).Return(nil, errors.New("test error")).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "incomeRepo.GetDeletedByID: test error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("income not in the trash", func(t *testing.T) {
+		incomeRepo.EXPECT().GetDeletedByID(ctx, params.ID).Return(nil, nil).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "income not found in the trash")
+		assert.Nil(t, res)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		incomeRepo.EXPECT().GetDeletedByID(ctx, params.ID).Return(deletedIncome(), nil).Once()
+		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(nil, nil).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "user not found")
+		assert.Nil(t, res)
+	})
+
+	t.Run("user mismatch", func(t *testing.T) {
+		incomeRepo.EXPECT().GetDeletedByID(ctx, params.ID).Return(deletedIncome(), nil).Once()
+		otherUser := user.New(user.Attributes{ID: user.ID{Value: 2}})
+		userRepo.EXPECT().GetByID(ctx, mock.Anything).Return(otherUser, nil).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "user mismatch")
+		assert.Nil(t, res)
+	})
+
+	t.Run("incomeRepo.StoreWithEvents returns error", func(t *testing.T) {
+		incomeRepo.EXPECT().GetDeletedByID(ctx, params.ID).Return(deletedIncome(), nil).Once()
+		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, mock.Anything).Return(errors.New("store error")).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "incomeRepo.StoreWithEvents: store error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		incomeRepo.EXPECT().GetDeletedByID(ctx, params.ID).Return(deletedIncome(), nil).Once()
+		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.MatchedBy(func(inc *income.Income) bool {
+			return inc.DeletedAt == nil
+		}), incomeEvent("income_restored")).Return(nil).Once()
+		res, err := useCase(ctx, params)
+		assert.NoError(t, err)
+		assert.Nil(t, res.DeletedAt)
+	})
+}
+
+func TestPurgeDeletedIncomes(t *testing.T) {
+	ctx := context.Background()
+	incomeRepo := mocks.NewMockincomeRepository(t)
+	useCase := usecase.NewPurgeDeletedIncomes(incomeRepo)
+	before := time.Date(2026, 9, 18, 0, 0, 0, 0, time.UTC)
+
+	t.Run("incomeRepo.PurgeDeleted returns error", func(t *testing.T) {
+		incomeRepo.EXPECT().PurgeDeleted(ctx, before).Return(0, errors.New("test error")).Once()
+		purged, err := useCase(ctx, before)
+		assert.ErrorContains(t, err, "incomeRepo.PurgeDeleted: test error")
+		assert.Zero(t, purged)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		incomeRepo.EXPECT().PurgeDeleted(ctx, before).Return(2, nil).Once()
+		purged, err := useCase(ctx, before)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+}
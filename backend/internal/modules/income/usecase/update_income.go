@@ -3,13 +3,13 @@ package usecase
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"time"
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
 )
 
@@ -21,6 +21,7 @@ type (
 		Type      *income.Type
 		Amount    *int
 		CreatedAt *time.Time
+		Version   *int
 	}
 	UpdateIncome func(ctx context.Context, p UpdateIncomeParams) (*income.Income, error)
 )
@@ -28,7 +29,6 @@ type (
 func NewUpdateIncome(
 	incomeRepo income.Repository,
 	userRepo user.Repository,
-	publisher pubsub.Publisher,
 ) UpdateIncome {
 	return func(ctx context.Context, p UpdateIncomeParams) (*income.Income, error) {
 		inc, err := incomeRepo.GetByID(ctx, p.ID)
@@ -36,6 +36,14 @@ func NewUpdateIncome(
 			return nil, fmt.Errorf("incomeRepo.GetByID: %w", err)
 		}
 
+		if inc == nil {
+			return nil, except.NotFoundError("income not found")
+		}
+
+		if err := inc.CheckVersion(p.Version); err != nil {
+			return nil, err
+		}
+
 		usr, err := userRepo.GetByID(ctx, p.UserID)
 		if err != nil {
 			return nil, fmt.Errorf("userRepo.GetByID: %w", err)
@@ -55,10 +63,6 @@ func NewUpdateIncome(
 			CreatedAt: p.CreatedAt,
 		})
 
-		if err := incomeRepo.Store(ctx, inc); err != nil {
-			return nil, fmt.Errorf("incomeRepo.Store: %w", err)
-		}
-
 		event := pubsub.IncomeEvent{
 			Event: pubsub.Event{
 				SentAt:  time.Now(),
@@ -68,8 +72,8 @@ func NewUpdateIncome(
 			},
 			Income: *inc,
 		}
-		if err := publisher.Publish(ctx, pubsub.IncomesTopic, event); err != nil {
-			slog.ErrorContext(ctx, "failed to publish income created event", "error", err)
+		if err := incomeRepo.StoreWithEvents(ctx, inc, outbox.NewEvent(pubsub.IncomesTopic, event)); err != nil {
+			return nil, fmt.Errorf("incomeRepo.StoreWithEvents: %w", err)
 		}
 
 		return inc, nil
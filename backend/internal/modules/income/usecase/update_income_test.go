@@ -13,7 +13,7 @@ import (
 	"github.com/Beigelman/nossas-despesas/internal/modules/income"
 	"github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
-	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
 )
 
@@ -22,7 +22,6 @@ func TestUpdateIncome(t *testing.T) {
 	ctx := context.Background()
 	incomeRepo := mocks.NewMockincomeRepository(t)
 	userRepo := mocks.NewMockuserRepository(t)
-	publisher := mocks.NewMockpubsubPublisher(t)
 
 	usr := user.New(user.Attributes{
 		ID:    user.ID{Value: 1},
@@ -47,7 +46,7 @@ func TestUpdateIncome(t *testing.T) {
 		CreatedAt: func() *time.Time { t := time.Now(); return &t }(),
 	}
 
-	useCase := usecase.NewUpdateIncome(incomeRepo, userRepo, publisher)
+	useCase := usecase.NewUpdateIncome(incomeRepo, userRepo)
 
 	t.Run("incomeRepo.GetByID returns error", func(t *testing.T) {
 		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(nil, errors.New("test error")).Once()
@@ -56,6 +55,22 @@ func TestUpdateIncome(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
+	t.Run("income not found", func(t *testing.T) {
+		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(nil, nil).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "income not found")
+		assert.Nil(t, res)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
+		stale := params
+		stale.Version = func() *int { v := inc.Version + 1; return &v }()
+		res, err := useCase(ctx, stale)
+		assert.ErrorIs(t, err, ddd.ErrVersionConflict)
+		assert.Nil(t, res)
+	})
+
 	t.Run("userRepo.GetByID returns error", func(t *testing.T) {
 		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
 		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(nil, errors.New("test error")).Once()
@@ -81,30 +96,19 @@ func TestUpdateIncome(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
-	t.Run("incomeRepo.Store returns error", func(t *testing.T) {
+	t.Run("incomeRepo.StoreWithEvents returns error", func(t *testing.T) {
 		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
 		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("store error")).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, mock.Anything).Return(errors.New("store error")).Once()
 		res, err := useCase(ctx, params)
-		assert.ErrorContains(t, err, "incomeRepo.Store: store error")
+		assert.ErrorContains(t, err, "incomeRepo.StoreWithEvents: store error")
 		assert.Nil(t, res)
 	})
 
 	t.Run("success", func(t *testing.T) {
 		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
 		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.IncomesTopic, mock.Anything).Return(nil).Once()
-		res, err := useCase(ctx, params)
-		assert.NoError(t, err)
-		assert.NotNil(t, res)
-	})
-
-	t.Run("publish returns error (should not fail usecase)", func(t *testing.T) {
-		incomeRepo.EXPECT().GetByID(ctx, params.ID).Return(inc, nil).Once()
-		userRepo.EXPECT().GetByID(ctx, params.UserID).Return(usr, nil).Once()
-		incomeRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
-		publisher.EXPECT().Publish(ctx, pubsub.IncomesTopic, mock.Anything).Return(errors.New("publish error")).Once()
+		incomeRepo.EXPECT().StoreWithEvents(ctx, mock.Anything, incomeEvent("income_updated")).Return(nil).Once()
 		res, err := useCase(ctx, params)
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
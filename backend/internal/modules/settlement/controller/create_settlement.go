@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	CreateSettlement func(ctx *fiber.Ctx) error
+
+	CreateSettlementRequest struct {
+		PayerID    *int       `json:"payer_id"`
+		ReceiverID int        `json:"receiver_id" validate:"required"`
+		Amount     int        `json:"amount" validate:"required,gt=0"`
+		Note       string     `json:"note" validate:"max=255"`
+		CreatedAt  *time.Time `json:"created_at"`
+	}
+
+	SettlementResponse struct {
+		ID         int       `json:"id"`
+		GroupID    int       `json:"group_id"`
+		PayerID    int       `json:"payer_id"`
+		ReceiverID int       `json:"receiver_id"`
+		Amount     int       `json:"amount"`
+		Note       string    `json:"note"`
+		CreatedAt  time.Time `json:"created_at"`
+		UpdatedAt  time.Time `json:"updated_at"`
+	}
+)
+
+func NewCreateSettlement(createSettlement usecase.CreateSettlement) CreateSettlement {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		var req CreateSettlementRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		var payerID int
+		if req.PayerID == nil {
+			payerID, ok = ctx.Locals("user_id").(int)
+			if !ok {
+				return except.BadRequestError("invalid user id")
+			}
+		} else {
+			payerID = *req.PayerID
+		}
+
+		stl, err := createSettlement(ctx.Context(), usecase.CreateSettlementParams{
+			GroupID:    group.ID{Value: groupID},
+			PayerID:    user.ID{Value: payerID},
+			ReceiverID: user.ID{Value: req.ReceiverID},
+			Amount:     req.Amount,
+			Note:       req.Note,
+			CreatedAt:  req.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("createSettlement: %w", err)
+		}
+
+		return ctx.Status(http.StatusCreated).JSON(
+			api.NewResponse(http.StatusCreated, SettlementResponse{
+				ID:         stl.ID.Value,
+				GroupID:    stl.GroupID.Value,
+				PayerID:    stl.PayerID.Value,
+				ReceiverID: stl.ReceiverID.Value,
+				Amount:     stl.Amount,
+				Note:       stl.Note,
+				CreatedAt:  stl.CreatedAt,
+				UpdatedAt:  stl.UpdatedAt,
+			}),
+		)
+	}
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	DeleteSettlement func(ctx *fiber.Ctx) error
+
+	DeleteSettlementResponse struct {
+		ID int `json:"id"`
+	}
+)
+
+func NewDeleteSettlement(deleteSettlement usecase.DeleteSettlement) DeleteSettlement {
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		settlementID, err := strconv.Atoi(ctx.Params("settlement_id"))
+		if err != nil {
+			return except.BadRequestError("invalid settlement id")
+		}
+
+		stl, err := deleteSettlement(ctx.Context(), usecase.DeleteSettlementParams{
+			ID:      settlement.ID{Value: settlementID},
+			GroupID: group.ID{Value: groupID},
+		})
+		if err != nil {
+			return fmt.Errorf("deleteSettlement: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, DeleteSettlementResponse{ID: stl.ID.Value}),
+		)
+	}
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	GetSettlements func(ctx *fiber.Ctx) error
+
+	GetSettlementsCursor struct {
+		LastSettlementID   int       `json:"last_settlement_id"`
+		LastSettlementDate time.Time `json:"last_settlement_date"`
+	}
+
+	GetSettlementsResponse struct {
+		Settlements []postgres.SettlementDetails `json:"settlements"`
+		NextToken   string                       `json:"next_token"`
+	}
+)
+
+func NewGetSettlements(getSettlements postgres.GetSettlements) GetSettlements {
+	const defaultLimit = 25
+
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid group id")
+		}
+
+		token, err := decodeCursor(ctx.Query("next_token", ""))
+		if err != nil {
+			return except.BadRequestError("invalid next token").SetInternal(err)
+		}
+
+		settlements, err := getSettlements(ctx.Context(), postgres.GetSettlementsInput{
+			GroupID:            groupID,
+			LastSettlementDate: token.LastSettlementDate,
+			LastSettlementID:   token.LastSettlementID,
+			Limit:              defaultLimit,
+		})
+		if err != nil {
+			return fmt.Errorf("query.GetSettlements: %w", err)
+		}
+
+		nextToken := ""
+		if len(settlements) == defaultLimit {
+			last := settlements[len(settlements)-1]
+			nextToken, err = encodeCursor(&GetSettlementsCursor{
+				LastSettlementDate: last.CreatedAt,
+				LastSettlementID:   last.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("encodeCursor: %w", err)
+			}
+		}
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, GetSettlementsResponse{
+			Settlements: settlements,
+			NextToken:   nextToken,
+		}))
+	}
+}
+
+func encodeCursor(cursor *GetSettlementsCursor) (string, error) {
+	serializedCursor, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(serializedCursor), nil
+}
+
+func decodeCursor(cursor string) (*GetSettlementsCursor, error) {
+	decodedCursor, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(decodedCursor) == "" {
+		return &GetSettlementsCursor{
+			LastSettlementDate: time.Now().AddDate(0, 2, 0),
+		}, nil
+	}
+
+	var cur *GetSettlementsCursor
+	if err := json.Unmarshal(decodedCursor, &cur); err != nil {
+		return nil, err
+	}
+
+	return cur, nil
+}
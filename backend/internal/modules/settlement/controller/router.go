@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/shared/middleware"
+)
+
+func Router(
+	server *fiber.App,
+	authMiddleware middleware.AuthMiddleware,
+	createSettlementHandler CreateSettlement,
+	getSettlementsHandler GetSettlements,
+	deleteSettlementHandler DeleteSettlement,
+) {
+	// Api group
+	api := server.Group("api")
+	// Api version V1
+	v1 := api.Group("v1")
+	// Settlement routes
+	settlement := v1.Group("group/settlements", authMiddleware)
+	settlement.Get("/", getSettlementsHandler)
+	settlement.Post("/", createSettlementHandler)
+	settlement.Delete("/:settlement_id", deleteSettlementHandler)
+}
@@ -0,0 +1,10 @@
+package settlement
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidAmount        = errors.New("invalid amount, must be greater than zero")
+	ErrSamePayerAndReceiver = errors.New("payer and receiver must be different users")
+)
@@ -0,0 +1,24 @@
+package settlement
+
+import (
+	"context"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/di"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/eon"
+)
+
+var Module = eon.NewModule("Settlement", func(ctx context.Context, c *di.Container, lc eon.LifeCycleManager, info eon.Info) {
+	// settlement
+	di.Provide(c, postgres.NewSettlementRepository)
+	di.Provide(c, postgres.NewGetSettlements)
+	di.Provide(c, usecase.NewCreateSettlement)
+	di.Provide(c, usecase.NewDeleteSettlement)
+	di.Provide(c, controller.NewCreateSettlement)
+	di.Provide(c, controller.NewGetSettlements)
+	di.Provide(c, controller.NewDeleteSettlement)
+	// Register routes
+	lc.OnBooted(eon.HookOrders.APPEND, func() error { return di.Call(c, controller.Router) })
+})
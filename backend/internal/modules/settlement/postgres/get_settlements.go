@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	SettlementDetails struct {
+		ID         int       `db:"id" json:"id"`
+		GroupID    int       `db:"group_id" json:"group_id"`
+		PayerID    int       `db:"payer_id" json:"payer_id"`
+		ReceiverID int       `db:"receiver_id" json:"receiver_id"`
+		Amount     float32   `db:"amount" json:"amount"`
+		Note       string    `db:"note" json:"note"`
+		CreatedAt  time.Time `db:"created_at" json:"created_at"`
+		UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+	}
+
+	GetSettlementsInput struct {
+		GroupID            int
+		LastSettlementDate time.Time
+		LastSettlementID   int
+		Limit              int
+	}
+
+	GetSettlements func(ctx context.Context, input GetSettlementsInput) ([]SettlementDetails, error)
+)
+
+func NewGetSettlements(db *db.Client) GetSettlements {
+	dbClient := db.Conn()
+	return func(ctx context.Context, input GetSettlementsInput) ([]SettlementDetails, error) {
+		var settlements []SettlementDetails
+		if err := dbClient.SelectContext(ctx, &settlements, `
+			SELECT
+				id,
+				group_id,
+				payer_id,
+				receiver_id,
+				amount_cents AS amount,
+				note,
+				created_at,
+				updated_at
+			FROM settlements
+			WHERE group_id = $1
+			AND (created_at < $2 OR (created_at = $2 AND id < $3))
+			AND deleted_at IS NULL
+			ORDER BY created_at DESC, id DESC
+			LIMIT $4
+		`, input.GroupID, input.LastSettlementDate, input.LastSettlementID, input.Limit); err != nil {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		return settlements, nil
+	}
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+func toEntity(model SettlementModel) *settlement.Settlement {
+	var deletedAt *time.Time
+	if model.DeletedAt.Valid {
+		deletedAt = &model.DeletedAt.Time
+	}
+
+	return &settlement.Settlement{
+		Entity: ddd.Entity[settlement.ID]{
+			ID:        settlement.ID{Value: model.ID},
+			CreatedAt: model.CreatedAt,
+			UpdatedAt: model.UpdatedAt,
+			DeletedAt: deletedAt,
+			Version:   model.Version,
+		},
+		GroupID:    group.ID{Value: model.GroupID},
+		PayerID:    user.ID{Value: model.PayerID},
+		ReceiverID: user.ID{Value: model.ReceiverID},
+		Amount:     model.Amount,
+		Note:       model.Note,
+	}
+}
+
+func toModel(entity *settlement.Settlement) SettlementModel {
+	deletedAt := sql.NullTime{Time: time.Time{}, Valid: false}
+	if entity.DeletedAt != nil {
+		deletedAt = sql.NullTime{Time: *entity.DeletedAt, Valid: true}
+	}
+
+	return SettlementModel{
+		ID:         entity.ID.Value,
+		GroupID:    entity.GroupID.Value,
+		PayerID:    entity.PayerID.Value,
+		ReceiverID: entity.ReceiverID.Value,
+		Amount:     entity.Amount,
+		Note:       entity.Note,
+		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
+		DeletedAt:  deletedAt,
+		Version:    entity.Version,
+	}
+}
@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+)
+
+type SettlementModel struct {
+	ID         int          `db:"id"`
+	GroupID    int          `db:"group_id"`
+	PayerID    int          `db:"payer_id"`
+	ReceiverID int          `db:"receiver_id"`
+	Amount     int          `db:"amount_cents"`
+	Note       string       `db:"note"`
+	CreatedAt  time.Time    `db:"created_at"`
+	UpdatedAt  time.Time    `db:"updated_at"`
+	DeletedAt  sql.NullTime `db:"deleted_at"`
+	Version    int          `db:"version"`
+}
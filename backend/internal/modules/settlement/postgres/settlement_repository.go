@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type SettlementRepository struct {
+	db *db.Client
+}
+
+func NewSettlementRepository(db *db.Client) settlement.Repository {
+	return &SettlementRepository{db: db}
+}
+
+func (repo *SettlementRepository) GetNextID() settlement.ID {
+	var nextValue int
+
+	if err := repo.db.Conn().QueryRowx("SELECT nextval('settlements_id_seq');").Scan(&nextValue); err != nil {
+		panic(fmt.Errorf("db.Select: %w", err))
+	}
+
+	return settlement.ID{Value: nextValue}
+}
+
+func (repo *SettlementRepository) GetByID(ctx context.Context, id settlement.ID) (*settlement.Settlement, error) {
+	var model SettlementModel
+
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
+		SELECT id, group_id, payer_id, receiver_id, amount_cents, note, created_at, updated_at, deleted_at, version
+		FROM settlements WHERE id = $1
+		AND deleted_at IS NULL
+	`, id.Value).StructScan(&model); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("db.Select: %w", err)
+	}
+
+	return toEntity(model), nil
+}
+
+func (repo *SettlementRepository) Store(ctx context.Context, entity *settlement.Settlement) error {
+	model := toModel(entity)
+	created, err := repo.create(ctx, model)
+	if err != nil {
+		return fmt.Errorf("repo.create: %w", err)
+	}
+
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (repo *SettlementRepository) create(ctx context.Context, model SettlementModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
+		INSERT INTO settlements (id, group_id, payer_id, receiver_id, amount_cents, note, created_at, updated_at, deleted_at, version)
+		VALUES (:id, :group_id, :payer_id, :receiver_id, :amount_cents, :note, :created_at, :updated_at, :deleted_at, :version)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repo *SettlementRepository) update(ctx context.Context, model SettlementModel) error {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
+		UPDATE settlements SET amount_cents = :amount_cents, note = :note, created_at = :created_at, updated_at = :updated_at, deleted_at = :deleted_at, version = version + 1
+		WHERE id = :id and version = :version
+	`, model)
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "settlements", model.ID))
+	}
+
+	return nil
+}
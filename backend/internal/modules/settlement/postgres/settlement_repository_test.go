@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+type SettlementRepositoryTestSuite struct {
+	suite.Suite
+	repository settlement.Repository
+	ctx        context.Context
+	db         *db.Client
+}
+
+func TestSettlementRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SettlementRepositoryTestSuite))
+}
+
+func (s *SettlementRepositoryTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+	s.repository = NewSettlementRepository(s.db)
+
+	_, err := s.db.Conn().Exec(`
+		INSERT INTO groups (id, name, created_at, updated_at, version)
+			VALUES (1, 'group', now(), now(), 0);
+		INSERT INTO users (id, name, email, group_id, created_at, updated_at, version)
+			VALUES (1, 'john', 'john@email.com', 1, now(), now(), 0),
+			       (2, 'jane', 'jane@email.com', 1, now(), now(), 0);`,
+	)
+	s.NoError(err)
+}
+
+func (s *SettlementRepositoryTestSuite) TearDownTest() {
+	err := s.db.Clean("settlements")
+	s.NoError(err)
+}
+
+func (s *SettlementRepositoryTestSuite) TestPgSettlementRepo_GetByID() {
+	expected, err := settlement.New(settlement.Attributes{
+		ID:         s.repository.GetNextID(),
+		GroupID:    group.ID{Value: 1},
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+		Amount:     1500,
+		Note:       "pix",
+	})
+	s.NoError(err)
+	s.NoError(s.repository.Store(s.ctx, expected))
+
+	actual, err := s.repository.GetByID(s.ctx, expected.ID)
+	s.NoError(err)
+	s.Equal(expected.ID, actual.ID)
+	s.Equal(expected.Amount, actual.Amount)
+	s.Equal(expected.Note, actual.Note)
+}
+
+func (s *SettlementRepositoryTestSuite) TestPgSettlementRepo_Delete() {
+	entity, err := settlement.New(settlement.Attributes{
+		ID:         s.repository.GetNextID(),
+		GroupID:    group.ID{Value: 1},
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+		Amount:     1500,
+	})
+	s.NoError(err)
+	s.NoError(s.repository.Store(s.ctx, entity))
+
+	entity.Delete()
+	s.NoError(s.repository.Store(s.ctx, entity))
+
+	actual, err := s.repository.GetByID(s.ctx, entity.ID)
+	s.NoError(err)
+	s.Nil(actual)
+}
+
+func (s *SettlementRepositoryTestSuite) TestPgSettlementRepo_StoreVersionConflict() {
+	entity, err := settlement.New(settlement.Attributes{
+		ID:         s.repository.GetNextID(),
+		GroupID:    group.ID{Value: 1},
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+		Amount:     1500,
+	})
+	s.NoError(err)
+	s.NoError(s.repository.Store(s.ctx, entity))
+
+	stale, err := s.repository.GetByID(s.ctx, entity.ID)
+	s.NoError(err)
+
+	entity.Delete()
+	s.NoError(s.repository.Store(s.ctx, entity))
+
+	stale.Note = "pix"
+	err = s.repository.Store(s.ctx, stale)
+	var conflict *ddd.VersionConflictError
+	s.ErrorAs(err, &conflict)
+	s.Equal(1, conflict.CurrentVersion)
+}
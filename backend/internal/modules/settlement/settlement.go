@@ -0,0 +1,81 @@
+package settlement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+type ID struct{ Value int }
+
+// Settlement is a payment from one member of the group to another to square up their balances.
+type Settlement struct {
+	ddd.Entity[ID]
+	GroupID    group.ID
+	PayerID    user.ID
+	ReceiverID user.ID
+	Amount     int
+	Note       string
+}
+
+type Attributes struct {
+	ID         ID
+	GroupID    group.ID
+	PayerID    user.ID
+	ReceiverID user.ID
+	Amount     int
+	Note       string
+	CreatedAt  *time.Time
+}
+
+func New(attr Attributes) (*Settlement, error) {
+	createdAt := time.Now()
+	if attr.CreatedAt != nil {
+		createdAt = *attr.CreatedAt
+	}
+
+	settlement := Settlement{
+		Entity: ddd.Entity[ID]{
+			ID:        attr.ID,
+			CreatedAt: createdAt,
+			UpdatedAt: time.Now(),
+			Version:   0,
+		},
+		GroupID:    attr.GroupID,
+		PayerID:    attr.PayerID,
+		ReceiverID: attr.ReceiverID,
+		Amount:     attr.Amount,
+		Note:       attr.Note,
+	}
+
+	if err := settlement.validate(); err != nil {
+		return nil, fmt.Errorf("settlement.Validate: %w", err)
+	}
+
+	return &settlement, nil
+}
+
+func (s *Settlement) Delete() {
+	now := time.Now()
+	s.DeletedAt = &now
+	s.UpdatedAt = now
+}
+
+func (s *Settlement) validate() error {
+	if s.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+
+	if s.PayerID == s.ReceiverID {
+		return ErrSamePayerAndReceiver
+	}
+
+	return nil
+}
+
+type Repository interface {
+	ddd.Repository[ID, Settlement]
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	CreateSettlementParams struct {
+		GroupID    group.ID
+		PayerID    user.ID
+		ReceiverID user.ID
+		Amount     int
+		Note       string
+		CreatedAt  *time.Time
+	}
+
+	CreateSettlement func(ctx context.Context, p CreateSettlementParams) (*settlement.Settlement, error)
+)
+
+func NewCreateSettlement(
+	userRepo user.Repository,
+	settlementRepo settlement.Repository,
+) CreateSettlement {
+	return func(ctx context.Context, p CreateSettlementParams) (*settlement.Settlement, error) {
+		for _, id := range []user.ID{p.PayerID, p.ReceiverID} {
+			usr, err := userRepo.GetByID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("userRepo.GetByID: %w", err)
+			}
+
+			if usr == nil {
+				return nil, except.NotFoundError("user not found")
+			}
+
+			if usr.GroupID == nil || *usr.GroupID != p.GroupID {
+				return nil, except.ForbiddenError("user does not belong to the group")
+			}
+		}
+
+		entity, err := settlement.New(settlement.Attributes{
+			ID:         settlementRepo.GetNextID(),
+			GroupID:    p.GroupID,
+			PayerID:    p.PayerID,
+			ReceiverID: p.ReceiverID,
+			Amount:     p.Amount,
+			Note:       p.Note,
+			CreatedAt:  p.CreatedAt,
+		})
+		if err != nil {
+			return nil, except.UnprocessableEntityError().SetInternal(fmt.Errorf("settlement.New: %w", err))
+		}
+
+		if err := settlementRepo.Store(ctx, entity); err != nil {
+			return nil, fmt.Errorf("settlementRepo.Store: %w", err)
+		}
+
+		return entity, nil
+	}
+}
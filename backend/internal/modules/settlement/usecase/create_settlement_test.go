@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestCreateSettlement(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockuserRepository(t)
+	settlementRepo := mocks.NewMocksettlementRepository(t)
+
+	groupID := group.ID{Value: 1}
+	payer := user.New(user.Attributes{ID: user.ID{Value: 1}, Name: "payer", Email: "payer@email.com", GroupID: &groupID})
+	receiver := user.New(user.Attributes{ID: user.ID{Value: 2}, Name: "receiver", Email: "receiver@email.com", GroupID: &groupID})
+	stranger := user.New(user.Attributes{ID: user.ID{Value: 2}, Name: "stranger", Email: "stranger@email.com"})
+
+	params := usecase.CreateSettlementParams{
+		GroupID:    groupID,
+		PayerID:    payer.ID,
+		ReceiverID: receiver.ID,
+		Amount:     1500,
+		Note:       "pix",
+	}
+
+	useCase := usecase.NewCreateSettlement(userRepo, settlementRepo)
+
+	t.Run("userRepo.GetByID returns error", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(nil, errors.New("test error")).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "userRepo.GetByID: test error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(nil, nil).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "user not found")
+		assert.Nil(t, res)
+	})
+
+	t.Run("user from another group", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(stranger, nil).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "user does not belong to the group")
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		settlementRepo.EXPECT().GetNextID().Return(settlement.ID{Value: 1}).Once()
+		invalid := params
+		invalid.Amount = 0
+		res, err := useCase(ctx, invalid)
+		assert.ErrorIs(t, err, settlement.ErrInvalidAmount)
+		assert.Nil(t, res)
+	})
+
+	t.Run("settlementRepo.Store returns error", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		settlementRepo.EXPECT().GetNextID().Return(settlement.ID{Value: 1}).Once()
+		settlementRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "settlementRepo.Store: test error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, payer.ID).Return(payer, nil).Once()
+		userRepo.EXPECT().GetByID(ctx, receiver.ID).Return(receiver, nil).Once()
+		settlementRepo.EXPECT().GetNextID().Return(settlement.ID{Value: 1}).Once()
+		settlementRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+		res, err := useCase(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, settlement.ID{Value: 1}, res.ID)
+		assert.Equal(t, payer.ID, res.PayerID)
+		assert.Equal(t, receiver.ID, res.ReceiverID)
+		assert.Equal(t, 1500, res.Amount)
+		assert.Equal(t, "pix", res.Note)
+	})
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	DeleteSettlementParams struct {
+		ID      settlement.ID
+		GroupID group.ID
+	}
+
+	DeleteSettlement func(ctx context.Context, p DeleteSettlementParams) (*settlement.Settlement, error)
+)
+
+func NewDeleteSettlement(settlementRepo settlement.Repository) DeleteSettlement {
+	return func(ctx context.Context, p DeleteSettlementParams) (*settlement.Settlement, error) {
+		entity, err := settlementRepo.GetByID(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("settlementRepo.GetByID: %w", err)
+		}
+
+		if entity == nil {
+			return nil, except.NotFoundError("settlement not found")
+		}
+
+		if entity.GroupID != p.GroupID {
+			return nil, except.ForbiddenError("settlement does not belong to the group")
+		}
+
+		entity.Delete()
+
+		if err := settlementRepo.Store(ctx, entity); err != nil {
+			return nil, fmt.Errorf("settlementRepo.Store: %w", err)
+		}
+
+		return entity, nil
+	}
+}
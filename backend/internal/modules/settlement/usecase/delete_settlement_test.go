@@ -0,0 +1,69 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	"github.com/Beigelman/nossas-despesas/internal/modules/settlement/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestDeleteSettlement(t *testing.T) {
+	ctx := context.Background()
+	settlementRepo := mocks.NewMocksettlementRepository(t)
+
+	newSettlement := func() *settlement.Settlement {
+		s, err := settlement.New(settlement.Attributes{
+			ID:         settlement.ID{Value: 1},
+			GroupID:    group.ID{Value: 1},
+			PayerID:    user.ID{Value: 1},
+			ReceiverID: user.ID{Value: 2},
+			Amount:     1500,
+		})
+		assert.NoError(t, err)
+		return s
+	}
+
+	params := usecase.DeleteSettlementParams{
+		ID:      settlement.ID{Value: 1},
+		GroupID: group.ID{Value: 1},
+	}
+
+	useCase := usecase.NewDeleteSettlement(settlementRepo)
+
+	t.Run("settlementRepo.GetByID returns error", func(t *testing.T) {
+		settlementRepo.EXPECT().GetByID(ctx, params.ID).Return(nil, errors.New("test error")).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "settlementRepo.GetByID: test error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("settlement not found", func(t *testing.T) {
+		settlementRepo.EXPECT().GetByID(ctx, params.ID).Return(nil, nil).Once()
+		res, err := useCase(ctx, params)
+		assert.ErrorContains(t, err, "settlement not found")
+		assert.Nil(t, res)
+	})
+
+	t.Run("settlement from another group", func(t *testing.T) {
+		settlementRepo.EXPECT().GetByID(ctx, params.ID).Return(newSettlement(), nil).Once()
+		res, err := useCase(ctx, usecase.DeleteSettlementParams{ID: params.ID, GroupID: group.ID{Value: 2}})
+		assert.ErrorContains(t, err, "settlement does not belong to the group")
+		assert.Nil(t, res)
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		settlementRepo.EXPECT().GetByID(ctx, params.ID).Return(newSettlement(), nil).Once()
+		settlementRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+		res, err := useCase(ctx, params)
+		assert.NoError(t, err)
+		assert.NotNil(t, res.DeletedAt)
+	})
+}
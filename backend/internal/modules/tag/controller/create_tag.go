@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	CreateTag func(ctx *fiber.Ctx) error
+
+	CreateTagRequest struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	TagResponse struct {
+		ID      int    `json:"id"`
+		Name    string `json:"name"`
+		Version int    `json:"version"`
+	}
+)
+
+func NewCreateTag(createTag usecase.CreateTag) CreateTag {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		var req CreateTagRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		tg, err := createTag(ctx.Context(), usecase.CreateTagParams{
+			GroupID: group.ID{Value: groupID},
+			Name:    req.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("CreateTag: %w", err)
+		}
+
+		return ctx.Status(http.StatusCreated).JSON(
+			api.NewResponse(http.StatusCreated, toTagResponse(tg)),
+		)
+	}
+}
+
+func toTagResponse(tg *tag.Tag) TagResponse {
+	return TagResponse{
+		ID:      tg.ID.Value,
+		Name:    tg.Name,
+		Version: tg.Version,
+	}
+}
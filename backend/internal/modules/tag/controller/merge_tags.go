@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	MergeTags func(ctx *fiber.Ctx) error
+
+	MergeTagsRequest struct {
+		SourceIDs []int `json:"source_ids" validate:"required,min=1,unique,dive,gt=0"`
+	}
+)
+
+// NewMergeTags folds the tags of the body into the one of the path.
+func NewMergeTags(mergeTags usecase.MergeTags) MergeTags {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		tagID, err := strconv.Atoi(ctx.Params("tag_id"))
+		if err != nil {
+			return except.BadRequestError("invalid tag id")
+		}
+
+		var req MergeTagsRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		sourceIDs := make([]tag.ID, len(req.SourceIDs))
+		for i, id := range req.SourceIDs {
+			sourceIDs[i] = tag.ID{Value: id}
+		}
+
+		var userID *user.ID
+		if id, ok := ctx.Locals("user_id").(int); ok {
+			userID = &user.ID{Value: id}
+		}
+
+		tg, err := mergeTags(ctx.Context(), usecase.MergeTagsParams{
+			GroupID:   group.ID{Value: groupID},
+			TargetID:  tag.ID{Value: tagID},
+			SourceIDs: sourceIDs,
+			UserID:    userID,
+		})
+		if err != nil {
+			return fmt.Errorf("MergeTags: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toTagResponse(tg)),
+		)
+	}
+}
@@ -0,0 +1,111 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestMergeTagsHandler(t *testing.T) {
+	t.Parallel()
+
+	target, err := tag.New(tag.Attributes{ID: tag.ID{Value: 1}, GroupID: group.ID{Value: 1}, Name: "viagem"})
+	assert.Nil(t, err)
+
+	// Definição dos casos de teste
+	testCases := []struct {
+		name             string
+		path             string
+		body             string
+		mockSetup        func(uc *mocks.MockusecaseMergeTags)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "should return 200 with the tag kept",
+			path: "/tags/1/merge",
+			body: `{"source_ids":[2,3]}`,
+			mockSetup: func(uc *mocks.MockusecaseMergeTags) {
+				uc.EXPECT().Execute(mock.Anything, usecase.MergeTagsParams{
+					GroupID:   group.ID{Value: 1},
+					TargetID:  tag.ID{Value: 1},
+					SourceIDs: []tag.ID{{Value: 2}, {Value: 3}},
+				}).Return(target, nil).Once()
+			},
+			expectedStatus: 200,
+		},
+		{
+			name:             "should return 400 if there is nothing to merge",
+			path:             "/tags/1/merge",
+			body:             `{"source_ids":[]}`,
+			mockSetup:        func(uc *mocks.MockusecaseMergeTags) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid request body","error":"invalid request body: internal=validation errors: [SourceIDs]: '[]' | Needs to implement 'min'"}`,
+		},
+		{
+			name:             "should return 400 if the tag id is invalid",
+			path:             "/tags/abc/merge",
+			body:             `{"source_ids":[2]}`,
+			mockSetup:        func(uc *mocks.MockusecaseMergeTags) {}, // Não precisa de mock para este caso
+			expectedStatus:   400,
+			expectedResponse: `{"status_code":400,"message":"invalid tag id","error":"invalid tag id"}`,
+		},
+		{
+			name: "should return 404 if a tag is not found",
+			path: "/tags/1/merge",
+			body: `{"source_ids":[9]}`,
+			mockSetup: func(uc *mocks.MockusecaseMergeTags) {
+				uc.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, except.NotFoundError("tag 9 not found")).Once()
+			},
+			expectedStatus:   404,
+			expectedResponse: `{"status_code":404,"message":"tag 9 not found","error":"MergeTags: tag 9 not found"}`,
+		},
+	}
+
+	// Setup comum para todos os testes
+	app := fiber.New(fiber.Config{ErrorHandler: api.ErrorHandler})
+	mergeTags := mocks.NewMockusecaseMergeTags(t)
+	app.Post("/tags/:tag_id/merge", func(c *fiber.Ctx) error {
+		c.Locals("group_id", 1)
+		return c.Next()
+	}, controller.NewMergeTags(mergeTags.Execute))
+
+	// Execução dos casos de teste
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(mergeTags)
+
+			req := httptest.NewRequest("POST", tc.path, bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err)
+			if tc.expectedResponse != "" {
+				assert.Equal(t, tc.expectedResponse, string(respBody))
+			} else {
+				var response api.Response[controller.TagResponse]
+				assert.Nil(t, json.Unmarshal(respBody, &response))
+				assert.Equal(t, controller.TagResponse{ID: 1, Name: "viagem"}, response.Data)
+			}
+			assert.NoError(t, resp.Body.Close())
+		})
+	}
+}
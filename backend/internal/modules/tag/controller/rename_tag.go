@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	RenameTag func(ctx *fiber.Ctx) error
+
+	RenameTagRequest struct {
+		Name string `json:"name" validate:"required"`
+	}
+)
+
+func NewRenameTag(renameTag usecase.RenameTag) RenameTag {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		groupID, ok := ctx.Locals("group_id").(int)
+		if !ok {
+			return except.UnprocessableEntityError("group_id not found in context")
+		}
+
+		tagID, err := strconv.Atoi(ctx.Params("tag_id"))
+		if err != nil {
+			return except.BadRequestError("invalid tag id")
+		}
+
+		var req RenameTagRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		tg, err := renameTag(ctx.Context(), usecase.RenameTagParams{
+			ID:      tag.ID{Value: tagID},
+			GroupID: group.ID{Value: groupID},
+			Name:    req.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("RenameTag: %w", err)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(
+			api.NewResponse(http.StatusOK, toTagResponse(tg)),
+		)
+	}
+}
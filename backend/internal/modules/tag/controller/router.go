@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/shared/middleware"
+)
+
+func Router(
+	server *fiber.App,
+	createTagHandler CreateTag,
+	getTagsHandler GetTags,
+	renameTagHandler RenameTag,
+	mergeTagsHandler MergeTags,
+	authMiddleware middleware.AuthMiddleware,
+) {
+	// Api group
+	api := server.Group("api")
+	// Api version V1
+	v1 := api.Group("v1")
+	// Tag routes
+	tag := v1.Group("tags", authMiddleware)
+	tag.Get("/", getTagsHandler)
+	tag.Post("/", createTagHandler)
+	tag.Patch("/:tag_id", renameTagHandler)
+	tag.Post("/:tag_id/merge", mergeTagsHandler)
+}
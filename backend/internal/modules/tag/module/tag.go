@@ -0,0 +1,25 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/controller"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/di"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/eon"
+)
+
+var Module = eon.NewModule("Tag", func(ctx context.Context, c *di.Container, lc eon.LifeCycleManager, info eon.Info) {
+	di.Provide(c, postgres.NewTagRepository)
+	di.Provide(c, usecase.NewCreateTag)
+	di.Provide(c, usecase.NewRenameTag)
+	di.Provide(c, usecase.NewMergeTags)
+	di.Provide(c, postgres.NewGetTags)
+	di.Provide(c, controller.NewCreateTag)
+	di.Provide(c, controller.NewGetTags)
+	di.Provide(c, controller.NewRenameTag)
+	di.Provide(c, controller.NewMergeTags)
+	// Register routes
+	lc.OnBooted(eon.HookOrders.APPEND, func() error { return di.Call(c, controller.Router) })
+})
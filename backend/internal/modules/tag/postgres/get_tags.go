@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type (
+	Tag struct {
+		ID   int    `db:"id" json:"id"`
+		Name string `db:"name" json:"name"`
+		// ExpenseCount is how many expenses, deleted ones aside, carry the tag.
+		ExpenseCount int `db:"expense_count" json:"expense_count"`
+	}
+
+	GetTags func(ctx context.Context, groupID int) ([]Tag, error)
+)
+
+func NewGetTags(db *db.Client) GetTags {
+	dbClient := db.Conn()
+	return func(ctx context.Context, groupID int) ([]Tag, error) {
+		tags := []Tag{}
+		if err := dbClient.SelectContext(ctx, &tags, `
+			SELECT
+				t.id,
+				t.name,
+				COUNT(ex.id) AS expense_count
+			FROM tags t
+			LEFT JOIN expenses_latest ex
+				ON t.id = ANY(ex.tag_ids)
+				AND ex.group_id = t.group_id
+				AND ex.deleted_at IS NULL
+			WHERE t.group_id = $1
+			GROUP BY t.id, t.name
+			ORDER BY t.name
+		`, groupID); err != nil {
+			return nil, fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		return tags, nil
+	}
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+func tagToEntity(model TagModel) *tag.Tag {
+	return &tag.Tag{
+		Entity: ddd.Entity[tag.ID]{
+			ID:        tag.ID{Value: model.ID},
+			CreatedAt: model.CreatedAt,
+			UpdatedAt: model.UpdatedAt,
+			Version:   model.Version,
+		},
+		GroupID: group.ID{Value: model.GroupID},
+		Name:    model.Name,
+	}
+}
+
+func tagToModel(entity *tag.Tag) TagModel {
+	return TagModel{
+		ID:        entity.ID.Value,
+		GroupID:   entity.GroupID.Value,
+		Name:      entity.Name,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		Version:   entity.Version,
+	}
+}
@@ -0,0 +1,12 @@
+package postgres
+
+import "time"
+
+type TagModel struct {
+	ID        int       `db:"id"`
+	GroupID   int       `db:"group_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	Version   int       `db:"version"`
+}
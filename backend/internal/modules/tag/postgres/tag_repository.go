@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+type TagRepository struct {
+	db *db.Client
+}
+
+func NewTagRepository(db *db.Client) tag.Repository {
+	return &TagRepository{db: db}
+}
+
+func (repo *TagRepository) GetNextID() tag.ID {
+	var nextValue int
+
+	if err := repo.db.Conn().QueryRowx("SELECT NEXTVAL('tags_id_seq');").Scan(&nextValue); err != nil {
+		panic(fmt.Errorf("db.QueryRow: %w", err))
+	}
+
+	return tag.ID{Value: nextValue}
+}
+
+func (repo *TagRepository) GetByID(ctx context.Context, id tag.ID) (*tag.Tag, error) {
+	var model TagModel
+
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
+		SELECT id, group_id, name, created_at, updated_at, version
+		FROM tags WHERE id = $1
+	`, id.Value).StructScan(&model); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("db.QueryRowContext: %w", err)
+	}
+
+	return tagToEntity(model), nil
+}
+
+func (repo *TagRepository) GetByName(ctx context.Context, groupID group.ID, name string) (*tag.Tag, error) {
+	var model TagModel
+
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
+		SELECT id, group_id, name, created_at, updated_at, version
+		FROM tags WHERE group_id = $1 AND name = $2
+	`, groupID.Value, name).StructScan(&model); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("db.QueryRowContext: %w", err)
+	}
+
+	return tagToEntity(model), nil
+}
+
+func (repo *TagRepository) Store(ctx context.Context, entity *tag.Tag) error {
+	model := tagToModel(entity)
+	created, err := repo.create(ctx, model)
+	if err != nil {
+		return fmt.Errorf("repo.create: %w", err)
+	}
+
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+		entity.Version = model.Version + 1
+	}
+
+	return nil
+}
+
+func (repo *TagRepository) Delete(ctx context.Context, ids []tag.ID) error {
+	tagIDs := make([]int, len(ids))
+	for i, id := range ids {
+		tagIDs[i] = id.Value
+	}
+
+	if _, err := repo.db.Executor(ctx).ExecContext(ctx, `DELETE FROM tags WHERE id = ANY($1)`, tagIDs); err != nil {
+		return fmt.Errorf("db.Delete: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *TagRepository) create(ctx context.Context, model TagModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
+		INSERT INTO tags (id, group_id, name, created_at, updated_at, version)
+		VALUES (:id, :group_id, :name, :created_at, :updated_at, :version)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repo *TagRepository) update(ctx context.Context, model TagModel) error {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
+		UPDATE tags SET name = :name, updated_at = :updated_at, version = version + 1
+		WHERE id = :id AND version = :version
+	`, model)
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("db.Update: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "tags", model.ID))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+)
+
+type TagRepositoryTestSuite struct {
+	suite.Suite
+	repository tag.Repository
+	ctx        context.Context
+	db         *db.Client
+}
+
+func TestTagRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TagRepositoryTestSuite))
+}
+
+func (s *TagRepositoryTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+	s.repository = postgres.NewTagRepository(s.db)
+}
+
+func (s *TagRepositoryTestSuite) TearDownTest() {
+	s.NoError(s.db.Clean())
+}
+
+func (s *TagRepositoryTestSuite) newTag(name string) *tag.Tag {
+	tg, err := tag.New(tag.Attributes{ID: s.repository.GetNextID(), GroupID: group.ID{Value: 1}, Name: name})
+	s.NoError(err)
+	s.NoError(s.repository.Store(s.ctx, tg))
+	return tg
+}
+
+func (s *TagRepositoryTestSuite) TestPgTagRepo_Store() {
+	tg := s.newTag("casamento")
+
+	s.NoError(tg.Rename("casamento-ana"))
+	s.NoError(s.repository.Store(s.ctx, tg))
+	s.Equal(1, tg.Version)
+
+	stored, err := s.repository.GetByName(s.ctx, group.ID{Value: 1}, "casamento-ana")
+	s.NoError(err)
+	s.Equal(tg.ID, stored.ID)
+
+	stored, err = s.repository.GetByName(s.ctx, group.ID{Value: 2}, "casamento-ana")
+	s.NoError(err)
+	s.Nil(stored)
+}
+
+func (s *TagRepositoryTestSuite) TestPgTagRepo_Delete() {
+	japao, viagem, casamento := s.newTag("japao"), s.newTag("viagem-japao"), s.newTag("casamento")
+
+	s.NoError(s.repository.Delete(s.ctx, []tag.ID{japao.ID, viagem.ID}))
+
+	for _, id := range []tag.ID{japao.ID, viagem.ID} {
+		removed, err := s.repository.GetByID(s.ctx, id)
+		s.NoError(err)
+		s.Nil(removed)
+	}
+
+	kept, err := s.repository.GetByID(s.ctx, casamento.ID)
+	s.NoError(err)
+	s.NotNil(kept)
+}
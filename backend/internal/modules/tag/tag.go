@@ -0,0 +1,86 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+// MaxNameLength is the longest a tag name can be, in characters.
+const MaxNameLength = 50
+
+var ErrInvalidName = errors.New("invalid tag name")
+
+type ID struct{ Value int }
+
+// Tag is a free-form label of the expenses of a group, such as "viagem-japão-2026", that cuts
+// across categories.
+type Tag struct {
+	ddd.Entity[ID]
+	GroupID group.ID
+	Name    string
+}
+
+type Attributes struct {
+	ID      ID
+	GroupID group.ID
+	Name    string
+}
+
+func New(attr Attributes) (*Tag, error) {
+	name, err := NormalizeName(attr.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tag{
+		Entity: ddd.Entity[ID]{
+			ID:        attr.ID,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			Version:   0,
+		},
+		GroupID: attr.GroupID,
+		Name:    name,
+	}, nil
+}
+
+func (t *Tag) Rename(name string) error {
+	normalized, err := NormalizeName(name)
+	if err != nil {
+		return err
+	}
+
+	t.Name = normalized
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// NormalizeName lowercases the name and joins its words with hyphens, so "Viagem Japão 2026" and
+// "viagem-japão-2026" are the same tag.
+func NormalizeName(name string) (string, error) {
+	normalized := strings.ToLower(strings.Join(strings.Fields(name), "-"))
+	if normalized == "" {
+		return "", fmt.Errorf("%w: name is required", ErrInvalidName)
+	}
+
+	if utf8.RuneCountInString(normalized) > MaxNameLength {
+		return "", fmt.Errorf("%w: name is longer than %d characters", ErrInvalidName, MaxNameLength)
+	}
+
+	return normalized, nil
+}
+
+type Repository interface {
+	ddd.Repository[ID, Tag]
+	GetByName(ctx context.Context, groupID group.ID, name string) (*Tag, error)
+	// Delete removes the tags. The expenses tagged with them must be retagged first.
+	Delete(ctx context.Context, ids []ID) error
+}
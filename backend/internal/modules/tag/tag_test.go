@@ -0,0 +1,42 @@
+package tag_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("should normalize the name", func(t *testing.T) {
+		tg, err := tag.New(tag.Attributes{ID: tag.ID{Value: 1}, GroupID: group.ID{Value: 1}, Name: "  Viagem Japão   2026 "})
+		assert.NoError(t, err)
+		assert.Equal(t, "viagem-japão-2026", tg.Name)
+	})
+
+	t.Run("should return error if the name is empty", func(t *testing.T) {
+		tg, err := tag.New(tag.Attributes{ID: tag.ID{Value: 1}, GroupID: group.ID{Value: 1}, Name: "   "})
+		assert.Nil(t, tg)
+		assert.ErrorIs(t, err, tag.ErrInvalidName)
+	})
+
+	t.Run("should return error if the name is too long", func(t *testing.T) {
+		tg, err := tag.New(tag.Attributes{ID: tag.ID{Value: 1}, GroupID: group.ID{Value: 1}, Name: strings.Repeat("ã", tag.MaxNameLength+1)})
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "invalid tag name: name is longer than 50 characters")
+	})
+}
+
+func TestRename(t *testing.T) {
+	tg, err := tag.New(tag.Attributes{ID: tag.ID{Value: 1}, GroupID: group.ID{Value: 1}, Name: "casamento"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, tg.Rename("Casamento Ana"))
+	assert.Equal(t, "casamento-ana", tg.Name)
+
+	assert.ErrorIs(t, tg.Rename(""), tag.ErrInvalidName)
+	assert.Equal(t, "casamento-ana", tg.Name)
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	CreateTagParams struct {
+		GroupID group.ID
+		Name    string
+	}
+
+	CreateTag func(ctx context.Context, p CreateTagParams) (*tag.Tag, error)
+)
+
+func NewCreateTag(tagRepo tag.Repository) CreateTag {
+	return func(ctx context.Context, p CreateTagParams) (*tag.Tag, error) {
+		newTag, err := tag.New(tag.Attributes{
+			ID:      tagRepo.GetNextID(),
+			GroupID: p.GroupID,
+			Name:    p.Name,
+		})
+		if err != nil {
+			return nil, except.UnprocessableEntityError(err.Error())
+		}
+
+		if err := checkNameAvailable(ctx, tagRepo, p.GroupID, newTag.Name); err != nil {
+			return nil, err
+		}
+
+		if err := tagRepo.Store(ctx, newTag); err != nil {
+			return nil, fmt.Errorf("tagRepo.Store: %w", err)
+		}
+
+		return newTag, nil
+	}
+}
+
+// checkNameAvailable fails with a conflict when another tag of the group already has the name.
+func checkNameAvailable(ctx context.Context, tagRepo tag.Repository, groupID group.ID, name string) error {
+	existing, err := tagRepo.GetByName(ctx, groupID, name)
+	if err != nil {
+		return fmt.Errorf("tagRepo.GetByName: %w", err)
+	}
+
+	if existing != nil {
+		return except.ConflictError(fmt.Sprintf("tag %s already exists", name))
+	}
+
+	return nil
+}
+
+// getGroupTag returns the tag, failing as not found when it belongs to another group.
+func getGroupTag(ctx context.Context, tagRepo tag.Repository, id tag.ID, groupID group.ID) (*tag.Tag, error) {
+	tg, err := tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("tagRepo.GetByID: %w", err)
+	}
+
+	if tg == nil || tg.GroupID != groupID {
+		return nil, except.NotFoundError(fmt.Sprintf("tag %d not found", id.Value))
+	}
+
+	return tg, nil
+}
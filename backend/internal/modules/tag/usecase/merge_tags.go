@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	MergeTagsParams struct {
+		GroupID group.ID
+		// TargetID is the tag that is kept, SourceIDs the ones folded into it.
+		TargetID  tag.ID
+		SourceIDs []tag.ID
+		// UserID is the user merging the tags, recorded as the author of the expenses retagged.
+		UserID *user.ID
+	}
+
+	MergeTags func(ctx context.Context, p MergeTagsParams) (*tag.Tag, error)
+)
+
+// NewMergeTags folds tags that mean the same, such as "japao" and "viagem-japao", into one: the
+// expenses of the sources get a new version tagged with the target and the sources are removed.
+// Earlier versions of the expenses are history and keep the tags they had.
+func NewMergeTags(tagRepo tag.Repository, expenseRepo expense.Repository, unitOfWork db.UnitOfWork) MergeTags {
+	return func(ctx context.Context, p MergeTagsParams) (*tag.Tag, error) {
+		if len(p.SourceIDs) == 0 {
+			return nil, except.UnprocessableEntityError("at least one tag to merge is needed")
+		}
+
+		if slices.Contains(p.SourceIDs, p.TargetID) {
+			return nil, except.UnprocessableEntityError("a tag cannot be merged into itself")
+		}
+
+		target, err := getGroupTag(ctx, tagRepo, p.TargetID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sourceID := range p.SourceIDs {
+			if _, err := getGroupTag(ctx, tagRepo, sourceID, p.GroupID); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := unitOfWork(ctx, func(ctx context.Context) error {
+			expenses, err := expenseRepo.GetByTagIDs(ctx, p.GroupID, p.SourceIDs)
+			if err != nil {
+				return fmt.Errorf("expenseRepo.GetByTagIDs: %w", err)
+			}
+
+			for i := range expenses {
+				tagIDs := make([]tag.ID, 0, len(expenses[i].TagIDs))
+				for _, tagID := range expenses[i].TagIDs {
+					if slices.Contains(p.SourceIDs, tagID) {
+						tagID = target.ID
+					}
+					tagIDs = append(tagIDs, tagID)
+				}
+
+				if err := expenses[i].Update(expense.UpdateAttributes{TagIDs: &tagIDs, UpdatedBy: p.UserID}); err != nil {
+					return except.UnprocessableEntityError().SetInternal(fmt.Errorf("expense.Update: %w", err))
+				}
+			}
+
+			if len(expenses) > 0 {
+				if err := expenseRepo.BulkStore(ctx, expenses); err != nil {
+					return fmt.Errorf("expenseRepo.BulkStore: %w", err)
+				}
+			}
+
+			if err := tagRepo.Delete(ctx, p.SourceIDs); err != nil {
+				return fmt.Errorf("tagRepo.Delete: %w", err)
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		return target, nil
+	}
+}
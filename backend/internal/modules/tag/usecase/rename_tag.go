@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type (
+	RenameTagParams struct {
+		ID      tag.ID
+		GroupID group.ID
+		Name    string
+	}
+
+	RenameTag func(ctx context.Context, p RenameTagParams) (*tag.Tag, error)
+)
+
+// NewRenameTag renames a tag on every expense carrying it. To rename a tag to the name of another
+// one, merge them instead.
+func NewRenameTag(tagRepo tag.Repository) RenameTag {
+	return func(ctx context.Context, p RenameTagParams) (*tag.Tag, error) {
+		tg, err := getGroupTag(ctx, tagRepo, p.ID, p.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		previous := tg.Name
+		if err := tg.Rename(p.Name); err != nil {
+			return nil, except.UnprocessableEntityError(err.Error())
+		}
+
+		if tg.Name == previous {
+			return tg, nil
+		}
+
+		if err := checkNameAvailable(ctx, tagRepo, p.GroupID, tg.Name); err != nil {
+			return nil, err
+		}
+
+		if err := tagRepo.Store(ctx, tg); err != nil {
+			return nil, fmt.Errorf("tagRepo.Store: %w", err)
+		}
+
+		return tg, nil
+	}
+}
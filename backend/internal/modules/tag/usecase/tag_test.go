@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/category"
+	"github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	"github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func newTag(t *testing.T, id, groupID int, name string) *tag.Tag {
+	tg, err := tag.New(tag.Attributes{ID: tag.ID{Value: id}, GroupID: group.ID{Value: groupID}, Name: name})
+	assert.NoError(t, err)
+	return tg
+}
+
+func TestCreateTag(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tagRepo := mocks.NewMocktagRepository(t)
+	createTag := usecase.NewCreateTag(tagRepo)
+	params := usecase.CreateTagParams{GroupID: group.ID{Value: 1}, Name: "Viagem Japão 2026"}
+
+	t.Run("should return error if the name is empty", func(t *testing.T) {
+		tagRepo.EXPECT().GetNextID().Return(tag.ID{Value: 1}).Once()
+
+		tg, err := createTag(ctx, usecase.CreateTagParams{GroupID: group.ID{Value: 1}, Name: " "})
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "invalid tag name: name is required")
+	})
+
+	t.Run("should return error if the group already has the tag", func(t *testing.T) {
+		tagRepo.EXPECT().GetNextID().Return(tag.ID{Value: 2}).Once()
+		tagRepo.EXPECT().GetByName(ctx, params.GroupID, "viagem-japão-2026").Return(newTag(t, 1, 1, "viagem-japão-2026"), nil).Once()
+
+		tg, err := createTag(ctx, params)
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "tag viagem-japão-2026 already exists")
+	})
+
+	t.Run("should create the tag", func(t *testing.T) {
+		tagRepo.EXPECT().GetNextID().Return(tag.ID{Value: 2}).Once()
+		tagRepo.EXPECT().GetByName(ctx, params.GroupID, "viagem-japão-2026").Return(nil, nil).Once()
+		tagRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+
+		tg, err := createTag(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, "viagem-japão-2026", tg.Name)
+		assert.Equal(t, params.GroupID, tg.GroupID)
+	})
+}
+
+func TestRenameTag(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tagRepo := mocks.NewMocktagRepository(t)
+	renameTag := usecase.NewRenameTag(tagRepo)
+	params := usecase.RenameTagParams{ID: tag.ID{Value: 1}, GroupID: group.ID{Value: 1}, Name: "Casamento Ana"}
+
+	t.Run("should return error if the tag belongs to another group", func(t *testing.T) {
+		tagRepo.EXPECT().GetByID(ctx, params.ID).Return(newTag(t, 1, 2, "casamento"), nil).Once()
+
+		tg, err := renameTag(ctx, params)
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "tag 1 not found")
+	})
+
+	t.Run("should return error if another tag has the name", func(t *testing.T) {
+		tagRepo.EXPECT().GetByID(ctx, params.ID).Return(newTag(t, 1, 1, "casamento"), nil).Once()
+		tagRepo.EXPECT().GetByName(ctx, params.GroupID, "casamento-ana").Return(newTag(t, 2, 1, "casamento-ana"), nil).Once()
+
+		tg, err := renameTag(ctx, params)
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "tag casamento-ana already exists")
+	})
+
+	t.Run("should rename the tag", func(t *testing.T) {
+		tagRepo.EXPECT().GetByID(ctx, params.ID).Return(newTag(t, 1, 1, "casamento"), nil).Once()
+		tagRepo.EXPECT().GetByName(ctx, params.GroupID, "casamento-ana").Return(nil, nil).Once()
+		tagRepo.EXPECT().Store(ctx, mock.MatchedBy(func(tg *tag.Tag) bool { return tg.Name == "casamento-ana" })).Return(nil).Once()
+
+		tg, err := renameTag(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, "casamento-ana", tg.Name)
+	})
+}
+
+func TestMergeTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tagRepo := mocks.NewMocktagRepository(t)
+	expenseRepo := mocks.NewMockexpenseRepository(t)
+	mergeTags := usecase.NewMergeTags(tagRepo, expenseRepo, dbtest.UnitOfWork)
+	params := usecase.MergeTagsParams{
+		GroupID:   group.ID{Value: 1},
+		TargetID:  tag.ID{Value: 1},
+		SourceIDs: []tag.ID{{Value: 2}, {Value: 3}},
+	}
+
+	t.Run("should return error if the target is one of the sources", func(t *testing.T) {
+		tg, err := mergeTags(ctx, usecase.MergeTagsParams{
+			GroupID:   group.ID{Value: 1},
+			TargetID:  tag.ID{Value: 1},
+			SourceIDs: []tag.ID{{Value: 1}},
+		})
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "a tag cannot be merged into itself")
+	})
+
+	t.Run("should return error if a source belongs to another group", func(t *testing.T) {
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 1}).Return(newTag(t, 1, 1, "japao"), nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 2}).Return(newTag(t, 2, 2, "viagem-japao"), nil).Once()
+
+		tg, err := mergeTags(ctx, params)
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "tag 2 not found")
+	})
+
+	t.Run("should return error if retagging the expenses fails", func(t *testing.T) {
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 1}).Return(newTag(t, 1, 1, "japao"), nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 2}).Return(newTag(t, 2, 1, "viagem-japao"), nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 3}).Return(newTag(t, 3, 1, "japão"), nil).Once()
+		expenseRepo.EXPECT().GetByTagIDs(ctx, params.GroupID, params.SourceIDs).Return([]expense.Expense{*newTaggedExpense(t, 2)}, nil).Once()
+		expenseRepo.EXPECT().BulkStore(ctx, mock.Anything).Return(errors.New("db error")).Once()
+
+		tg, err := mergeTags(ctx, params)
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "expenseRepo.BulkStore: db error")
+	})
+
+	t.Run("should return error if removing the sources fails", func(t *testing.T) {
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 1}).Return(newTag(t, 1, 1, "japao"), nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 2}).Return(newTag(t, 2, 1, "viagem-japao"), nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 3}).Return(newTag(t, 3, 1, "japão"), nil).Once()
+		expenseRepo.EXPECT().GetByTagIDs(ctx, params.GroupID, params.SourceIDs).Return(nil, nil).Once()
+		tagRepo.EXPECT().Delete(ctx, params.SourceIDs).Return(errors.New("db error")).Once()
+
+		tg, err := mergeTags(ctx, params)
+		assert.Nil(t, tg)
+		assert.EqualError(t, err, "tagRepo.Delete: db error")
+	})
+
+	t.Run("should merge the tags", func(t *testing.T) {
+		userID := user.ID{Value: 7}
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 1}).Return(newTag(t, 1, 1, "japao"), nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 2}).Return(newTag(t, 2, 1, "viagem-japao"), nil).Once()
+		tagRepo.EXPECT().GetByID(ctx, tag.ID{Value: 3}).Return(newTag(t, 3, 1, "japão"), nil).Once()
+		expenseRepo.EXPECT().GetByTagIDs(ctx, params.GroupID, params.SourceIDs).Return([]expense.Expense{
+			*newTaggedExpense(t, 1, 2, 4),
+			*newTaggedExpense(t, 3),
+		}, nil).Once()
+		// Every expense gets a new version with the target instead of the sources
+		expenseRepo.EXPECT().BulkStore(ctx, mock.MatchedBy(func(expenses []expense.Expense) bool {
+			return len(expenses) == 2 &&
+				assert.ObjectsAreEqual([]tag.ID{{Value: 1}, {Value: 4}}, expenses[0].TagIDs) &&
+				assert.ObjectsAreEqual([]tag.ID{{Value: 1}}, expenses[1].TagIDs) &&
+				expenses[0].Version == 1 && *expenses[0].UpdatedBy == userID
+		})).Return(nil).Once()
+		tagRepo.EXPECT().Delete(ctx, params.SourceIDs).Return(nil).Once()
+
+		p := params
+		p.UserID = &userID
+		tg, err := mergeTags(ctx, p)
+		assert.NoError(t, err)
+		assert.Equal(t, "japao", tg.Name)
+	})
+}
+
+func newTaggedExpense(t *testing.T, tagIDs ...int) *expense.Expense {
+	ids := make([]tag.ID, len(tagIDs))
+	for i, id := range tagIDs {
+		ids[i] = tag.ID{Value: id}
+	}
+
+	expns, err := expense.New(expense.Attributes{
+		ID:         expense.ID{Value: tagIDs[0]},
+		Name:       "Passagem",
+		Amount:     500000,
+		GroupID:    group.ID{Value: 1},
+		CategoryID: category.ID{Value: 1},
+		SplitRatio: expense.NewEqualSplitRatio(user.ID{Value: 1}, user.ID{Value: 2}),
+		SplitType:  expense.SplitTypes.Equal,
+		PayerID:    user.ID{Value: 1},
+		ReceiverID: user.ID{Value: 2},
+		TagIDs:     ids,
+	})
+	assert.NoError(t, err)
+
+	return expns
+}
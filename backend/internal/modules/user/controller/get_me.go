@@ -27,6 +27,8 @@ func NewGetMe(getUserID postgres.GetUserByID) GetMe {
 			return fmt.Errorf("query.getUser: %w", err)
 		}
 
+		api.SetETag(ctx, user.Version)
+
 		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, user))
 	}
 }
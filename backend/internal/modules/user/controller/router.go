@@ -9,6 +9,7 @@ import (
 func Router(
 	server *fiber.App,
 	getMyUserHandler GetMe,
+	updateMyUserHandler UpdateMe,
 	authMiddleware middleware.AuthMiddleware,
 ) {
 	// Api group
@@ -17,4 +18,5 @@ func Router(
 	v1 := api.Group("v1")
 	user := v1.Group("user", authMiddleware)
 	user.Get("/me", getMyUserHandler)
+	user.Patch("/me", updateMyUserHandler)
 }
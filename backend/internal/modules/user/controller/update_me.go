@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user/postgres"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/api"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/validator"
+)
+
+type (
+	UpdateMe func(ctx *fiber.Ctx) error
+
+	UpdateMeRequest struct {
+		PixKey *string `json:"pix_key" validate:"omitempty,max=77"`
+	}
+)
+
+func NewUpdateMe(updateUser usecase.UpdateUser, getUserByID postgres.GetUserByID) UpdateMe {
+	valid := validator.New()
+	return func(ctx *fiber.Ctx) error {
+		userID, ok := ctx.Locals("user_id").(int)
+		if !ok {
+			return except.BadRequestError("invalid user_id")
+		}
+
+		version, err := api.IfMatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		var req UpdateMeRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return except.UnprocessableEntityError().SetInternal(err)
+		}
+
+		if err := valid.Validate(req); err != nil {
+			return except.BadRequestError("invalid request body").SetInternal(err)
+		}
+
+		if _, err := updateUser(ctx.Context(), usecase.UpdateUserParams{
+			ID:      user.ID{Value: userID},
+			PixKey:  req.PixKey,
+			Version: version,
+		}); err != nil {
+			return fmt.Errorf("updateUser: %w", err)
+		}
+
+		usr, err := getUserByID(ctx.Context(), userID)
+		if err != nil {
+			return fmt.Errorf("query.getUser: %w", err)
+		}
+
+		api.SetETag(ctx, usr.Version)
+
+		return ctx.Status(http.StatusOK).JSON(api.NewResponse(http.StatusOK, usr))
+	}
+}
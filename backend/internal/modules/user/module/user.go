@@ -13,8 +13,10 @@ import (
 var Module = eon.NewModule("User", func(ctx context.Context, c *di.Container, lc eon.LifeCycleManager, info eon.Info) {
 	di.Provide(c, postgres.NewUserRepository)
 	di.Provide(c, usecase.NewCreateUser)
+	di.Provide(c, usecase.NewUpdateUser)
 	di.Provide(c, postgres.NewGetUserByID)
 	di.Provide(c, controller.NewGetMe)
+	di.Provide(c, controller.NewUpdateMe)
 
 	lc.OnBooted(eon.HookOrders.PREPEND, func() error {
 		return di.Call(c, controller.Router)
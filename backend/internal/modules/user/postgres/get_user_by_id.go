@@ -18,9 +18,11 @@ type User struct {
 	Email          string         `db:"email" json:"email"`
 	GroupID        *int           `db:"group_id" json:"group_id,omitempty"`
 	ProfilePicture *string        `db:"profile_picture" json:"profile_picture,omitempty"`
+	PixKey         *string        `db:"pix_key" json:"pix_key,omitempty"`
 	Flags          pq.StringArray `db:"flags"`
 	CreatedAt      string         `db:"created_at" json:"created_at"`
 	UpdatedAt      string         `db:"updated_at" json:"updated_at"`
+	Version        int            `db:"version" json:"version"`
 }
 
 type GetUserByID func(ctx context.Context, userID int) (*User, error)
@@ -30,7 +32,7 @@ func NewGetUserByID(db *db.Client) GetUserByID {
 	return func(ctx context.Context, userID int) (*User, error) {
 		var user User
 		if err := dbClient.GetContext(ctx, &user, `
-			SELECT id, name, email, profile_picture, group_id, flags, created_at, updated_at
+			SELECT id, name, email, profile_picture, pix_key, group_id, flags, created_at, updated_at, version
 			FROM users
 			WHERE id = $1	
 		`, userID); err != nil {
@@ -17,6 +17,11 @@ func toEntity(model UserModel) *user.User {
 		profilePicture = &model.ProfilePicture.String
 	}
 
+	var pixKey *string
+	if model.PixKey.Valid {
+		pixKey = &model.PixKey.String
+	}
+
 	var deletedAt *time.Time
 	if model.DeletedAt.Valid {
 		deletedAt = &model.DeletedAt.Time
@@ -45,6 +50,7 @@ func toEntity(model UserModel) *user.User {
 		Email:          model.Email,
 		Flags:          flags,
 		ProfilePicture: profilePicture,
+		PixKey:         pixKey,
 	}
 }
 
@@ -53,6 +59,10 @@ func toModel(entity *user.User) UserModel {
 	if entity.ProfilePicture != nil {
 		profilePicture = sql.NullString{String: *entity.ProfilePicture, Valid: true}
 	}
+	pixKey := sql.NullString{String: "", Valid: false}
+	if entity.PixKey != nil {
+		pixKey = sql.NullString{String: *entity.PixKey, Valid: true}
+	}
 	deletedAt := sql.NullTime{Time: time.Time{}, Valid: false}
 	if entity.DeletedAt != nil {
 		deletedAt = sql.NullTime{Time: *entity.DeletedAt, Valid: true}
@@ -74,6 +84,7 @@ func toModel(entity *user.User) UserModel {
 		Email:          entity.Email,
 		GroupID:        groupID,
 		ProfilePicture: profilePicture,
+		PixKey:         pixKey,
 		Flags:          flags,
 		CreatedAt:      entity.CreatedAt,
 		UpdatedAt:      entity.UpdatedAt,
@@ -13,6 +13,7 @@ type UserModel struct {
 	Email          string         `db:"email"`
 	GroupID        sql.NullInt64  `db:"group_id"`
 	ProfilePicture sql.NullString `db:"profile_picture"`
+	PixKey         sql.NullString `db:"pix_key"`
 	Flags          pq.StringArray `db:"flags"`
 	CreatedAt      time.Time      `db:"created_at"`
 	UpdatedAt      time.Time      `db:"updated_at"`
@@ -5,27 +5,25 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
-
-	"github.com/jmoiron/sqlx"
 
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 )
 
 type UserRepository struct {
-	db *sqlx.DB
+	db *db.Client
 }
 
 func NewUserRepository(db *db.Client) user.Repository {
-	return &UserRepository{db: db.Conn()}
+	return &UserRepository{db: db}
 }
 
 // GetNextID implements user.UserRepository.
 func (repo *UserRepository) GetNextID() user.ID {
 	var nextValue int
 
-	if err := repo.db.QueryRowx("SELECT NEXTVAL('users_id_seq');").Scan(&nextValue); err != nil {
+	if err := repo.db.Conn().QueryRowx("SELECT NEXTVAL('users_id_seq');").Scan(&nextValue); err != nil {
 		panic(fmt.Errorf("db.Select: %w", err))
 	}
 
@@ -36,8 +34,8 @@ func (repo *UserRepository) GetNextID() user.ID {
 func (repo *UserRepository) GetByID(ctx context.Context, id user.ID) (*user.User, error) {
 	var model UserModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
-		SELECT id, name, email, profile_picture, group_id, flags, created_at, updated_at, deleted_at, version
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
+		SELECT id, name, email, profile_picture, pix_key, group_id, flags, created_at, updated_at, deleted_at, version
 		FROM users WHERE id = $1
 		AND deleted_at IS NULL
 		ORDER BY version DESC
@@ -55,8 +53,8 @@ func (repo *UserRepository) GetByID(ctx context.Context, id user.ID) (*user.User
 func (repo *UserRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
 	var model UserModel
 
-	if err := repo.db.QueryRowxContext(ctx, `
-		SELECT id, name, email, profile_picture, group_id, flags, created_at, updated_at, deleted_at, version
+	if err := repo.db.Executor(ctx).QueryRowxContext(ctx, `
+		SELECT id, name, email, profile_picture, pix_key, group_id, flags, created_at, updated_at, deleted_at, version
 		FROM users WHERE email = $1
 		AND deleted_at IS NULL
 		ORDER BY version DESC
@@ -71,36 +69,65 @@ func (repo *UserRepository) GetByEmail(ctx context.Context, email string) (*user
 	return toEntity(model), nil
 }
 
+func (repo *UserRepository) GetByGroupID(ctx context.Context, groupID group.ID) ([]user.User, error) {
+	var models []UserModel
+
+	if err := repo.db.Executor(ctx).SelectContext(ctx, &models, `
+		SELECT id, name, email, profile_picture, pix_key, group_id, flags, created_at, updated_at, deleted_at, version
+		FROM users WHERE group_id = $1
+		AND deleted_at IS NULL
+		ORDER BY id
+	`, groupID.Value); err != nil {
+		return nil, fmt.Errorf("db.Select: %w", err)
+	}
+
+	users := make([]user.User, 0, len(models))
+	for _, model := range models {
+		users = append(users, *toEntity(model))
+	}
+
+	return users, nil
+}
+
 // Store implements user.UserRepository.
 func (repo *UserRepository) Store(ctx context.Context, entity *user.User) error {
 	model := toModel(entity)
-	if err := repo.create(ctx, model); err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			if err := repo.update(ctx, model); err != nil {
-				return fmt.Errorf("repo.update: %w", err)
-			}
-			return nil
-		}
+	created, err := repo.create(ctx, model)
+	if err != nil {
 		return fmt.Errorf("repo.create: %w", err)
 	}
 
+	if !created {
+		if err := repo.update(ctx, model); err != nil {
+			return fmt.Errorf("repo.update: %w", err)
+		}
+		entity.Version = model.Version + 1
+	}
+
 	return nil
 }
 
-func (repo *UserRepository) create(ctx context.Context, model UserModel) error {
-	if _, err := repo.db.NamedExecContext(ctx, `
-		INSERT INTO users (id, name, email, group_id, profile_picture, flags, created_at, updated_at, deleted_at, version)
-    VALUES (:id, :name, :email, :group_id, :profile_picture, :flags, :created_at, :updated_at, :deleted_at, :version)
-	`, model); err != nil {
-		return fmt.Errorf("db.Insert: %w", err)
+func (repo *UserRepository) create(ctx context.Context, model UserModel) (bool, error) {
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
+		INSERT INTO users (id, name, email, group_id, profile_picture, pix_key, flags, created_at, updated_at, deleted_at, version)
+    VALUES (:id, :name, :email, :group_id, :profile_picture, :pix_key, :flags, :created_at, :updated_at, :deleted_at, :version)
+		ON CONFLICT (id) DO NOTHING
+	`, model)
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("db.Insert: %w", err)
+	}
+
+	return rowsAffected > 0, nil
 }
 
 func (repo *UserRepository) update(ctx context.Context, model UserModel) error {
-	result, err := repo.db.NamedExecContext(ctx, `
-    UPDATE users SET name = :name, group_id = :group_id, profile_picture = :profile_picture, flags = :flags, updated_at = NOW(), deleted_at = :deleted_at, version = version + 1
+	result, err := repo.db.Executor(ctx).NamedExecContext(ctx, `
+    UPDATE users SET name = :name, group_id = :group_id, profile_picture = :profile_picture, pix_key = :pix_key, flags = :flags, updated_at = NOW(), deleted_at = :deleted_at, version = version + 1
 		WHERE id = :id AND version = :version
 	`, model)
 	if err != nil {
@@ -113,7 +140,7 @@ func (repo *UserRepository) update(ctx context.Context, model UserModel) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("db.Update: sql: no rows affected")
+		return fmt.Errorf("db.Update: %w", repo.db.VersionConflict(ctx, "users", model.ID))
 	}
 
 	return nil
@@ -7,10 +7,12 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user"
 	"github.com/Beigelman/nossas-despesas/internal/modules/user/postgres"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 )
 
 type UserRepositoryTestSuite struct {
@@ -47,6 +49,28 @@ func (s *UserRepositoryTestSuite) TestPgUserRepo_Store() {
 	s.NoError(s.repository.Store(s.ctx, usr))
 }
 
+func (s *UserRepositoryTestSuite) TestPgUserRepo_StoreVersionConflict() {
+	usr := user.New(user.Attributes{
+		ID:    s.repository.GetNextID(),
+		Name:  "John Doe",
+		Email: "john.conflict@email.com",
+	})
+	s.NoError(s.repository.Store(s.ctx, usr))
+
+	stale, err := s.repository.GetByID(s.ctx, usr.ID)
+	s.NoError(err)
+
+	usr.AddFlag(user.PREMIUM)
+	s.NoError(s.repository.Store(s.ctx, usr))
+	s.Equal(1, usr.Version)
+
+	stale.AddFlag(user.EDIT_PARTNER_INCOME)
+	err = s.repository.Store(s.ctx, stale)
+	var conflict *ddd.VersionConflictError
+	s.ErrorAs(err, &conflict)
+	s.Equal(1, conflict.CurrentVersion)
+}
+
 func (s *UserRepositoryTestSuite) TestPgUserRepo_GetByID() {
 	id := s.repository.GetNextID()
 	expected := user.New(user.Attributes{
@@ -92,3 +116,20 @@ func (s *UserRepositoryTestSuite) TestPgUserRepo_GetByEmail() {
 	s.Len(actual.Flags, 1)
 	s.Equal(user.PREMIUM, actual.Flags[0])
 }
+
+func (s *UserRepositoryTestSuite) TestPgUserRepo_GetByGroupID() {
+	groupID := group.ID{Value: 4242}
+
+	member := user.New(user.Attributes{ID: s.repository.GetNextID(), Name: "John Doe", Email: "john3@email.com"})
+	member.AssignGroup(groupID)
+	s.NoError(s.repository.Store(s.ctx, member))
+
+	outsider := user.New(user.Attributes{ID: s.repository.GetNextID(), Name: "Jane Doe", Email: "jane3@email.com"})
+	s.NoError(s.repository.Store(s.ctx, outsider))
+
+	members, err := s.repository.GetByGroupID(s.ctx, groupID)
+	s.NoError(err)
+	s.Len(members, 1)
+	s.Equal(member.ID, members[0].ID)
+	s.Equal(member.Email, members[0].Email)
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+type UpdateUserParams struct {
+	ID      user.ID
+	PixKey  *string
+	Version *int
+}
+
+type UpdateUser func(ctx context.Context, p UpdateUserParams) (*user.User, error)
+
+func NewUpdateUser(userRepo user.Repository) UpdateUser {
+	return func(ctx context.Context, p UpdateUserParams) (*user.User, error) {
+		usr, err := userRepo.GetByID(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("userRepo.GetByID: %w", err)
+		}
+
+		if usr == nil {
+			return nil, except.NotFoundError("user not found")
+		}
+
+		if err := usr.CheckVersion(p.Version); err != nil {
+			return nil, err
+		}
+
+		if err := usr.SetPixKey(p.PixKey); err != nil {
+			return nil, except.UnprocessableEntityError("invalid pix key").SetInternal(err)
+		}
+
+		if err := userRepo.Store(ctx, usr); err != nil {
+			return nil, fmt.Errorf("userRepo.Store: %w", err)
+		}
+
+		return usr, nil
+	}
+}
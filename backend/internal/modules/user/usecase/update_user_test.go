@@ -0,0 +1,75 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Beigelman/nossas-despesas/internal/modules/user"
+	"github.com/Beigelman/nossas-despesas/internal/modules/user/usecase"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pix"
+	"github.com/Beigelman/nossas-despesas/internal/shared/mocks"
+)
+
+func TestUpdateUser(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockuserRepository(t)
+	useCase := usecase.NewUpdateUser(userRepo)
+
+	newUser := func() *user.User {
+		return user.New(user.Attributes{ID: user.ID{Value: 1}, Name: "john", Email: "john@email.com"})
+	}
+	key := "123.456.789-09"
+
+	t.Run("userRepo.GetByID returns error", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 1}).Return(nil, errors.New("test error")).Once()
+		res, err := useCase(ctx, usecase.UpdateUserParams{ID: user.ID{Value: 1}, PixKey: &key})
+		assert.ErrorContains(t, err, "userRepo.GetByID: test error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 1}).Return(nil, nil).Once()
+		res, err := useCase(ctx, usecase.UpdateUserParams{ID: user.ID{Value: 1}, PixKey: &key})
+		assert.ErrorContains(t, err, "user not found")
+		assert.Nil(t, res)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		stale := 3
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 1}).Return(newUser(), nil).Once()
+		res, err := useCase(ctx, usecase.UpdateUserParams{ID: user.ID{Value: 1}, PixKey: &key, Version: &stale})
+		var conflict *ddd.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+		assert.Equal(t, 0, conflict.CurrentVersion)
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid pix key", func(t *testing.T) {
+		invalid := "not a key"
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 1}).Return(newUser(), nil).Once()
+		res, err := useCase(ctx, usecase.UpdateUserParams{ID: user.ID{Value: 1}, PixKey: &invalid})
+		assert.ErrorIs(t, err, pix.ErrInvalidKey)
+		assert.Nil(t, res)
+	})
+
+	t.Run("userRepo.Store returns error", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 1}).Return(newUser(), nil).Once()
+		userRepo.EXPECT().Store(ctx, mock.Anything).Return(errors.New("test error")).Once()
+		res, err := useCase(ctx, usecase.UpdateUserParams{ID: user.ID{Value: 1}, PixKey: &key})
+		assert.ErrorContains(t, err, "userRepo.Store: test error")
+		assert.Nil(t, res)
+	})
+
+	t.Run("happy path stores the normalized key", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, user.ID{Value: 1}).Return(newUser(), nil).Once()
+		userRepo.EXPECT().Store(ctx, mock.Anything).Return(nil).Once()
+		res, err := useCase(ctx, usecase.UpdateUserParams{ID: user.ID{Value: 1}, PixKey: &key})
+		assert.NoError(t, err)
+		assert.Equal(t, "12345678909", *res.PixKey)
+	})
+}
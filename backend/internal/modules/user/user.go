@@ -6,6 +6,7 @@ import (
 
 	"github.com/Beigelman/nossas-despesas/internal/modules/group"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pix"
 )
 
 type ID struct{ Value int }
@@ -16,6 +17,7 @@ type User struct {
 	Email          string
 	ProfilePicture *string
 	GroupID        *group.ID
+	PixKey         *string
 	Flags          []Flag
 }
 
@@ -74,6 +76,23 @@ func (u *User) SetEmail(email string) {
 	u.Email = email
 }
 
+// SetPixKey stores the key other members use to pay this user back. A nil or empty
+// key removes it.
+func (u *User) SetPixKey(key *string) error {
+	if key == nil || *key == "" {
+		u.PixKey = nil
+		return nil
+	}
+
+	normalized := pix.NormalizeKey(*key)
+	if err := pix.ValidateKey(normalized); err != nil {
+		return err
+	}
+
+	u.PixKey = &normalized
+	return nil
+}
+
 func (u *User) AssignGroup(g group.ID) {
 	u.GroupID = &g
 }
@@ -81,4 +100,6 @@ func (u *User) AssignGroup(g group.ID) {
 type Repository interface {
 	ddd.Repository[ID, User]
 	GetByEmail(ctx context.Context, email string) (*User, error)
+	// GetByGroupID returns the members of the group.
+	GetByGroupID(ctx context.Context, groupID group.ID) ([]User, error)
 }
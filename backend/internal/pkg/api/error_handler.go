@@ -10,6 +10,7 @@ import (
 	sentryfiber "github.com/getsentry/sentry-go/fiber"
 	"github.com/gofiber/fiber/v2"
 
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
 )
 
@@ -28,6 +29,15 @@ func ErrorHandler(ctx *fiber.Ctx, err error) error {
 		message = e.Message.(string)
 	}
 
+	var currentVersion *int
+	var conflict *ddd.VersionConflictError
+	if errors.As(err, &conflict) {
+		code = http.StatusConflict
+		message = "version conflict"
+		currentVersion = &conflict.CurrentVersion
+		SetETag(ctx, conflict.CurrentVersion)
+	}
+
 	hub := sentryfiber.GetHubFromContext(ctx)
 	if hub == nil {
 		hub = sentry.CurrentHub()
@@ -66,8 +76,9 @@ func ErrorHandler(ctx *fiber.Ctx, err error) error {
 	ctx.Set("Content-Type", "\"text/plain; charset=utf-8\"")
 
 	return ctx.Status(code).JSON(ErrorResponse{
-		StatusCode: code,
-		Message:    message,
-		Error:      errMsg,
+		StatusCode:     code,
+		Message:        message,
+		Error:          errMsg,
+		CurrentVersion: currentVersion,
 	})
 }
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/except"
+)
+
+// SetETag exposes the entity version so clients can send it back in the If-Match header.
+func SetETag(ctx *fiber.Ctx, version int) {
+	ctx.Set(fiber.HeaderETag, fmt.Sprintf(`"%d"`, version))
+}
+
+// IfMatch reads the version expected by the client from the If-Match header. It returns nil when
+// the header is absent or "*", meaning any version is accepted.
+func IfMatch(ctx *fiber.Ctx) (*int, error) {
+	header := strings.TrimSpace(ctx.Get(fiber.HeaderIfMatch))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	version, err := strconv.Atoi(tag)
+	if err != nil || version < 0 {
+		return nil, except.BadRequestError("invalid If-Match header")
+	}
+
+	return &version, nil
+}
@@ -31,6 +31,8 @@ var Module = eon.NewModule("Server", func(ctx context.Context, c *di.Container,
 			AppName:      info.ServiceName,
 			ReadTimeout:  5 * time.Second,
 			ErrorHandler: ErrorHandler,
+			// Leaves room for the largest attachment plus the rest of its multipart form
+			BodyLimit: 12 << 20,
 		})
 
 		server.Use(cors.New())
@@ -20,4 +20,6 @@ type ErrorResponse struct {
 	StatusCode int    `json:"status_code"`
 	Message    string `json:"message"`
 	Error      string `json:"error,omitempty"`
+	// CurrentVersion is set on version conflicts so the client can reload and retry.
+	CurrentVersion *int `json:"current_version,omitempty"`
 }
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// StreamAttachment sends the body produced by write as a file download, while it is being
+// written. Since the status is sent before write runs, its errors can only be logged and the
+// client ends up with a truncated file.
+func StreamAttachment(ctx *fiber.Ctx, filename, contentType string, write func(ctx context.Context, w io.Writer) error) error {
+	ctx.Attachment(filename)
+	ctx.Set(fiber.HeaderContentType, contentType)
+	ctx.Status(http.StatusOK)
+
+	requestCtx := ctx.Context()
+	requestCtx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := write(requestCtx, w); err != nil {
+			slog.ErrorContext(requestCtx, "failed to stream attachment", "filename", filename, "error", err)
+			return
+		}
+
+		if err := w.Flush(); err != nil {
+			slog.ErrorContext(requestCtx, "failed to flush attachment", "filename", filename, "error", err)
+		}
+	})
+
+	return nil
+}
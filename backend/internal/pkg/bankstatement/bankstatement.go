@@ -0,0 +1,149 @@
+// Package bankstatement reads the transactions of OFX files and of the CSV statements exported
+// by Brazilian banks.
+package bankstatement
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"cloud.google.com/go/civil"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+type Layout string
+
+var Layouts = struct {
+	OFX    Layout
+	Nubank Layout
+	Itau   Layout
+	Inter  Layout
+}{
+	OFX:    "ofx",
+	Nubank: "nubank",
+	Itau:   "itau",
+	Inter:  "inter",
+}
+
+var (
+	ErrUnknownLayout    = errors.New("unknown statement layout")
+	ErrInvalidStatement = errors.New("invalid statement")
+)
+
+// Transaction is an entry of a statement. Amount is in cents and negative when the money left
+// the account, whatever convention the bank uses. ID is the bank's identifier when the layout
+// has one, otherwise it is derived from the entry itself, so reading the same statement twice
+// yields the same IDs.
+type Transaction struct {
+	ID          string
+	Date        civil.Date
+	Description string
+	Amount      int
+}
+
+// Parse reads every transaction of the statement, in the order they appear.
+func Parse(r io.Reader, layout Layout) ([]Transaction, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	content, err = toUTF8(content)
+	if err != nil {
+		return nil, fmt.Errorf("toUTF8: %w", err)
+	}
+
+	var transactions []Transaction
+	switch layout {
+	case Layouts.OFX:
+		transactions, err = parseOFX(string(content))
+	case Layouts.Nubank, Layouts.Itau, Layouts.Inter:
+		transactions, err = parseCSV(content, csvLayouts[layout])
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLayout, layout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fillMissingIDs(transactions)
+
+	return transactions, nil
+}
+
+// toUTF8 drops the byte order mark and decodes the Windows-1252 files older bank systems still
+// export.
+func toUTF8(content []byte) ([]byte, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(content) {
+		return content, nil
+	}
+
+	return charmap.Windows1252.NewDecoder().Bytes(content)
+}
+
+// fillMissingIDs fingerprints the transactions without an ID. Identical entries, like two
+// coffees bought on the same day, are told apart by how many times they were seen before.
+func fillMissingIDs(transactions []Transaction) {
+	seen := make(map[string]int)
+	for i, transaction := range transactions {
+		if transaction.ID != "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s|%d|%s", transaction.Date, transaction.Amount, transaction.Description)
+		sum := sha1.Sum([]byte(key + "|" + strconv.Itoa(seen[key])))
+		seen[key]++
+
+		transactions[i].ID = hex.EncodeToString(sum[:])
+	}
+}
+
+// parseCents reads a decimal amount without going through floats. Thousand separators must be
+// removed beforehand and the decimal separator must be a dot.
+func parseCents(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimLeft(value, "+-")
+
+	units, decimals, _ := strings.Cut(value, ".")
+	if units == "" {
+		units = "0"
+	}
+	if len(decimals) > 2 {
+		return 0, fmt.Errorf("%w: too many decimals in %q", ErrInvalidStatement, value)
+	}
+	decimals += strings.Repeat("0", 2-len(decimals))
+
+	cents, err := strconv.Atoi(units + decimals)
+	if err != nil || cents < 0 {
+		return 0, fmt.Errorf("%w: invalid amount %q", ErrInvalidStatement, value)
+	}
+
+	if negative {
+		return -cents, nil
+	}
+	return cents, nil
+}
+
+// normalize lowercases the text and strips its accents, so headers match however the bank
+// spelled them.
+func normalize(text string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, err := transform.String(t, text)
+	if err != nil {
+		result = text
+	}
+
+	return strings.ToLower(strings.TrimSpace(result))
+}
@@ -0,0 +1,153 @@
+package bankstatement
+
+import (
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOFX(t *testing.T) {
+	t.Run("reads the sgml flavour", func(t *testing.T) {
+		statement := `OFXHEADER:100
+DATA:OFXSGML
+CHARSET:1252
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<BANKTRANLIST>
+<DTSTART>20250101
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250107120000[-3:BRT]
+<TRNAMT>-52,90
+<FITID>202501070001
+<MEMO>PADARIA PAO QUENTE
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20250110
+<TRNAMT>1500.00
+<FITID>202501100001
+<NAME>PIX RECEBIDO
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+		transactions, err := Parse(strings.NewReader(statement), Layouts.OFX)
+		assert.NoError(t, err)
+		assert.Equal(t, []Transaction{
+			{ID: "202501070001", Date: civil.Date{Year: 2025, Month: 1, Day: 7}, Description: "PADARIA PAO QUENTE", Amount: -5290},
+			{ID: "202501100001", Date: civil.Date{Year: 2025, Month: 1, Day: 10}, Description: "PIX RECEBIDO", Amount: 150000},
+		}, transactions)
+	})
+
+	t.Run("decodes windows-1252 files", func(t *testing.T) {
+		statement := "<OFX><STMTTRN><DTPOSTED>20250107<TRNAMT>-10.00<FITID>1<MEMO>A\xc7OUGUE</STMTTRN></OFX>"
+
+		transactions, err := Parse(strings.NewReader(statement), Layouts.OFX)
+		assert.NoError(t, err)
+		assert.Equal(t, "AÇOUGUE", transactions[0].Description)
+	})
+
+	t.Run("rejects files that are not ofx", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("date,title,amount"), Layouts.OFX)
+		assert.ErrorIs(t, err, ErrInvalidStatement)
+	})
+}
+
+func TestParseCSV(t *testing.T) {
+	testCases := []struct {
+		name      string
+		layout    Layout
+		statement string
+		expected  []Transaction
+	}{
+		{
+			name:      "nubank credit card, where purchases are positive",
+			layout:    Layouts.Nubank,
+			statement: "\xef\xbb\xbfdate,title,amount\n2025-01-07,Padaria,52.9\n2025-01-08,Pagamento recebido,-300.00\n",
+			expected: []Transaction{
+				{Date: civil.Date{Year: 2025, Month: 1, Day: 7}, Description: "Padaria", Amount: -5290},
+				{Date: civil.Date{Year: 2025, Month: 1, Day: 8}, Description: "Pagamento recebido", Amount: 30000},
+			},
+		},
+		{
+			name:      "nubank checking account",
+			layout:    Layouts.Nubank,
+			statement: "Data,Valor,Identificador,Descrição\n07/01/2025,-52.90,6780a1b2,Compra no débito - Padaria\n",
+			expected: []Transaction{
+				{ID: "6780a1b2", Date: civil.Date{Year: 2025, Month: 1, Day: 7}, Description: "Compra no débito - Padaria", Amount: -5290},
+			},
+		},
+		{
+			name:      "itau without header, skipping balances",
+			layout:    Layouts.Itau,
+			statement: "07/01/2025;SALDO ANTERIOR;1.000,00\n07/01/2025;PIX TRANSF MERCADO;-1.234,56\n",
+			expected: []Transaction{
+				{Date: civil.Date{Year: 2025, Month: 1, Day: 7}, Description: "PIX TRANSF MERCADO", Amount: -123456},
+			},
+		},
+		{
+			name:   "inter with the account summary on top",
+			layout: Layouts.Inter,
+			statement: "Extrato Conta Corrente\nConta ;12345\nPeríodo ;01/01/2025 a 31/01/2025\n\n" +
+				"Data Lançamento;Histórico;Descrição;Valor;Saldo\n" +
+				"07/01/2025;Pix enviado ;Fulano de Tal;-R$ 80,00;920,00\n",
+			expected: []Transaction{
+				{Date: civil.Date{Year: 2025, Month: 1, Day: 7}, Description: "Pix enviado - Fulano de Tal", Amount: -8000},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transactions, err := Parse(strings.NewReader(tc.statement), tc.layout)
+			assert.NoError(t, err)
+			assert.Len(t, transactions, len(tc.expected))
+
+			for i := range transactions {
+				if tc.expected[i].ID == "" {
+					assert.NotEmpty(t, transactions[i].ID)
+					transactions[i].ID = ""
+				}
+			}
+			assert.Equal(t, tc.expected, transactions)
+		})
+	}
+
+	t.Run("rejects files of another bank", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("date,title,amount\n2025-01-07,Padaria,52.9\n"), Layouts.Inter)
+		assert.ErrorIs(t, err, ErrInvalidStatement)
+	})
+
+	t.Run("rejects unknown layouts", func(t *testing.T) {
+		_, err := Parse(strings.NewReader(""), Layout("bradesco"))
+		assert.ErrorIs(t, err, ErrUnknownLayout)
+	})
+}
+
+func TestFillMissingIDs(t *testing.T) {
+	statement := "date,title,amount\n2025-01-07,Café,5.00\n2025-01-07,Café,5.00\n"
+
+	first, err := Parse(strings.NewReader(statement), Layouts.Nubank)
+	assert.NoError(t, err)
+	second, err := Parse(strings.NewReader(statement), Layouts.Nubank)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first[0].ID, first[1].ID, "identical entries get their own ids")
+	assert.Equal(t, first, second, "ids are stable across imports")
+}
+
+func TestParseCents(t *testing.T) {
+	for value, expected := range map[string]int{"52.9": 5290, "-0.05": -5, "+10": 1000, "1234.56": 123456} {
+		cents, err := parseCents(value)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, cents, value)
+	}
+
+	_, err := parseCents("1.234")
+	assert.ErrorIs(t, err, ErrInvalidStatement)
+}
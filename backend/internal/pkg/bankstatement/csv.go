@@ -0,0 +1,207 @@
+package bankstatement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+// csvLayout describes a CSV export. Columns are found by their normalized header, so extra or
+// reordered columns do not matter, and the rows above the header, like the account summary Inter
+// writes on top of the file, are skipped.
+type csvLayout struct {
+	comma        rune
+	dateLayout   string
+	decimalComma bool
+	// purchasesPositive tells the amounts are written from the card holder's point of view,
+	// where a purchase is positive.
+	purchasesPositive bool
+	date              string
+	amount            string
+	// descriptions are joined, since some banks split the kind of the entry from its counterpart.
+	descriptions []string
+	id           string
+	// headerless accepts files starting straight at the entries, in date, description and amount
+	// order.
+	headerless bool
+	// balances are the prefixes of the descriptions of rows that only report the balance.
+	balances []string
+}
+
+type csvColumns struct {
+	date, amount, id int
+	descriptions     []int
+}
+
+// csvLayouts lists the variants of each bank, tried in order. Nubank exports its credit card
+// and its checking account statements differently.
+var csvLayouts = map[Layout][]csvLayout{
+	Layouts.Nubank: {
+		{
+			comma:             ',',
+			dateLayout:        time.DateOnly,
+			purchasesPositive: true,
+			date:              "date",
+			amount:            "amount",
+			descriptions:      []string{"title"},
+		},
+		{
+			comma:        ',',
+			dateLayout:   "02/01/2006",
+			date:         "data",
+			amount:       "valor",
+			descriptions: []string{"descricao"},
+			id:           "identificador",
+		},
+	},
+	Layouts.Itau: {
+		{
+			comma:        ';',
+			dateLayout:   "02/01/2006",
+			decimalComma: true,
+			date:         "data",
+			amount:       "valor",
+			descriptions: []string{"lancamento"},
+			headerless:   true,
+			balances:     []string{"saldo"},
+		},
+	},
+	Layouts.Inter: {
+		{
+			comma:        ';',
+			dateLayout:   "02/01/2006",
+			decimalComma: true,
+			date:         "data lancamento",
+			amount:       "valor",
+			descriptions: []string{"historico", "descricao"},
+		},
+	},
+}
+
+func parseCSV(content []byte, variants []csvLayout) ([]Transaction, error) {
+	for _, layout := range variants {
+		reader := csv.NewReader(bytes.NewReader(content))
+		reader.Comma = layout.comma
+		reader.FieldsPerRecord = -1
+		reader.LazyQuotes = true
+
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidStatement, err)
+		}
+
+		columns, start, ok := layout.locate(records)
+		if !ok {
+			continue
+		}
+
+		var transactions []Transaction
+		for _, record := range records[start:] {
+			transaction, ok, err := layout.transaction(record, columns)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				transactions = append(transactions, transaction)
+			}
+		}
+
+		return transactions, nil
+	}
+
+	return nil, fmt.Errorf("%w: no known header found", ErrInvalidStatement)
+}
+
+// locate finds the columns of the layout and the row where the entries start.
+func (l csvLayout) locate(records [][]string) (csvColumns, int, bool) {
+	for i, record := range records {
+		header := make(map[string]int, len(record))
+		for j, name := range record {
+			header[normalize(name)] = j
+		}
+
+		columns := csvColumns{id: -1}
+		date, hasDate := header[l.date]
+		amount, hasAmount := header[l.amount]
+		for _, description := range l.descriptions {
+			if j, ok := header[description]; ok {
+				columns.descriptions = append(columns.descriptions, j)
+			}
+		}
+		if hasDate && hasAmount && len(columns.descriptions) > 0 {
+			columns.date, columns.amount = date, amount
+			if j, ok := header[l.id]; ok && l.id != "" {
+				columns.id = j
+			}
+			return columns, i + 1, true
+		}
+
+		if l.headerless && len(record) >= 3 {
+			if _, err := time.Parse(l.dateLayout, strings.TrimSpace(record[0])); err == nil {
+				return csvColumns{date: 0, descriptions: []int{1}, amount: 2, id: -1}, i, true
+			}
+		}
+	}
+
+	return csvColumns{}, 0, false
+}
+
+// transaction reads an entry, skipping blank rows and the daily balances some banks write
+// between the entries.
+func (l csvLayout) transaction(record []string, columns csvColumns) (Transaction, bool, error) {
+	field := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rawDate, rawAmount := field(columns.date), field(columns.amount)
+	if rawDate == "" || rawAmount == "" {
+		return Transaction{}, false, nil
+	}
+
+	date, err := time.Parse(l.dateLayout, rawDate)
+	if err != nil {
+		return Transaction{}, false, fmt.Errorf("%w: invalid date %q", ErrInvalidStatement, rawDate)
+	}
+
+	rawAmount = strings.NewReplacer("R$", "", " ", "", "\u00a0", "").Replace(rawAmount)
+	if l.decimalComma {
+		rawAmount = strings.ReplaceAll(rawAmount, ".", "")
+		rawAmount = strings.ReplaceAll(rawAmount, ",", ".")
+	}
+
+	amount, err := parseCents(rawAmount)
+	if err != nil {
+		return Transaction{}, false, err
+	}
+	if l.purchasesPositive {
+		amount = -amount
+	}
+
+	var descriptions []string
+	for _, i := range columns.descriptions {
+		if description := field(i); description != "" {
+			descriptions = append(descriptions, description)
+		}
+	}
+
+	description := strings.Join(descriptions, " - ")
+	for _, balance := range l.balances {
+		if strings.HasPrefix(normalize(description), balance) {
+			return Transaction{}, false, nil
+		}
+	}
+
+	return Transaction{
+		ID:          field(columns.id),
+		Date:        civil.DateOf(date),
+		Description: description,
+		Amount:      amount,
+	}, true, nil
+}
@@ -0,0 +1,69 @@
+package bankstatement
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+var (
+	ofxTransactionPattern = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+	ofxFieldPattern       = regexp.MustCompile(`(?i)<([A-Z0-9.]+)>([^<\r\n]*)`)
+)
+
+// parseOFX reads the statement transactions of both the SGML flavour of OFX, where only
+// aggregates like STMTTRN are closed, and the XML one.
+func parseOFX(content string) ([]Transaction, error) {
+	if !strings.Contains(strings.ToUpper(content), "<OFX>") {
+		return nil, fmt.Errorf("%w: missing OFX element", ErrInvalidStatement)
+	}
+
+	var transactions []Transaction
+	for _, match := range ofxTransactionPattern.FindAllStringSubmatch(content, -1) {
+		fields := make(map[string]string)
+		for _, field := range ofxFieldPattern.FindAllStringSubmatch(match[1], -1) {
+			fields[strings.ToUpper(field[1])] = strings.TrimSpace(field[2])
+		}
+
+		transaction, err := ofxTransaction(fields)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+func ofxTransaction(fields map[string]string) (Transaction, error) {
+	posted := fields["DTPOSTED"]
+	if len(posted) < 8 {
+		return Transaction{}, fmt.Errorf("%w: invalid DTPOSTED %q", ErrInvalidStatement, posted)
+	}
+
+	date, err := time.Parse("20060102", posted[:8])
+	if err != nil {
+		return Transaction{}, fmt.Errorf("%w: invalid DTPOSTED %q", ErrInvalidStatement, posted)
+	}
+
+	// Some banks write the amount with a decimal comma, OFX has no thousand separators
+	amount, err := parseCents(strings.ReplaceAll(fields["TRNAMT"], ",", "."))
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	description := fields["MEMO"]
+	if description == "" {
+		description = fields["NAME"]
+	}
+
+	return Transaction{
+		ID:          fields["FITID"],
+		Date:        civil.DateOf(date),
+		Description: description,
+		Amount:      amount,
+	}, nil
+}
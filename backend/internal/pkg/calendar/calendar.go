@@ -0,0 +1,142 @@
+// Package calendar tells business days apart from weekends and holidays, so dates that
+// fall on a day banks are closed can be moved to the closest business day.
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+type Calendar interface {
+	IsBusinessDay(date civil.Date) bool
+}
+
+type Option func(c *calendar)
+
+type calendar struct {
+	weekends bool
+	national bool
+	holidays map[civil.Date]struct{}
+}
+
+// New returns a calendar where only weekends are non business days, unless options say
+// otherwise.
+func New(opts ...Option) Calendar {
+	c := &calendar{weekends: true, holidays: map[civil.Date]struct{}{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// NewBrazilian returns a calendar with weekends, the Brazilian national holidays and the
+// given extra holidays (e.g. state or city ones).
+func NewBrazilian(extra ...civil.Date) Calendar {
+	return New(WithBrazilianHolidays(), WithHolidays(extra...))
+}
+
+// WithBrazilianHolidays adds the national holidays observed by Brazilian banks, movable
+// ones (Carnival, Good Friday and Corpus Christi) included.
+func WithBrazilianHolidays() Option {
+	return func(c *calendar) {
+		c.national = true
+	}
+}
+
+// WithHolidays adds fixed date holidays to the calendar.
+func WithHolidays(dates ...civil.Date) Option {
+	return func(c *calendar) {
+		for _, d := range dates {
+			c.holidays[d] = struct{}{}
+		}
+	}
+}
+
+// WithWeekends sets whether Saturdays and Sundays are non business days.
+func WithWeekends(enabled bool) Option {
+	return func(c *calendar) {
+		c.weekends = enabled
+	}
+}
+
+func (c *calendar) IsBusinessDay(date civil.Date) bool {
+	if c.weekends {
+		if wd := date.In(time.UTC).Weekday(); wd == time.Saturday || wd == time.Sunday {
+			return false
+		}
+	}
+
+	if _, ok := c.holidays[date]; ok {
+		return false
+	}
+
+	return !c.national || !isBrazilianHoliday(date)
+}
+
+func isBrazilianHoliday(date civil.Date) bool {
+	switch {
+	case date.Month == time.January && date.Day == 1,
+		date.Month == time.April && date.Day == 21,
+		date.Month == time.May && date.Day == 1,
+		date.Month == time.September && date.Day == 7,
+		date.Month == time.October && date.Day == 12,
+		date.Month == time.November && date.Day == 2,
+		date.Month == time.November && date.Day == 15,
+		date.Month == time.November && date.Day == 20 && date.Year >= 2024,
+		date.Month == time.December && date.Day == 25:
+		return true
+	}
+
+	easter := Easter(date.Year)
+	for _, offset := range []int{-48, -47, -2, 60} {
+		if easter.AddDays(offset) == date {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Easter returns the Easter Sunday of the given year (anonymous Gregorian algorithm).
+func Easter(year int) civil.Date {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return civil.Date{Year: year, Month: time.Month(month), Day: day}
+}
+
+// ParseDates parses a comma separated list of YYYY-MM-DD dates, as used to configure extra
+// holidays.
+func ParseDates(value string) ([]civil.Date, error) {
+	var dates []civil.Date
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		date, err := civil.ParseDate(part)
+		if err != nil {
+			return nil, fmt.Errorf("civil.ParseDate: %w", err)
+		}
+		dates = append(dates, date)
+	}
+
+	return dates, nil
+}
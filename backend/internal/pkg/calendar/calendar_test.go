@@ -0,0 +1,61 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEaster(t *testing.T) {
+	assert.Equal(t, civil.Date{Year: 2024, Month: time.March, Day: 31}, Easter(2024))
+	assert.Equal(t, civil.Date{Year: 2025, Month: time.April, Day: 20}, Easter(2025))
+	assert.Equal(t, civil.Date{Year: 2026, Month: time.April, Day: 5}, Easter(2026))
+}
+
+func TestCalendar_IsBusinessDay(t *testing.T) {
+	br := NewBrazilian(civil.Date{Year: 2025, Month: time.January, Day: 25})
+
+	tests := []struct {
+		name     string
+		cal      Calendar
+		date     civil.Date
+		expected bool
+	}{
+		{"weekday", br, civil.Date{Year: 2025, Month: time.March, Day: 12}, true},
+		{"saturday", br, civil.Date{Year: 2025, Month: time.March, Day: 15}, false},
+		{"sunday", br, civil.Date{Year: 2025, Month: time.March, Day: 16}, false},
+		{"tiradentes", br, civil.Date{Year: 2025, Month: time.April, Day: 21}, false},
+		{"carnival tuesday", br, civil.Date{Year: 2025, Month: time.March, Day: 4}, false},
+		{"good friday", br, civil.Date{Year: 2025, Month: time.April, Day: 18}, false},
+		{"corpus christi", br, civil.Date{Year: 2025, Month: time.June, Day: 19}, false},
+		{"consciencia negra before 2024", br, civil.Date{Year: 2023, Month: time.November, Day: 20}, true},
+		{"consciencia negra", br, civil.Date{Year: 2025, Month: time.November, Day: 20}, false},
+		{"extra holiday on a weekday", NewBrazilian(civil.Date{Year: 2025, Month: time.July, Day: 9}), civil.Date{Year: 2025, Month: time.July, Day: 9}, false},
+		{"holidays only when asked", New(), civil.Date{Year: 2025, Month: time.April, Day: 21}, true},
+		{"weekends disabled", New(WithWeekends(false)), civil.Date{Year: 2025, Month: time.March, Day: 15}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.cal.IsBusinessDay(tt.date))
+		})
+	}
+}
+
+func TestParseDates(t *testing.T) {
+	dates, err := ParseDates(" 2025-01-25, 2025-07-09,")
+	assert.NoError(t, err)
+	assert.Equal(t, []civil.Date{
+		{Year: 2025, Month: time.January, Day: 25},
+		{Year: 2025, Month: time.July, Day: 9},
+	}, dates)
+
+	dates, err = ParseDates("")
+	assert.NoError(t, err)
+	assert.Empty(t, dates)
+
+	_, err = ParseDates("25/01/2025")
+	assert.Error(t, err)
+}
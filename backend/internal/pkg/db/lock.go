@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+)
+
+// TryLock acquires a session level Postgres advisory lock identified by key, without waiting for it.
+// The lock is held by a dedicated connection until the returned release function is called, so it is
+// freed even if the instance dies in the meantime.
+func (sql *Client) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	conn, err := sql.conn.Connx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("db.Connx: %w", err)
+	}
+
+	lockID := advisoryLockID(key)
+
+	var acquired bool
+	if err := conn.QueryRowxContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&acquired); err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("pg_try_advisory_lock: %w", err)
+	}
+
+	if !acquired {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+
+	release := func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID); err != nil {
+			slog.Error("pg_advisory_unlock", "key", key, "error", err)
+		}
+		_ = conn.Close()
+	}
+
+	return release, true, nil
+}
+
+func advisoryLockID(key string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(key))
+	return int64(hash.Sum64())
+}
@@ -26,6 +26,12 @@ var Module = eon.NewModule("Database", func(ctx context.Context, c *di.Container
 		return dbClient, nil
 	})
 
+	di.Provide(c, func(dbClient *Client) eon.Locker {
+		return dbClient
+	})
+
+	di.Provide(c, NewUnitOfWork)
+
 	lc.OnDisposing(eon.HookOrders.PREPEND, func() error {
 		dbClient := di.Resolve[*Client](c)
 		if err := dbClient.Close(); err != nil {
@@ -40,13 +40,47 @@ type TxOptions struct {
 // The function receives a context and a transaction object, and returns an error if any operation fails.
 type TransactionFunction func(ctx context.Context, tx *sqlx.Tx) error
 
-// NewTransactionManager creates a new TransactionManager for the Client.
-// The manager handles the lifecycle of a transaction, including committing or rolling back
-// depending on whether the transaction function returns an error or a panic occurs.
+// Executor is the set of query methods shared by *sqlx.DB and *sqlx.Tx.
+type Executor interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+type txKey struct{}
+
+// WithTx returns a copy of ctx carrying the transaction.
+func WithTx(ctx context.Context, tx *sqlx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext returns the transaction carried by ctx, if any.
+func TxFromContext(ctx context.Context) (*sqlx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sqlx.Tx)
+	return tx, ok && tx != nil
+}
+
+// Executor returns the transaction carried by ctx or, when there is none, the connection pool.
+// Repositories run their queries through it so they take part in the caller's transaction.
+func (sql *Client) Executor(ctx context.Context) Executor {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return sql.conn
+}
+
+// Transaction runs txFn in a transaction, committing it if txFn succeeds and rolling it back
+// if txFn returns an error or panics. The context given to txFn carries the transaction.
 //
-// Returns:
-//   - TransactionManager: A function that manages a transaction, executing the given transaction function.
+// When ctx already carries a transaction, txFn joins it instead: the outer transaction decides
+// whether everything is committed or rolled back, and the options are ignored.
 func (sql *Client) Transaction(ctx context.Context, txFn TransactionFunction, opts ...TxOptions) (err error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		return txFn(ctx, tx)
+	}
+
 	var txOptions *TxOptions
 	if len(opts) > 0 {
 		txOptions = &opts[0]
@@ -59,6 +93,8 @@ func (sql *Client) Transaction(ctx context.Context, txFn TransactionFunction, op
 		return fmt.Errorf("begin transaction: %w", err)
 	}
 
+	ctx = WithTx(ctx, tx)
+
 	defer func() {
 		r := recover()
 		if r == nil && err == nil {
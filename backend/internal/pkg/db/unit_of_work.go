@@ -0,0 +1,19 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// UnitOfWork runs fn in a single transaction carried by the context it receives, so every
+// repository write made with that context commits or rolls back together.
+type UnitOfWork func(ctx context.Context, fn func(ctx context.Context) error) error
+
+func NewUnitOfWork(client *Client) UnitOfWork {
+	return func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return client.Transaction(ctx, func(ctx context.Context, _ *sqlx.Tx) error {
+			return fn(ctx)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+)
+
+type UnitOfWorkTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	unitOfWork db.UnitOfWork
+}
+
+func TestUnitOfWorkTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitOfWorkTestSuite))
+}
+
+func (s *UnitOfWorkTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.client = dbtest.Setup(s.ctx, s.T())
+	s.unitOfWork = db.NewUnitOfWork(s.client)
+}
+
+func (s *UnitOfWorkTestSuite) TearDownTest() {
+	s.NoError(s.client.Clean("groups"))
+}
+
+func (s *UnitOfWorkTestSuite) insertGroup(ctx context.Context, id int) error {
+	_, err := s.client.Executor(ctx).ExecContext(ctx, `
+		INSERT INTO groups (id, name, created_at, updated_at, version) VALUES ($1, 'group', now(), now(), 0)
+	`, id)
+	return err
+}
+
+func (s *UnitOfWorkTestSuite) countGroups() int {
+	var count int
+	s.NoError(s.client.Conn().Get(&count, "SELECT count(*) FROM groups"))
+	return count
+}
+
+func (s *UnitOfWorkTestSuite) TestUnitOfWork_CommitsEveryWrite() {
+	err := s.unitOfWork(s.ctx, func(ctx context.Context) error {
+		if err := s.insertGroup(ctx, 1); err != nil {
+			return err
+		}
+		return s.insertGroup(ctx, 2)
+	})
+
+	s.NoError(err)
+	s.Equal(2, s.countGroups())
+}
+
+func (s *UnitOfWorkTestSuite) TestUnitOfWork_RollsBackEveryWrite() {
+	err := s.unitOfWork(s.ctx, func(ctx context.Context) error {
+		if err := s.insertGroup(ctx, 1); err != nil {
+			return err
+		}
+		return errors.New("second write failed")
+	})
+
+	s.ErrorContains(err, "second write failed")
+	s.Zero(s.countGroups())
+}
+
+func (s *UnitOfWorkTestSuite) TestUnitOfWork_NestedTransactionsJoinTheOuterOne() {
+	err := s.unitOfWork(s.ctx, func(ctx context.Context) error {
+		outer, _ := db.TxFromContext(ctx)
+
+		if err := s.client.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+			s.Same(outer, tx)
+			return s.insertGroup(ctx, 1)
+		}); err != nil {
+			return err
+		}
+
+		return errors.New("outer failed")
+	})
+
+	s.Error(err)
+	s.Zero(s.countGroups())
+}
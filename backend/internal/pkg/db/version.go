@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/ddd"
+)
+
+// ErrNoRowsAffected is returned when the row being updated does not exist anymore.
+var ErrNoRowsAffected = errors.New("sql: no rows affected")
+
+// VersionConflict explains why a versioned write to table did not touch the row identified by id.
+// It returns a ddd.VersionConflictError carrying the version currently stored, or ErrNoRowsAffected
+// when the row is gone.
+func (c *Client) VersionConflict(ctx context.Context, table string, id int) error {
+	var current sql.NullInt64
+	if err := c.Executor(ctx).QueryRowxContext(ctx, fmt.Sprintf("SELECT MAX(version) FROM %s WHERE id = $1", table), id).Scan(&current); err != nil {
+		return fmt.Errorf("db.Select: %w", err)
+	}
+
+	if !current.Valid {
+		return ErrNoRowsAffected
+	}
+
+	return &ddd.VersionConflictError{CurrentVersion: int(current.Int64)}
+}
@@ -0,0 +1,12 @@
+package dbtest
+
+import (
+	"context"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+// UnitOfWork runs the writes straight away, for tests whose repositories are mocked.
+var UnitOfWork db.UnitOfWork = func(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,32 @@
+package ddd
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVersionConflict is matched by every VersionConflictError.
+var ErrVersionConflict = errors.New("version conflict")
+
+// VersionConflictError reports that an entity was changed by someone else after it was read.
+type VersionConflictError struct {
+	CurrentVersion int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("version conflict: current version is %d", e.CurrentVersion)
+}
+
+func (e *VersionConflictError) Is(target error) bool {
+	return target == ErrVersionConflict
+}
+
+// CheckVersion fails with a VersionConflictError when the expected version is given and the
+// entity is no longer on it.
+func (e Entity[ID]) CheckVersion(expected *int) error {
+	if expected != nil && *expected != e.Version {
+		return &VersionConflictError{CurrentVersion: e.Version}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package eon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+type (
+	// JobFn is the work done by a background job. The context is cancelled when the application
+	// gives up waiting for the job during shutdown.
+	JobFn func(ctx context.Context) error
+
+	// Locker guarantees that a job runs in a single instance of the application at a time.
+	Locker interface {
+		// TryLock acquires the lock identified by key without waiting for it. When acquired is false
+		// someone else holds the lock. The release function must be called once the work is done.
+		TryLock(ctx context.Context, key string) (release func(), acquired bool, err error)
+	}
+
+	// Job is a background job run by the application according to a cron expression.
+	Job struct {
+		// Name identifies the job in the logs and is the key used to lock it.
+		Name string
+		// Schedule is a standard five fields cron expression, such as "0 6 * * *", or a descriptor
+		// like "@daily" or "@every 1h". A "CRON_TZ=America/Sao_Paulo" prefix sets its time zone.
+		Schedule string
+		// Locker, when set, prevents the job from running concurrently in different instances.
+		Locker Locker
+		Run    JobFn
+	}
+)
+
+type jobScheduler struct {
+	cron   *cron.Cron
+	jobs   []Job
+	logger Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newJobScheduler(logger Logger) *jobScheduler {
+	return &jobScheduler{
+		cron:   cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
+		logger: logger,
+	}
+}
+
+func (s *jobScheduler) add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *jobScheduler) start() error {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	for _, job := range s.jobs {
+		if _, err := s.cron.AddFunc(job.Schedule, s.runner(job)); err != nil {
+			return fmt.Errorf("scheduling job %s: %w", job.Name, err)
+		}
+		s.logger.Info(fmt.Sprintf("[EON] Job %s scheduled at %s", job.Name, job.Schedule))
+	}
+
+	s.cron.Start()
+
+	return nil
+}
+
+// stop prevents new runs and waits for the running jobs to finish, cancelling their context if they
+// take longer than the given timeout.
+func (s *jobScheduler) stop(timeout time.Duration) error {
+	if s.cancel == nil {
+		return nil
+	}
+	defer s.cancel()
+
+	done := s.cron.Stop().Done()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		s.logger.Warn("[EON] Jobs are taking too long to finish, cancelling them")
+		s.cancel()
+		<-done
+		return nil
+	}
+}
+
+func (s *jobScheduler) runner(job Job) func() {
+	return func() {
+		ctx := s.ctx
+
+		if job.Locker != nil {
+			release, acquired, err := job.Locker.TryLock(ctx, job.Name)
+			if err != nil {
+				s.logger.Error(fmt.Sprintf("[EON] Failed to lock job %s", job.Name), "err", err)
+				return
+			}
+
+			if !acquired {
+				s.logger.Debug(fmt.Sprintf("[EON] Job %s is running somewhere else, skipping", job.Name))
+				return
+			}
+			defer release()
+		}
+
+		start := time.Now()
+		if err := job.Run(ctx); err != nil {
+			s.logger.Error(fmt.Sprintf("[EON] Job %s failed", job.Name), "err", err)
+			return
+		}
+
+		s.logger.Debug(fmt.Sprintf("[EON] Job %s finished", job.Name), "duration", time.Since(start).String())
+	}
+}
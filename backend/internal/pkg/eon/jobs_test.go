@@ -0,0 +1,121 @@
+package eon
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeLocker struct {
+	acquired bool
+	err      error
+	released atomic.Int32
+}
+
+func (l *fakeLocker) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	if l.err != nil || !l.acquired {
+		return nil, false, l.err
+	}
+	return func() { l.released.Add(1) }, true, nil
+}
+
+type JobSchedulerTestSuite struct {
+	suite.Suite
+	lfcm *lifeCycleManager
+}
+
+func TestJobScheduler(t *testing.T) {
+	suite.Run(t, new(JobSchedulerTestSuite))
+}
+
+func (suite *JobSchedulerTestSuite) SetupTest() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	suite.lfcm = newLifeCycleManager(2*time.Second, logger)
+}
+
+func (suite *JobSchedulerTestSuite) TestJobScheduler_RunsJobsWhileRunning() {
+	var runs atomic.Int32
+	locker := &fakeLocker{acquired: true}
+	suite.lfcm.Schedule(Job{
+		Name:     "counter",
+		Schedule: "@every 1s",
+		Locker:   locker,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	suite.NoError(suite.lfcm.start())
+	suite.Eventually(func() bool { return runs.Load() > 0 }, 3*time.Second, 50*time.Millisecond)
+	suite.NoError(suite.lfcm.stop())
+
+	suite.Equal(runs.Load(), locker.released.Load())
+}
+
+func (suite *JobSchedulerTestSuite) TestJobScheduler_SkipsJobsLockedElsewhere() {
+	var runs atomic.Int32
+	suite.lfcm.Schedule(Job{
+		Name:     "locked",
+		Schedule: "@every 1s",
+		Locker:   &fakeLocker{acquired: false},
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	suite.NoError(suite.lfcm.start())
+	time.Sleep(1500 * time.Millisecond)
+	suite.NoError(suite.lfcm.stop())
+
+	suite.Zero(runs.Load())
+}
+
+func (suite *JobSchedulerTestSuite) TestJobScheduler_WaitsForRunningJobsOnDisposing() {
+	started := make(chan struct{})
+	var finished atomic.Bool
+	suite.lfcm.Schedule(Job{
+		Name:     "slow",
+		Schedule: "@every 1s",
+		Run: func(ctx context.Context) error {
+			if finished.Load() {
+				return nil
+			}
+			close(started)
+			time.Sleep(300 * time.Millisecond)
+			finished.Store(true)
+			return errors.New("failures are only logged")
+		},
+	})
+
+	var disposedAfterJob bool
+	suite.lfcm.OnDisposing(HookOrders.PREPEND, func() error {
+		disposedAfterJob = finished.Load()
+		return nil
+	})
+
+	suite.NoError(suite.lfcm.start())
+	<-started
+	suite.NoError(suite.lfcm.stop())
+
+	suite.True(finished.Load())
+	suite.True(disposedAfterJob)
+}
+
+func (suite *JobSchedulerTestSuite) TestJobScheduler_WithInvalidSchedule() {
+	suite.lfcm.Schedule(Job{
+		Name:     "invalid",
+		Schedule: "every day",
+		Run:      func(ctx context.Context) error { return nil },
+	})
+
+	err := suite.lfcm.start()
+	suite.ErrorContains(err, "starting jobs: scheduling job invalid")
+}
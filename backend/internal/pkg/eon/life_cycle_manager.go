@@ -32,6 +32,7 @@ func newLifeCycleManager(shutdownTime time.Duration, logger Logger) *lifeCycleMa
 		logger:        logger,
 		forceShutdown: false,
 		shutdownTime:  shutdownTime,
+		jobs:          newJobScheduler(logger),
 	}
 }
 
@@ -41,6 +42,7 @@ type lifeCycleManager struct {
 	logger        Logger
 	forceShutdown bool
 	shutdownTime  time.Duration
+	jobs          *jobScheduler
 }
 
 func (lfcm *lifeCycleManager) status(newStatus appState) HookFn {
@@ -73,6 +75,7 @@ func (lfcm *lifeCycleManager) start() error {
 		lfcm.transition(hooks.BOOTED),
 		lfcm.transition(hooks.READY),
 		lfcm.transition(hooks.RUNNING),
+		lfcm.startJobs,
 		lfcm.status(appStates.STARTED),
 	)
 	if err != nil {
@@ -130,6 +133,25 @@ func (lfcm *lifeCycleManager) OnDisposed(order HookOrder, fn ...HookFn) {
 	lfcm.on(hooks.DISPOSED, order, fn...)
 }
 
+func (lfcm *lifeCycleManager) Schedule(job Job) {
+	lfcm.jobs.add(job)
+}
+
+// startJobs starts the background jobs once the application is running, and makes sure they are
+// the first thing stopped when it is disposing, before the resources they use are released.
+func (lfcm *lifeCycleManager) startJobs() error {
+	if err := lfcm.jobs.start(); err != nil {
+		return fmt.Errorf("starting jobs: %w", err)
+	}
+
+	lfcm.hooks.prepend(hooks.DISPOSING, func() error {
+		lfcm.logger.Info("[EON] Stopping jobs")
+		return lfcm.jobs.stop(lfcm.shutdownTime / 2)
+	})
+
+	return nil
+}
+
 func (lfcm *lifeCycleManager) on(lfc hook, order HookOrder, fn ...HookFn) {
 	if order == HookOrders.APPEND {
 		lfcm.hooks.append(lfc, fn...)
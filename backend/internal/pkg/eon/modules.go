@@ -28,6 +28,9 @@ type (
 		// OnDisposed By the time Disposed event is dispatched, we expect that everything that keeps the process open is already finished, leaving it in a safe state to be terminated.
 		// You could use this event to clean temporary files, for instance.
 		OnDisposed(order HookOrder, fn ...HookFn)
+		// Schedule registers a background job that starts running along with the application, once every Running hook is done.
+		// The jobs are stopped at the very beginning of the Disposing event, waiting for the ones in progress to finish.
+		Schedule(job Job)
 	}
 
 	Info struct {
@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Event is a message addressed to a topic. It is written to the outbox in the same transaction as
+// the aggregate change it announces, and only reaches the topic once that transaction commits.
+type Event struct {
+	Topic   string
+	Payload any
+}
+
+func NewEvent(topic string, payload any) Event {
+	return Event{Topic: topic, Payload: payload}
+}
+
+// Write stores the events in the outbox using the given transaction. The relay forwards them to their
+// topics later on, in the same order they were written.
+func Write(ctx context.Context, tx *sqlx.Tx, events ...Event) error {
+	for _, event := range events {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO outbox_events (uuid, topic, payload, created_at)
+			VALUES ($1, $2, $3, $4)
+		`, uuid.NewString(), event.Topic, payload, time.Now()); err != nil {
+			return fmt.Errorf("db.ExecContext: %w", err)
+		}
+	}
+
+	return nil
+}
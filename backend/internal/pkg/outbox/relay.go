@@ -0,0 +1,96 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill"
+	pubsubSql "github.com/ThreeDotsLabs/watermill-sql/v3/pkg/sql"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+)
+
+const defaultBatchSize = 100
+
+type eventModel struct {
+	ID      int64  `db:"id"`
+	UUID    string `db:"uuid"`
+	Topic   string `db:"topic"`
+	Payload []byte `db:"payload"`
+}
+
+// Relay forwards the events written to the outbox to their watermill topics.
+type Relay struct {
+	db        *db.Client
+	batchSize int
+}
+
+func NewRelay(db *db.Client) *Relay {
+	return &Relay{db: db, batchSize: defaultBatchSize}
+}
+
+// Forward publishes a batch of pending events and removes them from the outbox. Both happen in a
+// single transaction, since watermill SQL topics live in the same database, so an event is never lost
+// nor delivered twice by the relay. The topics must have been initialized by their subscribers.
+func (r *Relay) Forward(ctx context.Context) (int, error) {
+	var forwarded int
+
+	if err := r.db.Transaction(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		var models []eventModel
+		if err := tx.SelectContext(ctx, &models, `
+			SELECT id, uuid, topic, payload FROM outbox_events
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		`, r.batchSize); err != nil {
+			return fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		if len(models) == 0 {
+			return nil
+		}
+
+		publisher, err := pubsubSql.NewPublisher(tx.Tx, pubsubSql.PublisherConfig{
+			SchemaAdapter: pubsubSql.DefaultPostgreSQLSchema{},
+		}, watermill.NewSlogLogger(nil))
+		if err != nil {
+			return fmt.Errorf("pubsubSql.NewPublisher: %w", err)
+		}
+
+		ids := make([]int64, 0, len(models))
+		for _, model := range models {
+			if err := publisher.Publish(model.Topic, message.NewMessage(model.UUID, model.Payload)); err != nil {
+				return fmt.Errorf("publisher.Publish: %w", err)
+			}
+			ids = append(ids, model.ID)
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM outbox_events WHERE id = ANY($1)`, ids); err != nil {
+			return fmt.Errorf("db.ExecContext: %w", err)
+		}
+
+		forwarded = len(models)
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return forwarded, nil
+}
+
+// Run forwards pending events until the outbox is drained.
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		forwarded, err := r.Forward(ctx)
+		if err != nil {
+			return fmt.Errorf("relay.Forward: %w", err)
+		}
+
+		if forwarded < r.batchSize {
+			return nil
+		}
+	}
+}
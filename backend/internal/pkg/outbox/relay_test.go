@@ -0,0 +1,91 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/db"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/dbtest"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
+)
+
+const topic = "outbox.topic"
+
+type RelayTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	db         *db.Client
+	relay      *outbox.Relay
+	subscriber pubsub.Subscriber
+	messages   <-chan *pubsub.Message
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
+
+func (s *RelayTestSuite) SetupSuite() {
+	var err error
+	s.ctx = context.Background()
+	s.db = dbtest.Setup(s.ctx, s.T())
+	s.relay = outbox.NewRelay(s.db)
+
+	s.subscriber, err = pubsub.NewSqlSubscriber(s.db)
+	s.Require().NoError(err)
+
+	s.messages, err = s.subscriber.Subscribe(s.ctx, topic)
+	s.Require().NoError(err)
+}
+
+func (s *RelayTestSuite) TearDownSuite() {
+	s.NoError(s.subscriber.Close())
+}
+
+func (s *RelayTestSuite) TearDownTest() {
+	s.NoError(s.db.Clean("outbox_events"))
+}
+
+func (s *RelayTestSuite) TestRelay_ForwardsCommittedEventsInOrder() {
+	s.NoError(s.db.Transaction(s.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		return outbox.Write(ctx, tx,
+			outbox.NewEvent(topic, map[string]int{"order": 1}),
+			outbox.NewEvent(topic, map[string]int{"order": 2}),
+		)
+	}))
+
+	s.NoError(s.relay.Run(s.ctx))
+
+	for _, expected := range []string{`{"order": 1}`, `{"order": 2}`} {
+		select {
+		case msg := <-s.messages:
+			s.JSONEq(expected, string(msg.Payload))
+			msg.Ack()
+		case <-time.After(5 * time.Second):
+			s.FailNow("message not delivered")
+		}
+	}
+
+	var pending int
+	s.NoError(s.db.Conn().Get(&pending, "SELECT count(*) FROM outbox_events"))
+	s.Zero(pending)
+}
+
+func (s *RelayTestSuite) TestRelay_IgnoresRolledBackEvents() {
+	err := s.db.Transaction(s.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if err := outbox.Write(ctx, tx, outbox.NewEvent(topic, map[string]int{"order": 3})); err != nil {
+			return err
+		}
+		return errors.New("aggregate change failed")
+	})
+	s.Error(err)
+
+	forwarded, err := s.relay.Forward(s.ctx)
+	s.NoError(err)
+	s.Zero(forwarded)
+}
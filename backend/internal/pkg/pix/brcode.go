@@ -0,0 +1,146 @@
+// Package pix builds static Pix BR Codes, the EMV payload behind both the "copia e cola"
+// string and the QR code read by banking apps.
+package pix
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	gui          = "br.gov.bcb.pix"
+	defaultCity  = "BRASIL"
+	maxNameLen   = 25
+	maxCityLen   = 15
+	maxTxIDLen   = 25
+	currencyBRL  = "986"
+	countryCode  = "BR"
+	noMCC        = "0000"
+	staticTxID   = "***"
+	crcFieldHead = "6304"
+)
+
+var ErrInvalidPayload = errors.New("invalid pix payload")
+
+// Payload holds the data of a static charge. Amount is in cents; zero leaves the amount
+// for the payer to fill in. City defaults to BRASIL and TxID to "***" when empty.
+type Payload struct {
+	Key    string
+	Name   string
+	City   string
+	Amount int
+	TxID   string
+}
+
+// BRCode returns the EMV string of the payload, CRC included.
+func (p Payload) BRCode() (string, error) {
+	if err := ValidateKey(p.Key); err != nil {
+		return "", err
+	}
+
+	if p.Amount < 0 {
+		return "", fmt.Errorf("%w: negative amount", ErrInvalidPayload)
+	}
+
+	name := sanitize(p.Name, maxNameLen)
+	if name == "" {
+		return "", fmt.Errorf("%w: missing merchant name", ErrInvalidPayload)
+	}
+
+	city := sanitize(p.City, maxCityLen)
+	if city == "" {
+		city = defaultCity
+	}
+
+	txID := staticTxID
+	if p.TxID != "" {
+		txID = sanitizeTxID(p.TxID)
+	}
+
+	var b strings.Builder
+	b.WriteString(field("00", "01"))
+	b.WriteString(field("26", field("00", gui)+field("01", p.Key)))
+	b.WriteString(field("52", noMCC))
+	b.WriteString(field("53", currencyBRL))
+	if p.Amount > 0 {
+		b.WriteString(field("54", fmt.Sprintf("%d.%02d", p.Amount/100, p.Amount%100)))
+	}
+	b.WriteString(field("58", countryCode))
+	b.WriteString(field("59", name))
+	b.WriteString(field("60", city))
+	b.WriteString(field("62", field("05", txID)))
+	b.WriteString(crcFieldHead)
+
+	return b.String() + fmt.Sprintf("%04X", CRC16(b.String())), nil
+}
+
+// CRC16 implements CRC-16/CCITT-FALSE (polynomial 0x1021, initial value 0xFFFF), the
+// checksum required by the BR Code specification.
+func CRC16(data string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+func field(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// sanitize strips accents and any non printable ASCII character, as most banking apps
+// reject payloads with them, and truncates the result to size.
+func sanitize(value string, size int) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	stripped, _, err := transform.String(t, value)
+	if err != nil {
+		stripped = value
+	}
+
+	cleaned := strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E {
+			return -1
+		}
+		return r
+	}, stripped)
+
+	cleaned = strings.TrimSpace(cleaned)
+	if len(cleaned) > size {
+		cleaned = strings.TrimSpace(cleaned[:size])
+	}
+
+	return cleaned
+}
+
+func sanitizeTxID(value string) string {
+	txID := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return -1
+	}, value)
+
+	if len(txID) > maxTxIDLen {
+		txID = txID[:maxTxIDLen]
+	}
+
+	if txID == "" {
+		return staticTxID
+	}
+
+	return txID
+}
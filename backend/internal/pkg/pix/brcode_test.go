@@ -0,0 +1,87 @@
+package pix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCRC16(t *testing.T) {
+	assert.Equal(t, uint16(0x29B1), CRC16("123456789"))
+}
+
+func TestPayload_BRCode(t *testing.T) {
+	t.Run("matches the central bank example", func(t *testing.T) {
+		code, err := Payload{
+			Key:  "123e4567-e12b-12d1-a456-426655440000",
+			Name: "Fulano de Tal",
+			City: "BRASILIA",
+		}.BRCode()
+		assert.NoError(t, err)
+		assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", code)
+	})
+
+	t.Run("includes amount and strips accents", func(t *testing.T) {
+		code, err := Payload{
+			Key:    "joao@email.com",
+			Name:   "João Gonçalves",
+			City:   "São Paulo",
+			Amount: 12345,
+		}.BRCode()
+		assert.NoError(t, err)
+		assert.Contains(t, code, "5406123.45")
+		assert.Contains(t, code, "5914Joao Goncalves")
+		assert.Contains(t, code, "6009Sao Paulo")
+		assert.Equal(t, code[len(code)-4:], fmtCRC(code[:len(code)-4]))
+	})
+
+	t.Run("truncates long names and defaults the city", func(t *testing.T) {
+		code, err := Payload{
+			Key:    "12345678909",
+			Name:   "Maria Aparecida dos Santos Oliveira",
+			Amount: 5,
+		}.BRCode()
+		assert.NoError(t, err)
+		assert.Contains(t, code, "5404"+"0.05")
+		assert.Contains(t, code, "5925Maria Aparecida dos Santo")
+		assert.Contains(t, code, "6006BRASIL")
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := Payload{Key: "not a key", Name: "Fulano"}.BRCode()
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := Payload{Key: "12345678909"}.BRCode()
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+	})
+}
+
+func TestNormalizeKey(t *testing.T) {
+	assert.Equal(t, "12345678909", NormalizeKey(" 123.456.789-09 "))
+	assert.Equal(t, "12345678000190", NormalizeKey("12.345.678/0001-90"))
+	assert.Equal(t, "+5511999998888", NormalizeKey("+55 (11) 99999-8888"))
+	assert.Equal(t, "joao@email.com", NormalizeKey("Joao@Email.com"))
+	assert.Equal(t, "123e4567-e12b-12d1-a456-426655440000", NormalizeKey("123E4567-E12B-12D1-A456-426655440000"))
+	// A phone number without the country code is not mistaken for a CPF
+	assert.Equal(t, "+5511987654321", NormalizeKey("(11) 98765-4321"))
+	assert.Equal(t, "+551133334444", NormalizeKey("11 3333-4444"))
+}
+
+func TestValidateKey(t *testing.T) {
+	for _, key := range []string{"12345678909", "11222333000181", "+5511987654321", "+551133334444", "joao@email.com", "123e4567-e12b-12d1-a456-426655440000"} {
+		assert.NoError(t, ValidateKey(key), key)
+	}
+
+	// Wrong check digits, repeated digits, phone numbers out of Brazil or without +55
+	for _, key := range []string{"12345678901", "11111111111", "11222333000182", "00000000000000", "+14155552671", "11987654321"} {
+		assert.ErrorIs(t, ValidateKey(key), ErrInvalidKey, key)
+	}
+}
+
+func fmtCRC(data string) string {
+	const hex = "0123456789ABCDEF"
+	crc := CRC16(data)
+	return string([]byte{hex[crc>>12&0xF], hex[crc>>8&0xF], hex[crc>>4&0xF], hex[crc&0xF]})
+}
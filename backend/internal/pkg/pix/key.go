@@ -0,0 +1,93 @@
+package pix
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidKey = errors.New("invalid pix key")
+
+var (
+	cpfRegex   = regexp.MustCompile(`^\d{11}$`)
+	cnpjRegex  = regexp.MustCompile(`^\d{14}$`)
+	phoneRegex = regexp.MustCompile(`^\+55\d{10,11}$`)
+	emailRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	evpRegex   = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	// localPhoneRegex is a Brazilian phone number typed without the country code: area code
+	// plus 8 or 9 digits.
+	localPhoneRegex = regexp.MustCompile(`^[1-9]{2}\d{8,9}$`)
+)
+
+var (
+	cpfWeights  = []int{10, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// NormalizeKey removes the formatting users usually type around a key, such as the dots
+// of a CPF or the spaces of a phone number, so it can be stored as the bank expects it.
+// A phone number typed without the country code, and that is not a valid CPF, gets +55.
+func NormalizeKey(key string) string {
+	key = strings.TrimSpace(key)
+	if strings.Contains(key, "@") || evpRegex.MatchString(strings.ToLower(key)) {
+		return strings.ToLower(key)
+	}
+
+	key = strings.Map(func(r rune) rune {
+		if r == '.' || r == '-' || r == '/' || r == ' ' || r == '(' || r == ')' {
+			return -1
+		}
+		return r
+	}, key)
+
+	if localPhoneRegex.MatchString(key) && !validCPF(key) {
+		return "+55" + key
+	}
+
+	return key
+}
+
+// ValidateKey checks that key is a CPF or CNPJ with valid check digits, a Brazilian phone
+// number (E.164, +55), an e-mail or a random (EVP) key, already normalized.
+func ValidateKey(key string) error {
+	switch {
+	case len(key) == 0 || len(key) > 77:
+		return ErrInvalidKey
+	case validCPF(key), validCNPJ(key), phoneRegex.MatchString(key):
+		return nil
+	case emailRegex.MatchString(key), evpRegex.MatchString(key):
+		return nil
+	default:
+		return ErrInvalidKey
+	}
+}
+
+func validCPF(key string) bool {
+	return cpfRegex.MatchString(key) && !repeatedDigits(key) &&
+		checkDigit(key[:9], cpfWeights) == key[9] && checkDigit(key[:10], append([]int{11}, cpfWeights...)) == key[10]
+}
+
+func validCNPJ(key string) bool {
+	return cnpjRegex.MatchString(key) && !repeatedDigits(key) &&
+		checkDigit(key[:12], cnpjWeights[1:]) == key[12] && checkDigit(key[:13], cnpjWeights) == key[13]
+}
+
+// checkDigit computes the modulo 11 check digit of the digits, weighted from the left.
+func checkDigit(digits string, weights []int) byte {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+
+	if rest := sum % 11; rest >= 2 {
+		return byte('0' + 11 - rest)
+	}
+
+	return '0'
+}
+
+// repeatedDigits catches numbers like 111.111.111-11, whose check digits match but are not
+// registered documents.
+func repeatedDigits(key string) bool {
+	return strings.Count(key, key[:1]) == len(key)
+}
@@ -0,0 +1,17 @@
+package pix
+
+import (
+	"fmt"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// QRCode renders the BR Code as a PNG image of size x size pixels.
+func QRCode(brCode string, size int) ([]byte, error) {
+	png, err := qrcode.Encode(brCode, qrcode.Medium, size)
+	if err != nil {
+		return nil, fmt.Errorf("qrcode.Encode: %w", err)
+	}
+
+	return png, nil
+}
@@ -25,3 +25,8 @@ type ExpenseEvent struct {
 	Event
 	Expense expense.Expense
 }
+
+type BudgetAlertEvent struct {
+	Event
+	Alert expense.BudgetAlert
+}
@@ -2,3 +2,4 @@ package pubsub
 
 const IncomesTopic = "incomes.topic"
 const ExpensesTopic = "expenses.topic"
+const BudgetsTopic = "budgets.topic"
@@ -0,0 +1,54 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type csvWriter struct {
+	writer *csv.Writer
+	locale Locale
+}
+
+// newCSVWriter separates cells with semicolons in pt-BR, since commas are its decimal separator.
+func newCSVWriter(w io.Writer, locale Locale) *csvWriter {
+	writer := csv.NewWriter(w)
+	if locale == Locales.PtBR {
+		writer.Comma = ';'
+	}
+
+	return &csvWriter{writer: writer, locale: locale}
+}
+
+func (c *csvWriter) Write(row []any) error {
+	record := make([]string, len(row))
+	for i, cell := range row {
+		switch value := cell.(type) {
+		case nil:
+		case string:
+			record[i] = value
+		case int:
+			record[i] = strconv.Itoa(value)
+		case Money:
+			record[i] = FormatMoney(value, c.locale)
+		case Date:
+			record[i] = time.Time(value).Format(dateLayout(c.locale))
+		default:
+			return fmt.Errorf("spreadsheet: unsupported cell type %T", cell)
+		}
+	}
+
+	if err := c.writer.Write(record); err != nil {
+		return fmt.Errorf("csv.Write: %w", err)
+	}
+
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
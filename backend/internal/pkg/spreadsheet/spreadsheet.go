@@ -0,0 +1,114 @@
+// Package spreadsheet writes tabular exports as CSV or XLSX, one row at a time, formatting
+// amounts and dates either in a neutral format or the way Brazilian spreadsheets expect them.
+package spreadsheet
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format string
+
+var Formats = struct {
+	CSV  Format
+	XLSX Format
+}{
+	CSV:  "csv",
+	XLSX: "xlsx",
+}
+
+// ContentType is the MIME type of files in the format.
+func (f Format) ContentType() string {
+	if f == Formats.XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+type Locale string
+
+var Locales = struct {
+	Default Locale
+	PtBR    Locale
+}{
+	Default: "",
+	PtBR:    "pt-BR",
+}
+
+// Money is an amount in cents.
+type Money int
+
+// Date is written without the time of the day.
+type Date time.Time
+
+// Writer writes the rows of a single sheet. Cells may be strings, ints, Money, Date or nil for
+// an empty cell. Close must be called to flush what is left to the underlying writer.
+type Writer interface {
+	Write(row []any) error
+	Close() error
+}
+
+// NewWriter starts a sheet with the given headers. The sheet name is only used by XLSX.
+func NewWriter(w io.Writer, format Format, locale Locale, sheet string, headers []string) (Writer, error) {
+	var (
+		writer Writer
+		err    error
+	)
+
+	switch format {
+	case Formats.CSV:
+		writer = newCSVWriter(w, locale)
+	case Formats.XLSX:
+		writer, err = newXLSXWriter(w, locale, sheet)
+	default:
+		return nil, fmt.Errorf("spreadsheet: unknown format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	row := make([]any, len(headers))
+	for i, header := range headers {
+		row[i] = header
+	}
+
+	if err := writer.Write(row); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// FormatMoney writes the amount with two decimals, grouping thousands in pt-BR.
+func FormatMoney(cents Money, locale Locale) string {
+	value := int(cents)
+	sign := ""
+	if value < 0 {
+		sign, value = "-", -value
+	}
+
+	units, decimals := strconv.Itoa(value/100), fmt.Sprintf("%02d", value%100)
+	if locale != Locales.PtBR {
+		return sign + units + "." + decimals
+	}
+
+	var grouped strings.Builder
+	for i, digit := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return sign + grouped.String() + "," + decimals
+}
+
+func dateLayout(locale Locale) string {
+	if locale == Locales.PtBR {
+		return "02/01/2006"
+	}
+	return time.DateOnly
+}
@@ -0,0 +1,81 @@
+package spreadsheet_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/Beigelman/nossas-despesas/internal/pkg/spreadsheet"
+)
+
+func writeRows(t *testing.T, format spreadsheet.Format, locale spreadsheet.Locale) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer, err := spreadsheet.NewWriter(&buf, format, locale, "Despesas", []string{"Data", "Nome", "Valor", "Parcela", "Reembolso"})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	date := spreadsheet.Date(time.Date(2025, 3, 7, 15, 4, 0, 0, time.UTC))
+	assert.NoError(t, writer.Write([]any{date, "Mercado; feira", spreadsheet.Money(123456789), 2, nil}))
+	assert.NoError(t, writer.Write([]any{date, "Estorno", spreadsheet.Money(-5), nil, spreadsheet.Money(0)}))
+	assert.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func TestCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default locale", func(t *testing.T) {
+		assert.Equal(t,
+			"Data,Nome,Valor,Parcela,Reembolso\n"+
+				"2025-03-07,Mercado; feira,1234567.89,2,\n"+
+				"2025-03-07,Estorno,-0.05,,0.00\n",
+			string(writeRows(t, spreadsheet.Formats.CSV, spreadsheet.Locales.Default)),
+		)
+	})
+
+	t.Run("pt-BR", func(t *testing.T) {
+		assert.Equal(t,
+			"Data;Nome;Valor;Parcela;Reembolso\n"+
+				"07/03/2025;\"Mercado; feira\";1.234.567,89;2;\n"+
+				"07/03/2025;Estorno;-0,05;;0,00\n",
+			string(writeRows(t, spreadsheet.Formats.CSV, spreadsheet.Locales.PtBR)),
+		)
+	})
+}
+
+func TestXLSX(t *testing.T) {
+	t.Parallel()
+
+	file, err := excelize.OpenReader(bytes.NewReader(writeRows(t, spreadsheet.Formats.XLSX, spreadsheet.Locales.PtBR)))
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer file.Close()
+
+	rows, err := file.GetRows("Despesas")
+	assert.NoError(t, err)
+	if !assert.Len(t, rows, 3) {
+		return
+	}
+	assert.Equal(t, []string{"Data", "Nome", "Valor", "Parcela", "Reembolso"}, rows[0])
+	assert.Equal(t, "07/03/2025", rows[1][0])
+	assert.Equal(t, "Mercado; feira", rows[1][1])
+	assert.Equal(t, "2", rows[1][3])
+
+	amount, err := file.GetCellValue("Despesas", "C2", excelize.Options{RawCellValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "1234567.89", amount)
+}
+
+func TestNewWriterUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := spreadsheet.NewWriter(&bytes.Buffer{}, "pdf", spreadsheet.Locales.Default, "Despesas", nil)
+	assert.EqualError(t, err, `spreadsheet: unknown format "pdf"`)
+}
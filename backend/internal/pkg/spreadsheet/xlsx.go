@@ -0,0 +1,91 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// xlsxWriter relies on the excelize stream writer, which keeps a bounded amount of rows in
+// memory and spills the rest to a temporary file until the workbook is written out on Close.
+type xlsxWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+	money  int
+	date   int
+}
+
+func newXLSXWriter(w io.Writer, locale Locale, sheet string) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName(file.GetSheetName(0), sheet); err != nil {
+		return nil, fmt.Errorf("excelize.SetSheetName: %w", err)
+	}
+
+	stream, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("excelize.NewStreamWriter: %w", err)
+	}
+
+	// Excel renders the thousands and decimal separators of the reader's locale by itself
+	money, err := file.NewStyle(&excelize.Style{NumFmt: 4})
+	if err != nil {
+		return nil, fmt.Errorf("excelize.NewStyle: %w", err)
+	}
+
+	dateFormat := "yyyy-mm-dd"
+	if locale == Locales.PtBR {
+		dateFormat = "dd/mm/yyyy"
+	}
+	date, err := file.NewStyle(&excelize.Style{CustomNumFmt: &dateFormat})
+	if err != nil {
+		return nil, fmt.Errorf("excelize.NewStyle: %w", err)
+	}
+
+	return &xlsxWriter{out: w, file: file, stream: stream, money: money, date: date}, nil
+}
+
+func (x *xlsxWriter) Write(row []any) error {
+	cells := make([]any, len(row))
+	for i, cell := range row {
+		switch value := cell.(type) {
+		case nil, string, int:
+			cells[i] = value
+		case Money:
+			cells[i] = excelize.Cell{StyleID: x.money, Value: float64(value) / 100}
+		case Date:
+			cells[i] = excelize.Cell{StyleID: x.date, Value: time.Time(value)}
+		default:
+			return fmt.Errorf("spreadsheet: unsupported cell type %T", cell)
+		}
+	}
+
+	x.row++
+	axis, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return fmt.Errorf("excelize.CoordinatesToCellName: %w", err)
+	}
+
+	if err := x.stream.SetRow(axis, cells); err != nil {
+		return fmt.Errorf("excelize.SetRow: %w", err)
+	}
+
+	return nil
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	if err := x.stream.Flush(); err != nil {
+		return fmt.Errorf("excelize.Flush: %w", err)
+	}
+
+	if err := x.file.Write(x.out); err != nil {
+		return fmt.Errorf("excelize.Write: %w", err)
+	}
+
+	return nil
+}
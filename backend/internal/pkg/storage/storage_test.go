@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	local, err := NewLocal(t.TempDir())
+	assert.NoError(t, err)
+
+	assert.NoError(t, local.Put(ctx, "attachments/1/receipt", []byte("content"), "image/png"))
+
+	file, err := local.Get(ctx, "attachments/1/receipt")
+	assert.NoError(t, err)
+	content, err := io.ReadAll(file)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+	assert.Equal(t, "content", string(content))
+
+	assert.NoError(t, local.Delete(ctx, "attachments/1/receipt"))
+	assert.NoError(t, local.Delete(ctx, "attachments/1/receipt"), "deleting a missing file succeeds")
+
+	_, err = local.Get(ctx, "attachments/1/receipt")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "attachments/../../secret", "attachments//1"} {
+		assert.ErrorIs(t, local.Put(ctx, key, nil, ""), ErrInvalidKey, key)
+	}
+}
+
+func TestS3(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	objects := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = r.Header.Get("Content-Type") + ":" + decodeChunks(string(body))
+		case http.MethodGet, http.MethodHead:
+			object, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+			_, _ = io.WriteString(w, object)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	s3, err := NewS3(S3Config{Endpoint: server.URL, Bucket: "receipts", AccessKey: "minio", SecretKey: "minio123"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, s3.Put(ctx, "attachments/1/receipt", []byte("content"), "application/pdf"))
+	assert.Contains(t, objects, "/receipts/attachments/1/receipt")
+
+	file, err := s3.Get(ctx, "attachments/1/receipt")
+	assert.NoError(t, err)
+	content, err := io.ReadAll(file)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+	assert.Equal(t, "application/pdf:content", string(content))
+
+	assert.NoError(t, s3.Delete(ctx, "attachments/1/receipt"))
+
+	_, err = s3.Get(ctx, "attachments/1/receipt")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// decodeChunks reads the payload of a request signed in chunks, as objects are uploaded through
+// plain HTTP: each chunk is its size in hex and its signature, followed by its data.
+func decodeChunks(body string) string {
+	var payload strings.Builder
+	for body != "" {
+		header, rest, _ := strings.Cut(body, "\r\n")
+		sizeHex, _, _ := strings.Cut(header, ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil || size == 0 {
+			break
+		}
+		payload.WriteString(rest[:size])
+		body = strings.TrimPrefix(rest[size:], "\r\n")
+	}
+
+	return payload.String()
+}
@@ -0,0 +1,115 @@
+// Package thumbnail scales images down to small previews.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	// Register the formats image.Decode reads
+	_ "image/gif"
+	_ "image/png"
+)
+
+const (
+	// ContentType is the format of every thumbnail.
+	ContentType = "image/jpeg"
+	// MaxPixels is the largest image, in pixels, a thumbnail is generated for. A small file may
+	// declare huge dimensions, and decoding it would allocate all of them. Receipts are legible
+	// well below it.
+	MaxPixels = 8_000_000
+)
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image format")
+	ErrImageTooLarge    = errors.New("image too large")
+)
+
+// Generate scales the image down to fit a square of the given size, keeping its aspect ratio, and
+// encodes it as JPEG. Images already smaller than the square are only re-encoded. JPEG, PNG and
+// GIF images are supported, up to MaxPixels.
+func Generate(content []byte, size int) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, ErrUnsupportedImage
+		}
+		return nil, fmt.Errorf("image.DecodeConfig: %w", err)
+	}
+
+	if config.Width*config.Height > MaxPixels {
+		return nil, ErrImageTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, ErrUnsupportedImage
+		}
+		return nil, fmt.Errorf("image.Decode: %w", err)
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, fmt.Errorf("image.Decode: empty image")
+	}
+
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, scale(src, width, height), &jpeg.Options{Quality: 80}); err != nil {
+		return nil, fmt.Errorf("jpeg.Encode: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// scale resizes the image by averaging the source pixels each destination pixel covers, which is
+// enough for previews without the cost of a resampling filter. Transparent areas are painted
+// white, since JPEG has no alpha channel.
+func scale(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcHeight/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcWidth/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcWidth/width)
+
+			var r, g, b, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					// Blend over white: the colors are premultiplied by alpha
+					white := 0xffff - uint64(ca)
+					r += uint64(cr) + white
+					g += uint64(cg) + white
+					b += uint64(cb) + white
+					count++
+				}
+			}
+
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / count >> 8),
+				G: uint8(g / count >> 8),
+				B: uint8(b / count >> 8),
+				A: 0xff,
+			})
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,66 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("scales down keeping the aspect ratio", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 800, 400))
+		for y := 0; y < 400; y++ {
+			for x := 0; x < 800; x++ {
+				src.Set(x, y, color.RGBA{R: 200, G: 10, B: 10, A: 0xff})
+			}
+		}
+
+		thumb, err := Generate(encodePNG(t, src), 200)
+		assert.NoError(t, err)
+
+		img, err := jpeg.Decode(bytes.NewReader(thumb))
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 200, 100), img.Bounds())
+
+		r, g, b, _ := img.At(100, 50).RGBA()
+		assert.InDelta(t, 200, r>>8, 8)
+		assert.InDelta(t, 10, g>>8, 8)
+		assert.InDelta(t, 10, b>>8, 8)
+	})
+
+	t.Run("keeps small images at their size", func(t *testing.T) {
+		thumb, err := Generate(encodePNG(t, image.NewRGBA(image.Rect(0, 0, 30, 60))), 200)
+		assert.NoError(t, err)
+
+		img, err := jpeg.Decode(bytes.NewReader(thumb))
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 30, 60), img.Bounds())
+		// Transparent pixels become white
+		r, g, b, _ := img.At(10, 10).RGBA()
+		assert.Equal(t, []uint32{0xff, 0xff, 0xff}, []uint32{r >> 8, g >> 8, b >> 8})
+	})
+
+	t.Run("rejects images too large before decoding them", func(t *testing.T) {
+		// A GIF header declaring a 65535x65535 screen, with no pixels at all
+		header := []byte("GIF89a\xff\xff\xff\xff\x00\x00\x00")
+
+		_, err := Generate(header, 200)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+	})
+
+	t.Run("rejects what is not an image", func(t *testing.T) {
+		_, err := Generate([]byte("%PDF-1.7"), 200)
+		assert.ErrorIs(t, err, ErrUnsupportedImage)
+	})
+}
@@ -0,0 +1,294 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockexpenseAttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type MockexpenseAttachmentRepository struct {
+	mock.Mock
+}
+
+type MockexpenseAttachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockexpenseAttachmentRepository) EXPECT() *MockexpenseAttachmentRepository_Expecter {
+	return &MockexpenseAttachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockexpenseAttachmentRepository) Delete(ctx context.Context, id expense.AttachmentID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.AttachmentID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockexpenseAttachmentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockexpenseAttachmentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id expense.AttachmentID
+func (_e *MockexpenseAttachmentRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockexpenseAttachmentRepository_Delete_Call {
+	return &MockexpenseAttachmentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockexpenseAttachmentRepository_Delete_Call) Run(run func(ctx context.Context, id expense.AttachmentID)) *MockexpenseAttachmentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_Delete_Call) Return(_a0 error) *MockexpenseAttachmentRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_Delete_Call) RunAndReturn(run func(context.Context, expense.AttachmentID) error) *MockexpenseAttachmentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByExpenseID provides a mock function with given fields: ctx, expenseID
+func (_m *MockexpenseAttachmentRepository) GetByExpenseID(ctx context.Context, expenseID expense.ID) ([]expense.Attachment, error) {
+	ret := _m.Called(ctx, expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByExpenseID")
+	}
+
+	var r0 []expense.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ID) ([]expense.Attachment, error)); ok {
+		return rf(ctx, expenseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ID) []expense.Attachment); ok {
+		r0 = rf(ctx, expenseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, expense.ID) error); ok {
+		r1 = rf(ctx, expenseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseAttachmentRepository_GetByExpenseID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByExpenseID'
+type MockexpenseAttachmentRepository_GetByExpenseID_Call struct {
+	*mock.Call
+}
+
+// GetByExpenseID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expenseID expense.ID
+func (_e *MockexpenseAttachmentRepository_Expecter) GetByExpenseID(ctx interface{}, expenseID interface{}) *MockexpenseAttachmentRepository_GetByExpenseID_Call {
+	return &MockexpenseAttachmentRepository_GetByExpenseID_Call{Call: _e.mock.On("GetByExpenseID", ctx, expenseID)}
+}
+
+func (_c *MockexpenseAttachmentRepository_GetByExpenseID_Call) Run(run func(ctx context.Context, expenseID expense.ID)) *MockexpenseAttachmentRepository_GetByExpenseID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.ID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_GetByExpenseID_Call) Return(_a0 []expense.Attachment, _a1 error) *MockexpenseAttachmentRepository_GetByExpenseID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_GetByExpenseID_Call) RunAndReturn(run func(context.Context, expense.ID) ([]expense.Attachment, error)) *MockexpenseAttachmentRepository_GetByExpenseID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockexpenseAttachmentRepository) GetByID(ctx context.Context, id expense.AttachmentID) (*expense.Attachment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *expense.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.AttachmentID) (*expense.Attachment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, expense.AttachmentID) *expense.Attachment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, expense.AttachmentID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseAttachmentRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockexpenseAttachmentRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id expense.AttachmentID
+func (_e *MockexpenseAttachmentRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockexpenseAttachmentRepository_GetByID_Call {
+	return &MockexpenseAttachmentRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockexpenseAttachmentRepository_GetByID_Call) Run(run func(ctx context.Context, id expense.AttachmentID)) *MockexpenseAttachmentRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_GetByID_Call) Return(_a0 *expense.Attachment, _a1 error) *MockexpenseAttachmentRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_GetByID_Call) RunAndReturn(run func(context.Context, expense.AttachmentID) (*expense.Attachment, error)) *MockexpenseAttachmentRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextID provides a mock function with no fields
+func (_m *MockexpenseAttachmentRepository) GetNextID() expense.AttachmentID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextID")
+	}
+
+	var r0 expense.AttachmentID
+	if rf, ok := ret.Get(0).(func() expense.AttachmentID); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(expense.AttachmentID)
+	}
+
+	return r0
+}
+
+// MockexpenseAttachmentRepository_GetNextID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextID'
+type MockexpenseAttachmentRepository_GetNextID_Call struct {
+	*mock.Call
+}
+
+// GetNextID is a helper method to define mock.On call
+func (_e *MockexpenseAttachmentRepository_Expecter) GetNextID() *MockexpenseAttachmentRepository_GetNextID_Call {
+	return &MockexpenseAttachmentRepository_GetNextID_Call{Call: _e.mock.On("GetNextID")}
+}
+
+func (_c *MockexpenseAttachmentRepository_GetNextID_Call) Run(run func()) *MockexpenseAttachmentRepository_GetNextID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_GetNextID_Call) Return(_a0 expense.AttachmentID) *MockexpenseAttachmentRepository_GetNextID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_GetNextID_Call) RunAndReturn(run func() expense.AttachmentID) *MockexpenseAttachmentRepository_GetNextID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Store provides a mock function with given fields: ctx, entity
+func (_m *MockexpenseAttachmentRepository) Store(ctx context.Context, entity *expense.Attachment) error {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *expense.Attachment) error); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockexpenseAttachmentRepository_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type MockexpenseAttachmentRepository_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *expense.Attachment
+func (_e *MockexpenseAttachmentRepository_Expecter) Store(ctx interface{}, entity interface{}) *MockexpenseAttachmentRepository_Store_Call {
+	return &MockexpenseAttachmentRepository_Store_Call{Call: _e.mock.On("Store", ctx, entity)}
+}
+
+func (_c *MockexpenseAttachmentRepository_Store_Call) Run(run func(ctx context.Context, entity *expense.Attachment)) *MockexpenseAttachmentRepository_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*expense.Attachment))
+	})
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_Store_Call) Return(_a0 error) *MockexpenseAttachmentRepository_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseAttachmentRepository_Store_Call) RunAndReturn(run func(context.Context, *expense.Attachment) error) *MockexpenseAttachmentRepository_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockexpenseAttachmentRepository creates a new instance of MockexpenseAttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockexpenseAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockexpenseAttachmentRepository {
+	mock := &MockexpenseAttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,358 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	group "github.com/Beigelman/nossas-despesas/internal/modules/group"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockexpenseBudgetRepository is an autogenerated mock type for the BudgetRepository type
+type MockexpenseBudgetRepository struct {
+	mock.Mock
+}
+
+type MockexpenseBudgetRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockexpenseBudgetRepository) EXPECT() *MockexpenseBudgetRepository_Expecter {
+	return &MockexpenseBudgetRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockexpenseBudgetRepository) Delete(ctx context.Context, id expense.BudgetID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.BudgetID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockexpenseBudgetRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockexpenseBudgetRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id expense.BudgetID
+func (_e *MockexpenseBudgetRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockexpenseBudgetRepository_Delete_Call {
+	return &MockexpenseBudgetRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockexpenseBudgetRepository_Delete_Call) Run(run func(ctx context.Context, id expense.BudgetID)) *MockexpenseBudgetRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.BudgetID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_Delete_Call) Return(_a0 error) *MockexpenseBudgetRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_Delete_Call) RunAndReturn(run func(context.Context, expense.BudgetID) error) *MockexpenseBudgetRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByGroupID provides a mock function with given fields: ctx, groupID
+func (_m *MockexpenseBudgetRepository) GetByGroupID(ctx context.Context, groupID group.ID) ([]expense.Budget, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGroupID")
+	}
+
+	var r0 []expense.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) ([]expense.Budget, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) []expense.Budget); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.Budget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseBudgetRepository_GetByGroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGroupID'
+type MockexpenseBudgetRepository_GetByGroupID_Call struct {
+	*mock.Call
+}
+
+// GetByGroupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+func (_e *MockexpenseBudgetRepository_Expecter) GetByGroupID(ctx interface{}, groupID interface{}) *MockexpenseBudgetRepository_GetByGroupID_Call {
+	return &MockexpenseBudgetRepository_GetByGroupID_Call{Call: _e.mock.On("GetByGroupID", ctx, groupID)}
+}
+
+func (_c *MockexpenseBudgetRepository_GetByGroupID_Call) Run(run func(ctx context.Context, groupID group.ID)) *MockexpenseBudgetRepository_GetByGroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetByGroupID_Call) Return(_a0 []expense.Budget, _a1 error) *MockexpenseBudgetRepository_GetByGroupID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetByGroupID_Call) RunAndReturn(run func(context.Context, group.ID) ([]expense.Budget, error)) *MockexpenseBudgetRepository_GetByGroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockexpenseBudgetRepository) GetByID(ctx context.Context, id expense.BudgetID) (*expense.Budget, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *expense.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.BudgetID) (*expense.Budget, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, expense.BudgetID) *expense.Budget); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.Budget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, expense.BudgetID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseBudgetRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockexpenseBudgetRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id expense.BudgetID
+func (_e *MockexpenseBudgetRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockexpenseBudgetRepository_GetByID_Call {
+	return &MockexpenseBudgetRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockexpenseBudgetRepository_GetByID_Call) Run(run func(ctx context.Context, id expense.BudgetID)) *MockexpenseBudgetRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.BudgetID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetByID_Call) Return(_a0 *expense.Budget, _a1 error) *MockexpenseBudgetRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetByID_Call) RunAndReturn(run func(context.Context, expense.BudgetID) (*expense.Budget, error)) *MockexpenseBudgetRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMonthlySpending provides a mock function with given fields: ctx, budget, until
+func (_m *MockexpenseBudgetRepository) GetMonthlySpending(ctx context.Context, budget expense.Budget, until time.Time) ([]int, error) {
+	ret := _m.Called(ctx, budget, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMonthlySpending")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.Budget, time.Time) ([]int, error)); ok {
+		return rf(ctx, budget, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, expense.Budget, time.Time) []int); ok {
+		r0 = rf(ctx, budget, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, expense.Budget, time.Time) error); ok {
+		r1 = rf(ctx, budget, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseBudgetRepository_GetMonthlySpending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMonthlySpending'
+type MockexpenseBudgetRepository_GetMonthlySpending_Call struct {
+	*mock.Call
+}
+
+// GetMonthlySpending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - budget expense.Budget
+//   - until time.Time
+func (_e *MockexpenseBudgetRepository_Expecter) GetMonthlySpending(ctx interface{}, budget interface{}, until interface{}) *MockexpenseBudgetRepository_GetMonthlySpending_Call {
+	return &MockexpenseBudgetRepository_GetMonthlySpending_Call{Call: _e.mock.On("GetMonthlySpending", ctx, budget, until)}
+}
+
+func (_c *MockexpenseBudgetRepository_GetMonthlySpending_Call) Run(run func(ctx context.Context, budget expense.Budget, until time.Time)) *MockexpenseBudgetRepository_GetMonthlySpending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.Budget), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetMonthlySpending_Call) Return(_a0 []int, _a1 error) *MockexpenseBudgetRepository_GetMonthlySpending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetMonthlySpending_Call) RunAndReturn(run func(context.Context, expense.Budget, time.Time) ([]int, error)) *MockexpenseBudgetRepository_GetMonthlySpending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextID provides a mock function with no fields
+func (_m *MockexpenseBudgetRepository) GetNextID() expense.BudgetID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextID")
+	}
+
+	var r0 expense.BudgetID
+	if rf, ok := ret.Get(0).(func() expense.BudgetID); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(expense.BudgetID)
+	}
+
+	return r0
+}
+
+// MockexpenseBudgetRepository_GetNextID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextID'
+type MockexpenseBudgetRepository_GetNextID_Call struct {
+	*mock.Call
+}
+
+// GetNextID is a helper method to define mock.On call
+func (_e *MockexpenseBudgetRepository_Expecter) GetNextID() *MockexpenseBudgetRepository_GetNextID_Call {
+	return &MockexpenseBudgetRepository_GetNextID_Call{Call: _e.mock.On("GetNextID")}
+}
+
+func (_c *MockexpenseBudgetRepository_GetNextID_Call) Run(run func()) *MockexpenseBudgetRepository_GetNextID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetNextID_Call) Return(_a0 expense.BudgetID) *MockexpenseBudgetRepository_GetNextID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_GetNextID_Call) RunAndReturn(run func() expense.BudgetID) *MockexpenseBudgetRepository_GetNextID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Store provides a mock function with given fields: ctx, entity
+func (_m *MockexpenseBudgetRepository) Store(ctx context.Context, entity *expense.Budget) error {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *expense.Budget) error); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockexpenseBudgetRepository_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type MockexpenseBudgetRepository_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *expense.Budget
+func (_e *MockexpenseBudgetRepository_Expecter) Store(ctx interface{}, entity interface{}) *MockexpenseBudgetRepository_Store_Call {
+	return &MockexpenseBudgetRepository_Store_Call{Call: _e.mock.On("Store", ctx, entity)}
+}
+
+func (_c *MockexpenseBudgetRepository_Store_Call) Run(run func(ctx context.Context, entity *expense.Budget)) *MockexpenseBudgetRepository_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*expense.Budget))
+	})
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_Store_Call) Return(_a0 error) *MockexpenseBudgetRepository_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseBudgetRepository_Store_Call) RunAndReturn(run func(context.Context, *expense.Budget) error) *MockexpenseBudgetRepository_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockexpenseBudgetRepository creates a new instance of MockexpenseBudgetRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockexpenseBudgetRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockexpenseBudgetRepository {
+	mock := &MockexpenseBudgetRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
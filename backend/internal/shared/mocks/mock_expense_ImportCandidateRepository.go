@@ -0,0 +1,309 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	group "github.com/Beigelman/nossas-despesas/internal/modules/group"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockexpenseImportCandidateRepository is an autogenerated mock type for the ImportCandidateRepository type
+type MockexpenseImportCandidateRepository struct {
+	mock.Mock
+}
+
+type MockexpenseImportCandidateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockexpenseImportCandidateRepository) EXPECT() *MockexpenseImportCandidateRepository_Expecter {
+	return &MockexpenseImportCandidateRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetByGroupID provides a mock function with given fields: ctx, groupID, status
+func (_m *MockexpenseImportCandidateRepository) GetByGroupID(ctx context.Context, groupID group.ID, status expense.ImportCandidateStatus) ([]expense.ImportCandidate, error) {
+	ret := _m.Called(ctx, groupID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGroupID")
+	}
+
+	var r0 []expense.ImportCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID, expense.ImportCandidateStatus) ([]expense.ImportCandidate, error)); ok {
+		return rf(ctx, groupID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID, expense.ImportCandidateStatus) []expense.ImportCandidate); ok {
+		r0 = rf(ctx, groupID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.ImportCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ID, expense.ImportCandidateStatus) error); ok {
+		r1 = rf(ctx, groupID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseImportCandidateRepository_GetByGroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGroupID'
+type MockexpenseImportCandidateRepository_GetByGroupID_Call struct {
+	*mock.Call
+}
+
+// GetByGroupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+//   - status expense.ImportCandidateStatus
+func (_e *MockexpenseImportCandidateRepository_Expecter) GetByGroupID(ctx interface{}, groupID interface{}, status interface{}) *MockexpenseImportCandidateRepository_GetByGroupID_Call {
+	return &MockexpenseImportCandidateRepository_GetByGroupID_Call{Call: _e.mock.On("GetByGroupID", ctx, groupID, status)}
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetByGroupID_Call) Run(run func(ctx context.Context, groupID group.ID, status expense.ImportCandidateStatus)) *MockexpenseImportCandidateRepository_GetByGroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID), args[2].(expense.ImportCandidateStatus))
+	})
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetByGroupID_Call) Return(_a0 []expense.ImportCandidate, _a1 error) *MockexpenseImportCandidateRepository_GetByGroupID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetByGroupID_Call) RunAndReturn(run func(context.Context, group.ID, expense.ImportCandidateStatus) ([]expense.ImportCandidate, error)) *MockexpenseImportCandidateRepository_GetByGroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockexpenseImportCandidateRepository) GetByID(ctx context.Context, id expense.ImportCandidateID) (*expense.ImportCandidate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *expense.ImportCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ImportCandidateID) (*expense.ImportCandidate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ImportCandidateID) *expense.ImportCandidate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.ImportCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, expense.ImportCandidateID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseImportCandidateRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockexpenseImportCandidateRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id expense.ImportCandidateID
+func (_e *MockexpenseImportCandidateRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockexpenseImportCandidateRepository_GetByID_Call {
+	return &MockexpenseImportCandidateRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetByID_Call) Run(run func(ctx context.Context, id expense.ImportCandidateID)) *MockexpenseImportCandidateRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.ImportCandidateID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetByID_Call) Return(_a0 *expense.ImportCandidate, _a1 error) *MockexpenseImportCandidateRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetByID_Call) RunAndReturn(run func(context.Context, expense.ImportCandidateID) (*expense.ImportCandidate, error)) *MockexpenseImportCandidateRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextID provides a mock function with no fields
+func (_m *MockexpenseImportCandidateRepository) GetNextID() expense.ImportCandidateID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextID")
+	}
+
+	var r0 expense.ImportCandidateID
+	if rf, ok := ret.Get(0).(func() expense.ImportCandidateID); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(expense.ImportCandidateID)
+	}
+
+	return r0
+}
+
+// MockexpenseImportCandidateRepository_GetNextID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextID'
+type MockexpenseImportCandidateRepository_GetNextID_Call struct {
+	*mock.Call
+}
+
+// GetNextID is a helper method to define mock.On call
+func (_e *MockexpenseImportCandidateRepository_Expecter) GetNextID() *MockexpenseImportCandidateRepository_GetNextID_Call {
+	return &MockexpenseImportCandidateRepository_GetNextID_Call{Call: _e.mock.On("GetNextID")}
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetNextID_Call) Run(run func()) *MockexpenseImportCandidateRepository_GetNextID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetNextID_Call) Return(_a0 expense.ImportCandidateID) *MockexpenseImportCandidateRepository_GetNextID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_GetNextID_Call) RunAndReturn(run func() expense.ImportCandidateID) *MockexpenseImportCandidateRepository_GetNextID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Store provides a mock function with given fields: ctx, entity
+func (_m *MockexpenseImportCandidateRepository) Store(ctx context.Context, entity *expense.ImportCandidate) error {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *expense.ImportCandidate) error); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockexpenseImportCandidateRepository_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type MockexpenseImportCandidateRepository_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *expense.ImportCandidate
+func (_e *MockexpenseImportCandidateRepository_Expecter) Store(ctx interface{}, entity interface{}) *MockexpenseImportCandidateRepository_Store_Call {
+	return &MockexpenseImportCandidateRepository_Store_Call{Call: _e.mock.On("Store", ctx, entity)}
+}
+
+func (_c *MockexpenseImportCandidateRepository_Store_Call) Run(run func(ctx context.Context, entity *expense.ImportCandidate)) *MockexpenseImportCandidateRepository_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*expense.ImportCandidate))
+	})
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_Store_Call) Return(_a0 error) *MockexpenseImportCandidateRepository_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_Store_Call) RunAndReturn(run func(context.Context, *expense.ImportCandidate) error) *MockexpenseImportCandidateRepository_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreNew provides a mock function with given fields: ctx, candidates
+func (_m *MockexpenseImportCandidateRepository) StoreNew(ctx context.Context, candidates []expense.ImportCandidate) ([]expense.ImportCandidate, error) {
+	ret := _m.Called(ctx, candidates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreNew")
+	}
+
+	var r0 []expense.ImportCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []expense.ImportCandidate) ([]expense.ImportCandidate, error)); ok {
+		return rf(ctx, candidates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []expense.ImportCandidate) []expense.ImportCandidate); ok {
+		r0 = rf(ctx, candidates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.ImportCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []expense.ImportCandidate) error); ok {
+		r1 = rf(ctx, candidates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseImportCandidateRepository_StoreNew_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreNew'
+type MockexpenseImportCandidateRepository_StoreNew_Call struct {
+	*mock.Call
+}
+
+// StoreNew is a helper method to define mock.On call
+//   - ctx context.Context
+//   - candidates []expense.ImportCandidate
+func (_e *MockexpenseImportCandidateRepository_Expecter) StoreNew(ctx interface{}, candidates interface{}) *MockexpenseImportCandidateRepository_StoreNew_Call {
+	return &MockexpenseImportCandidateRepository_StoreNew_Call{Call: _e.mock.On("StoreNew", ctx, candidates)}
+}
+
+func (_c *MockexpenseImportCandidateRepository_StoreNew_Call) Run(run func(ctx context.Context, candidates []expense.ImportCandidate)) *MockexpenseImportCandidateRepository_StoreNew_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]expense.ImportCandidate))
+	})
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_StoreNew_Call) Return(_a0 []expense.ImportCandidate, _a1 error) *MockexpenseImportCandidateRepository_StoreNew_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseImportCandidateRepository_StoreNew_Call) RunAndReturn(run func(context.Context, []expense.ImportCandidate) ([]expense.ImportCandidate, error)) *MockexpenseImportCandidateRepository_StoreNew_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockexpenseImportCandidateRepository creates a new instance of MockexpenseImportCandidateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockexpenseImportCandidateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockexpenseImportCandidateRepository {
+	mock := &MockexpenseImportCandidateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,6 +10,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	outbox "github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
+
+	tag "github.com/Beigelman/nossas-despesas/internal/modules/tag"
+
 	time "time"
 )
 
@@ -26,17 +30,24 @@ func (_m *MockexpenseRepository) EXPECT() *MockexpenseRepository_Expecter {
 	return &MockexpenseRepository_Expecter{mock: &_m.Mock}
 }
 
-// BulkStore provides a mock function with given fields: ctx, expenses
-func (_m *MockexpenseRepository) BulkStore(ctx context.Context, expenses []expense.Expense) error {
-	ret := _m.Called(ctx, expenses)
+// BulkStore provides a mock function with given fields: ctx, expenses, events
+func (_m *MockexpenseRepository) BulkStore(ctx context.Context, expenses []expense.Expense, events ...outbox.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, expenses)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BulkStore")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []expense.Expense) error); ok {
-		r0 = rf(ctx, expenses)
+	if rf, ok := ret.Get(0).(func(context.Context, []expense.Expense, ...outbox.Event) error); ok {
+		r0 = rf(ctx, expenses, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -52,13 +63,21 @@ type MockexpenseRepository_BulkStore_Call struct {
 // BulkStore is a helper method to define mock.On call
 //   - ctx context.Context
 //   - expenses []expense.Expense
-func (_e *MockexpenseRepository_Expecter) BulkStore(ctx interface{}, expenses interface{}) *MockexpenseRepository_BulkStore_Call {
-	return &MockexpenseRepository_BulkStore_Call{Call: _e.mock.On("BulkStore", ctx, expenses)}
+//   - events ...outbox.Event
+func (_e *MockexpenseRepository_Expecter) BulkStore(ctx interface{}, expenses interface{}, events ...interface{}) *MockexpenseRepository_BulkStore_Call {
+	return &MockexpenseRepository_BulkStore_Call{Call: _e.mock.On("BulkStore",
+		append([]interface{}{ctx, expenses}, events...)...)}
 }
 
-func (_c *MockexpenseRepository_BulkStore_Call) Run(run func(ctx context.Context, expenses []expense.Expense)) *MockexpenseRepository_BulkStore_Call {
+func (_c *MockexpenseRepository_BulkStore_Call) Run(run func(ctx context.Context, expenses []expense.Expense, events ...outbox.Event)) *MockexpenseRepository_BulkStore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]expense.Expense))
+		variadicArgs := make([]outbox.Event, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.Event)
+			}
+		}
+		run(args[0].(context.Context), args[1].([]expense.Expense), variadicArgs...)
 	})
 	return _c
 }
@@ -68,7 +87,55 @@ func (_c *MockexpenseRepository_BulkStore_Call) Return(_a0 error) *MockexpenseRe
 	return _c
 }
 
-func (_c *MockexpenseRepository_BulkStore_Call) RunAndReturn(run func(context.Context, []expense.Expense) error) *MockexpenseRepository_BulkStore_Call {
+func (_c *MockexpenseRepository_BulkStore_Call) RunAndReturn(run func(context.Context, []expense.Expense, ...outbox.Event) error) *MockexpenseRepository_BulkStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DismissDuplicates provides a mock function with given fields: ctx, groupID, ids
+func (_m *MockexpenseRepository) DismissDuplicates(ctx context.Context, groupID group.ID, ids []expense.ID) error {
+	ret := _m.Called(ctx, groupID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissDuplicates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID, []expense.ID) error); ok {
+		r0 = rf(ctx, groupID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockexpenseRepository_DismissDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissDuplicates'
+type MockexpenseRepository_DismissDuplicates_Call struct {
+	*mock.Call
+}
+
+// DismissDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+//   - ids []expense.ID
+func (_e *MockexpenseRepository_Expecter) DismissDuplicates(ctx interface{}, groupID interface{}, ids interface{}) *MockexpenseRepository_DismissDuplicates_Call {
+	return &MockexpenseRepository_DismissDuplicates_Call{Call: _e.mock.On("DismissDuplicates", ctx, groupID, ids)}
+}
+
+func (_c *MockexpenseRepository_DismissDuplicates_Call) Run(run func(ctx context.Context, groupID group.ID, ids []expense.ID)) *MockexpenseRepository_DismissDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID), args[2].([]expense.ID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseRepository_DismissDuplicates_Call) Return(_a0 error) *MockexpenseRepository_DismissDuplicates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseRepository_DismissDuplicates_Call) RunAndReturn(run func(context.Context, group.ID, []expense.ID) error) *MockexpenseRepository_DismissDuplicates_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -192,6 +259,125 @@ func (_c *MockexpenseRepository_GetByID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetByPurchaseID provides a mock function with given fields: ctx, purchaseID
+func (_m *MockexpenseRepository) GetByPurchaseID(ctx context.Context, purchaseID string) ([]expense.Expense, error) {
+	ret := _m.Called(ctx, purchaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPurchaseID")
+	}
+
+	var r0 []expense.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]expense.Expense, error)); ok {
+		return rf(ctx, purchaseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []expense.Expense); ok {
+		r0 = rf(ctx, purchaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, purchaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseRepository_GetByPurchaseID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPurchaseID'
+type MockexpenseRepository_GetByPurchaseID_Call struct {
+	*mock.Call
+}
+
+// GetByPurchaseID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purchaseID string
+func (_e *MockexpenseRepository_Expecter) GetByPurchaseID(ctx interface{}, purchaseID interface{}) *MockexpenseRepository_GetByPurchaseID_Call {
+	return &MockexpenseRepository_GetByPurchaseID_Call{Call: _e.mock.On("GetByPurchaseID", ctx, purchaseID)}
+}
+
+func (_c *MockexpenseRepository_GetByPurchaseID_Call) Run(run func(ctx context.Context, purchaseID string)) *MockexpenseRepository_GetByPurchaseID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetByPurchaseID_Call) Return(_a0 []expense.Expense, _a1 error) *MockexpenseRepository_GetByPurchaseID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetByPurchaseID_Call) RunAndReturn(run func(context.Context, string) ([]expense.Expense, error)) *MockexpenseRepository_GetByPurchaseID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTagIDs provides a mock function with given fields: ctx, groupID, tagIDs
+func (_m *MockexpenseRepository) GetByTagIDs(ctx context.Context, groupID group.ID, tagIDs []tag.ID) ([]expense.Expense, error) {
+	ret := _m.Called(ctx, groupID, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTagIDs")
+	}
+
+	var r0 []expense.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID, []tag.ID) ([]expense.Expense, error)); ok {
+		return rf(ctx, groupID, tagIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID, []tag.ID) []expense.Expense); ok {
+		r0 = rf(ctx, groupID, tagIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ID, []tag.ID) error); ok {
+		r1 = rf(ctx, groupID, tagIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseRepository_GetByTagIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTagIDs'
+type MockexpenseRepository_GetByTagIDs_Call struct {
+	*mock.Call
+}
+
+// GetByTagIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+//   - tagIDs []tag.ID
+func (_e *MockexpenseRepository_Expecter) GetByTagIDs(ctx interface{}, groupID interface{}, tagIDs interface{}) *MockexpenseRepository_GetByTagIDs_Call {
+	return &MockexpenseRepository_GetByTagIDs_Call{Call: _e.mock.On("GetByTagIDs", ctx, groupID, tagIDs)}
+}
+
+func (_c *MockexpenseRepository_GetByTagIDs_Call) Run(run func(ctx context.Context, groupID group.ID, tagIDs []tag.ID)) *MockexpenseRepository_GetByTagIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID), args[2].([]tag.ID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetByTagIDs_Call) Return(_a0 []expense.Expense, _a1 error) *MockexpenseRepository_GetByTagIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetByTagIDs_Call) RunAndReturn(run func(context.Context, group.ID, []tag.ID) ([]expense.Expense, error)) *MockexpenseRepository_GetByTagIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNextID provides a mock function with no fields
 func (_m *MockexpenseRepository) GetNextID() expense.ID {
 	ret := _m.Called()
@@ -237,6 +423,190 @@ func (_c *MockexpenseRepository_GetNextID_Call) RunAndReturn(run func() expense.
 	return _c
 }
 
+// GetPossibleDuplicates provides a mock function with given fields: ctx, entity
+func (_m *MockexpenseRepository) GetPossibleDuplicates(ctx context.Context, entity expense.Expense) ([]expense.Expense, error) {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPossibleDuplicates")
+	}
+
+	var r0 []expense.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.Expense) ([]expense.Expense, error)); ok {
+		return rf(ctx, entity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, expense.Expense) []expense.Expense); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, expense.Expense) error); ok {
+		r1 = rf(ctx, entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseRepository_GetPossibleDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPossibleDuplicates'
+type MockexpenseRepository_GetPossibleDuplicates_Call struct {
+	*mock.Call
+}
+
+// GetPossibleDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity expense.Expense
+func (_e *MockexpenseRepository_Expecter) GetPossibleDuplicates(ctx interface{}, entity interface{}) *MockexpenseRepository_GetPossibleDuplicates_Call {
+	return &MockexpenseRepository_GetPossibleDuplicates_Call{Call: _e.mock.On("GetPossibleDuplicates", ctx, entity)}
+}
+
+func (_c *MockexpenseRepository_GetPossibleDuplicates_Call) Run(run func(ctx context.Context, entity expense.Expense)) *MockexpenseRepository_GetPossibleDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.Expense))
+	})
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetPossibleDuplicates_Call) Return(_a0 []expense.Expense, _a1 error) *MockexpenseRepository_GetPossibleDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetPossibleDuplicates_Call) RunAndReturn(run func(context.Context, expense.Expense) ([]expense.Expense, error)) *MockexpenseRepository_GetPossibleDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVersions provides a mock function with given fields: ctx, id
+func (_m *MockexpenseRepository) GetVersions(ctx context.Context, id expense.ID) ([]expense.Expense, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVersions")
+	}
+
+	var r0 []expense.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ID) ([]expense.Expense, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ID) []expense.Expense); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, expense.ID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseRepository_GetVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersions'
+type MockexpenseRepository_GetVersions_Call struct {
+	*mock.Call
+}
+
+// GetVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id expense.ID
+func (_e *MockexpenseRepository_Expecter) GetVersions(ctx interface{}, id interface{}) *MockexpenseRepository_GetVersions_Call {
+	return &MockexpenseRepository_GetVersions_Call{Call: _e.mock.On("GetVersions", ctx, id)}
+}
+
+func (_c *MockexpenseRepository_GetVersions_Call) Run(run func(ctx context.Context, id expense.ID)) *MockexpenseRepository_GetVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(expense.ID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetVersions_Call) Return(_a0 []expense.Expense, _a1 error) *MockexpenseRepository_GetVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseRepository_GetVersions_Call) RunAndReturn(run func(context.Context, expense.ID) ([]expense.Expense, error)) *MockexpenseRepository_GetVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeleted provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockexpenseRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, []expense.Attachment, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 int
+	var r1 []expense.Attachment
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, []expense.Attachment, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) []expense.Attachment); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]expense.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = rf(ctx, deletedBefore)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockexpenseRepository_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockexpenseRepository_PurgeDeleted_Call struct {
+	*mock.Call
+}
+
+// PurgeDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *MockexpenseRepository_Expecter) PurgeDeleted(ctx interface{}, deletedBefore interface{}) *MockexpenseRepository_PurgeDeleted_Call {
+	return &MockexpenseRepository_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", ctx, deletedBefore)}
+}
+
+func (_c *MockexpenseRepository_PurgeDeleted_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *MockexpenseRepository_PurgeDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockexpenseRepository_PurgeDeleted_Call) Return(_a0 int, _a1 []expense.Attachment, _a2 error) *MockexpenseRepository_PurgeDeleted_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockexpenseRepository_PurgeDeleted_Call) RunAndReturn(run func(context.Context, time.Time) (int, []expense.Attachment, error)) *MockexpenseRepository_PurgeDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Store provides a mock function with given fields: ctx, entity
 func (_m *MockexpenseRepository) Store(ctx context.Context, entity *expense.Expense) error {
 	ret := _m.Called(ctx, entity)
@@ -284,6 +654,68 @@ func (_c *MockexpenseRepository_Store_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// StoreWithEvents provides a mock function with given fields: ctx, _a1, events
+func (_m *MockexpenseRepository) StoreWithEvents(ctx context.Context, _a1 *expense.Expense, events ...outbox.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreWithEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *expense.Expense, ...outbox.Event) error); ok {
+		r0 = rf(ctx, _a1, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockexpenseRepository_StoreWithEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreWithEvents'
+type MockexpenseRepository_StoreWithEvents_Call struct {
+	*mock.Call
+}
+
+// StoreWithEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *expense.Expense
+//   - events ...outbox.Event
+func (_e *MockexpenseRepository_Expecter) StoreWithEvents(ctx interface{}, _a1 interface{}, events ...interface{}) *MockexpenseRepository_StoreWithEvents_Call {
+	return &MockexpenseRepository_StoreWithEvents_Call{Call: _e.mock.On("StoreWithEvents",
+		append([]interface{}{ctx, _a1}, events...)...)}
+}
+
+func (_c *MockexpenseRepository_StoreWithEvents_Call) Run(run func(ctx context.Context, _a1 *expense.Expense, events ...outbox.Event)) *MockexpenseRepository_StoreWithEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.Event, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.Event)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*expense.Expense), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockexpenseRepository_StoreWithEvents_Call) Return(_a0 error) *MockexpenseRepository_StoreWithEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockexpenseRepository_StoreWithEvents_Call) RunAndReturn(run func(context.Context, *expense.Expense, ...outbox.Event) error) *MockexpenseRepository_StoreWithEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockexpenseRepository creates a new instance of MockexpenseRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockexpenseRepository(t interface {
@@ -5,7 +5,12 @@ package mocks
 import (
 	context "context"
 
+	civil "cloud.google.com/go/civil"
+
 	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+
+	group "github.com/Beigelman/nossas-despesas/internal/modules/group"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -127,6 +132,65 @@ func (_c *MockexpenseScheduledExpenseRepository_GetActiveScheduledExpenses_Call)
 	return _c
 }
 
+// GetByGroupID provides a mock function with given fields: ctx, groupID
+func (_m *MockexpenseScheduledExpenseRepository) GetByGroupID(ctx context.Context, groupID group.ID) ([]expense.ScheduledExpense, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGroupID")
+	}
+
+	var r0 []expense.ScheduledExpense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) ([]expense.ScheduledExpense, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) []expense.ScheduledExpense); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.ScheduledExpense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseScheduledExpenseRepository_GetByGroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGroupID'
+type MockexpenseScheduledExpenseRepository_GetByGroupID_Call struct {
+	*mock.Call
+}
+
+// GetByGroupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+func (_e *MockexpenseScheduledExpenseRepository_Expecter) GetByGroupID(ctx interface{}, groupID interface{}) *MockexpenseScheduledExpenseRepository_GetByGroupID_Call {
+	return &MockexpenseScheduledExpenseRepository_GetByGroupID_Call{Call: _e.mock.On("GetByGroupID", ctx, groupID)}
+}
+
+func (_c *MockexpenseScheduledExpenseRepository_GetByGroupID_Call) Run(run func(ctx context.Context, groupID group.ID)) *MockexpenseScheduledExpenseRepository_GetByGroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID))
+	})
+	return _c
+}
+
+func (_c *MockexpenseScheduledExpenseRepository_GetByGroupID_Call) Return(_a0 []expense.ScheduledExpense, _a1 error) *MockexpenseScheduledExpenseRepository_GetByGroupID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseScheduledExpenseRepository_GetByGroupID_Call) RunAndReturn(run func(context.Context, group.ID) ([]expense.ScheduledExpense, error)) *MockexpenseScheduledExpenseRepository_GetByGroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *MockexpenseScheduledExpenseRepository) GetByID(ctx context.Context, id expense.ScheduledExpenseID) (*expense.ScheduledExpense, error) {
 	ret := _m.Called(ctx, id)
@@ -278,6 +342,66 @@ func (_c *MockexpenseScheduledExpenseRepository_Store_Call) RunAndReturn(run fun
 	return _c
 }
 
+// StoreOccurrences provides a mock function with given fields: ctx, scheduledExpense, occurrences
+func (_m *MockexpenseScheduledExpenseRepository) StoreOccurrences(ctx context.Context, scheduledExpense *expense.ScheduledExpense, occurrences []expense.ScheduledOccurrence) ([]civil.Date, error) {
+	ret := _m.Called(ctx, scheduledExpense, occurrences)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreOccurrences")
+	}
+
+	var r0 []civil.Date
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *expense.ScheduledExpense, []expense.ScheduledOccurrence) ([]civil.Date, error)); ok {
+		return rf(ctx, scheduledExpense, occurrences)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *expense.ScheduledExpense, []expense.ScheduledOccurrence) []civil.Date); ok {
+		r0 = rf(ctx, scheduledExpense, occurrences)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]civil.Date)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *expense.ScheduledExpense, []expense.ScheduledOccurrence) error); ok {
+		r1 = rf(ctx, scheduledExpense, occurrences)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockexpenseScheduledExpenseRepository_StoreOccurrences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreOccurrences'
+type MockexpenseScheduledExpenseRepository_StoreOccurrences_Call struct {
+	*mock.Call
+}
+
+// StoreOccurrences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduledExpense *expense.ScheduledExpense
+//   - occurrences []expense.ScheduledOccurrence
+func (_e *MockexpenseScheduledExpenseRepository_Expecter) StoreOccurrences(ctx interface{}, scheduledExpense interface{}, occurrences interface{}) *MockexpenseScheduledExpenseRepository_StoreOccurrences_Call {
+	return &MockexpenseScheduledExpenseRepository_StoreOccurrences_Call{Call: _e.mock.On("StoreOccurrences", ctx, scheduledExpense, occurrences)}
+}
+
+func (_c *MockexpenseScheduledExpenseRepository_StoreOccurrences_Call) Run(run func(ctx context.Context, scheduledExpense *expense.ScheduledExpense, occurrences []expense.ScheduledOccurrence)) *MockexpenseScheduledExpenseRepository_StoreOccurrences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*expense.ScheduledExpense), args[2].([]expense.ScheduledOccurrence))
+	})
+	return _c
+}
+
+func (_c *MockexpenseScheduledExpenseRepository_StoreOccurrences_Call) Return(_a0 []civil.Date, _a1 error) *MockexpenseScheduledExpenseRepository_StoreOccurrences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockexpenseScheduledExpenseRepository_StoreOccurrences_Call) RunAndReturn(run func(context.Context, *expense.ScheduledExpense, []expense.ScheduledOccurrence) ([]civil.Date, error)) *MockexpenseScheduledExpenseRepository_StoreOccurrences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockexpenseScheduledExpenseRepository creates a new instance of MockexpenseScheduledExpenseRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockexpenseScheduledExpenseRepository(t interface {
@@ -8,6 +8,8 @@ import (
 	income "github.com/Beigelman/nossas-despesas/internal/modules/income"
 	mock "github.com/stretchr/testify/mock"
 
+	outbox "github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
+
 	time "time"
 
 	user "github.com/Beigelman/nossas-despesas/internal/modules/user"
@@ -85,6 +87,65 @@ func (_c *MockincomeRepository_GetByID_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetDeletedByID provides a mock function with given fields: ctx, id
+func (_m *MockincomeRepository) GetDeletedByID(ctx context.Context, id income.ID) (*income.Income, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByID")
+	}
+
+	var r0 *income.Income
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, income.ID) (*income.Income, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, income.ID) *income.Income); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*income.Income)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, income.ID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockincomeRepository_GetDeletedByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByID'
+type MockincomeRepository_GetDeletedByID_Call struct {
+	*mock.Call
+}
+
+// GetDeletedByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id income.ID
+func (_e *MockincomeRepository_Expecter) GetDeletedByID(ctx interface{}, id interface{}) *MockincomeRepository_GetDeletedByID_Call {
+	return &MockincomeRepository_GetDeletedByID_Call{Call: _e.mock.On("GetDeletedByID", ctx, id)}
+}
+
+func (_c *MockincomeRepository_GetDeletedByID_Call) Run(run func(ctx context.Context, id income.ID)) *MockincomeRepository_GetDeletedByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(income.ID))
+	})
+	return _c
+}
+
+func (_c *MockincomeRepository_GetDeletedByID_Call) Return(_a0 *income.Income, _a1 error) *MockincomeRepository_GetDeletedByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockincomeRepository_GetDeletedByID_Call) RunAndReturn(run func(context.Context, income.ID) (*income.Income, error)) *MockincomeRepository_GetDeletedByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNextID provides a mock function with no fields
 func (_m *MockincomeRepository) GetNextID() income.ID {
 	ret := _m.Called()
@@ -190,6 +251,63 @@ func (_c *MockincomeRepository_GetUserMonthlyIncomes_Call) RunAndReturn(run func
 	return _c
 }
 
+// PurgeDeleted provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockincomeRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockincomeRepository_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockincomeRepository_PurgeDeleted_Call struct {
+	*mock.Call
+}
+
+// PurgeDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *MockincomeRepository_Expecter) PurgeDeleted(ctx interface{}, deletedBefore interface{}) *MockincomeRepository_PurgeDeleted_Call {
+	return &MockincomeRepository_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", ctx, deletedBefore)}
+}
+
+func (_c *MockincomeRepository_PurgeDeleted_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *MockincomeRepository_PurgeDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockincomeRepository_PurgeDeleted_Call) Return(_a0 int, _a1 error) *MockincomeRepository_PurgeDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockincomeRepository_PurgeDeleted_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *MockincomeRepository_PurgeDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Store provides a mock function with given fields: ctx, entity
 func (_m *MockincomeRepository) Store(ctx context.Context, entity *income.Income) error {
 	ret := _m.Called(ctx, entity)
@@ -237,6 +355,68 @@ func (_c *MockincomeRepository_Store_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// StoreWithEvents provides a mock function with given fields: ctx, _a1, events
+func (_m *MockincomeRepository) StoreWithEvents(ctx context.Context, _a1 *income.Income, events ...outbox.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreWithEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *income.Income, ...outbox.Event) error); ok {
+		r0 = rf(ctx, _a1, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockincomeRepository_StoreWithEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreWithEvents'
+type MockincomeRepository_StoreWithEvents_Call struct {
+	*mock.Call
+}
+
+// StoreWithEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *income.Income
+//   - events ...outbox.Event
+func (_e *MockincomeRepository_Expecter) StoreWithEvents(ctx interface{}, _a1 interface{}, events ...interface{}) *MockincomeRepository_StoreWithEvents_Call {
+	return &MockincomeRepository_StoreWithEvents_Call{Call: _e.mock.On("StoreWithEvents",
+		append([]interface{}{ctx, _a1}, events...)...)}
+}
+
+func (_c *MockincomeRepository_StoreWithEvents_Call) Run(run func(ctx context.Context, _a1 *income.Income, events ...outbox.Event)) *MockincomeRepository_StoreWithEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.Event, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.Event)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*income.Income), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockincomeRepository_StoreWithEvents_Call) Return(_a0 error) *MockincomeRepository_StoreWithEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockincomeRepository_StoreWithEvents_Call) RunAndReturn(run func(context.Context, *income.Income, ...outbox.Event) error) *MockincomeRepository_StoreWithEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockincomeRepository creates a new instance of MockincomeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockincomeRepository(t interface {
@@ -0,0 +1,192 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockserviceFileStorage is an autogenerated mock type for the FileStorage type
+type MockserviceFileStorage struct {
+	mock.Mock
+}
+
+type MockserviceFileStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockserviceFileStorage) EXPECT() *MockserviceFileStorage_Expecter {
+	return &MockserviceFileStorage_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *MockserviceFileStorage) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockserviceFileStorage_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockserviceFileStorage_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockserviceFileStorage_Expecter) Delete(ctx interface{}, key interface{}) *MockserviceFileStorage_Delete_Call {
+	return &MockserviceFileStorage_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockserviceFileStorage_Delete_Call) Run(run func(ctx context.Context, key string)) *MockserviceFileStorage_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockserviceFileStorage_Delete_Call) Return(_a0 error) *MockserviceFileStorage_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockserviceFileStorage_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockserviceFileStorage_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, key
+func (_m *MockserviceFileStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockserviceFileStorage_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockserviceFileStorage_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockserviceFileStorage_Expecter) Get(ctx interface{}, key interface{}) *MockserviceFileStorage_Get_Call {
+	return &MockserviceFileStorage_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockserviceFileStorage_Get_Call) Run(run func(ctx context.Context, key string)) *MockserviceFileStorage_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockserviceFileStorage_Get_Call) Return(_a0 io.ReadCloser, _a1 error) *MockserviceFileStorage_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockserviceFileStorage_Get_Call) RunAndReturn(run func(context.Context, string) (io.ReadCloser, error)) *MockserviceFileStorage_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, key, content, contentType
+func (_m *MockserviceFileStorage) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	ret := _m.Called(ctx, key, content, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, string) error); ok {
+		r0 = rf(ctx, key, content, contentType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockserviceFileStorage_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockserviceFileStorage_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - content []byte
+//   - contentType string
+func (_e *MockserviceFileStorage_Expecter) Put(ctx interface{}, key interface{}, content interface{}, contentType interface{}) *MockserviceFileStorage_Put_Call {
+	return &MockserviceFileStorage_Put_Call{Call: _e.mock.On("Put", ctx, key, content, contentType)}
+}
+
+func (_c *MockserviceFileStorage_Put_Call) Run(run func(ctx context.Context, key string, content []byte, contentType string)) *MockserviceFileStorage_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockserviceFileStorage_Put_Call) Return(_a0 error) *MockserviceFileStorage_Put_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockserviceFileStorage_Put_Call) RunAndReturn(run func(context.Context, string, []byte, string) error) *MockserviceFileStorage_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockserviceFileStorage creates a new instance of MockserviceFileStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockserviceFileStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockserviceFileStorage {
+	mock := &MockserviceFileStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,188 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	settlement "github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MocksettlementRepository is an autogenerated mock type for the Repository type
+type MocksettlementRepository struct {
+	mock.Mock
+}
+
+type MocksettlementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MocksettlementRepository) EXPECT() *MocksettlementRepository_Expecter {
+	return &MocksettlementRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MocksettlementRepository) GetByID(ctx context.Context, id settlement.ID) (*settlement.Settlement, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *settlement.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, settlement.ID) (*settlement.Settlement, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, settlement.ID) *settlement.Settlement); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*settlement.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, settlement.ID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MocksettlementRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MocksettlementRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id settlement.ID
+func (_e *MocksettlementRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MocksettlementRepository_GetByID_Call {
+	return &MocksettlementRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MocksettlementRepository_GetByID_Call) Run(run func(ctx context.Context, id settlement.ID)) *MocksettlementRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(settlement.ID))
+	})
+	return _c
+}
+
+func (_c *MocksettlementRepository_GetByID_Call) Return(_a0 *settlement.Settlement, _a1 error) *MocksettlementRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MocksettlementRepository_GetByID_Call) RunAndReturn(run func(context.Context, settlement.ID) (*settlement.Settlement, error)) *MocksettlementRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextID provides a mock function with no fields
+func (_m *MocksettlementRepository) GetNextID() settlement.ID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextID")
+	}
+
+	var r0 settlement.ID
+	if rf, ok := ret.Get(0).(func() settlement.ID); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(settlement.ID)
+	}
+
+	return r0
+}
+
+// MocksettlementRepository_GetNextID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextID'
+type MocksettlementRepository_GetNextID_Call struct {
+	*mock.Call
+}
+
+// GetNextID is a helper method to define mock.On call
+func (_e *MocksettlementRepository_Expecter) GetNextID() *MocksettlementRepository_GetNextID_Call {
+	return &MocksettlementRepository_GetNextID_Call{Call: _e.mock.On("GetNextID")}
+}
+
+func (_c *MocksettlementRepository_GetNextID_Call) Run(run func()) *MocksettlementRepository_GetNextID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MocksettlementRepository_GetNextID_Call) Return(_a0 settlement.ID) *MocksettlementRepository_GetNextID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MocksettlementRepository_GetNextID_Call) RunAndReturn(run func() settlement.ID) *MocksettlementRepository_GetNextID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Store provides a mock function with given fields: ctx, entity
+func (_m *MocksettlementRepository) Store(ctx context.Context, entity *settlement.Settlement) error {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *settlement.Settlement) error); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MocksettlementRepository_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type MocksettlementRepository_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *settlement.Settlement
+func (_e *MocksettlementRepository_Expecter) Store(ctx interface{}, entity interface{}) *MocksettlementRepository_Store_Call {
+	return &MocksettlementRepository_Store_Call{Call: _e.mock.On("Store", ctx, entity)}
+}
+
+func (_c *MocksettlementRepository_Store_Call) Run(run func(ctx context.Context, entity *settlement.Settlement)) *MocksettlementRepository_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*settlement.Settlement))
+	})
+	return _c
+}
+
+func (_c *MocksettlementRepository_Store_Call) Return(_a0 error) *MocksettlementRepository_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MocksettlementRepository_Store_Call) RunAndReturn(run func(context.Context, *settlement.Settlement) error) *MocksettlementRepository_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMocksettlementRepository creates a new instance of MocksettlementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMocksettlementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MocksettlementRepository {
+	mock := &MocksettlementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,297 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	group "github.com/Beigelman/nossas-despesas/internal/modules/group"
+	mock "github.com/stretchr/testify/mock"
+
+	tag "github.com/Beigelman/nossas-despesas/internal/modules/tag"
+)
+
+// MocktagRepository is an autogenerated mock type for the Repository type
+type MocktagRepository struct {
+	mock.Mock
+}
+
+type MocktagRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MocktagRepository) EXPECT() *MocktagRepository_Expecter {
+	return &MocktagRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, ids
+func (_m *MocktagRepository) Delete(ctx context.Context, ids []tag.ID) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []tag.ID) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MocktagRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MocktagRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []tag.ID
+func (_e *MocktagRepository_Expecter) Delete(ctx interface{}, ids interface{}) *MocktagRepository_Delete_Call {
+	return &MocktagRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, ids)}
+}
+
+func (_c *MocktagRepository_Delete_Call) Run(run func(ctx context.Context, ids []tag.ID)) *MocktagRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]tag.ID))
+	})
+	return _c
+}
+
+func (_c *MocktagRepository_Delete_Call) Return(_a0 error) *MocktagRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MocktagRepository_Delete_Call) RunAndReturn(run func(context.Context, []tag.ID) error) *MocktagRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MocktagRepository) GetByID(ctx context.Context, id tag.ID) (*tag.Tag, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.ID) (*tag.Tag, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.ID) *tag.Tag); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.ID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MocktagRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MocktagRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id tag.ID
+func (_e *MocktagRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MocktagRepository_GetByID_Call {
+	return &MocktagRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MocktagRepository_GetByID_Call) Run(run func(ctx context.Context, id tag.ID)) *MocktagRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.ID))
+	})
+	return _c
+}
+
+func (_c *MocktagRepository_GetByID_Call) Return(_a0 *tag.Tag, _a1 error) *MocktagRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MocktagRepository_GetByID_Call) RunAndReturn(run func(context.Context, tag.ID) (*tag.Tag, error)) *MocktagRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function with given fields: ctx, groupID, name
+func (_m *MocktagRepository) GetByName(ctx context.Context, groupID group.ID, name string) (*tag.Tag, error) {
+	ret := _m.Called(ctx, groupID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID, string) (*tag.Tag, error)); ok {
+		return rf(ctx, groupID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID, string) *tag.Tag); ok {
+		r0 = rf(ctx, groupID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ID, string) error); ok {
+		r1 = rf(ctx, groupID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MocktagRepository_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type MocktagRepository_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+//   - name string
+func (_e *MocktagRepository_Expecter) GetByName(ctx interface{}, groupID interface{}, name interface{}) *MocktagRepository_GetByName_Call {
+	return &MocktagRepository_GetByName_Call{Call: _e.mock.On("GetByName", ctx, groupID, name)}
+}
+
+func (_c *MocktagRepository_GetByName_Call) Run(run func(ctx context.Context, groupID group.ID, name string)) *MocktagRepository_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MocktagRepository_GetByName_Call) Return(_a0 *tag.Tag, _a1 error) *MocktagRepository_GetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MocktagRepository_GetByName_Call) RunAndReturn(run func(context.Context, group.ID, string) (*tag.Tag, error)) *MocktagRepository_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextID provides a mock function with no fields
+func (_m *MocktagRepository) GetNextID() tag.ID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextID")
+	}
+
+	var r0 tag.ID
+	if rf, ok := ret.Get(0).(func() tag.ID); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(tag.ID)
+	}
+
+	return r0
+}
+
+// MocktagRepository_GetNextID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextID'
+type MocktagRepository_GetNextID_Call struct {
+	*mock.Call
+}
+
+// GetNextID is a helper method to define mock.On call
+func (_e *MocktagRepository_Expecter) GetNextID() *MocktagRepository_GetNextID_Call {
+	return &MocktagRepository_GetNextID_Call{Call: _e.mock.On("GetNextID")}
+}
+
+func (_c *MocktagRepository_GetNextID_Call) Run(run func()) *MocktagRepository_GetNextID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MocktagRepository_GetNextID_Call) Return(_a0 tag.ID) *MocktagRepository_GetNextID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MocktagRepository_GetNextID_Call) RunAndReturn(run func() tag.ID) *MocktagRepository_GetNextID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Store provides a mock function with given fields: ctx, entity
+func (_m *MocktagRepository) Store(ctx context.Context, entity *tag.Tag) error {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *tag.Tag) error); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MocktagRepository_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type MocktagRepository_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity *tag.Tag
+func (_e *MocktagRepository_Expecter) Store(ctx interface{}, entity interface{}) *MocktagRepository_Store_Call {
+	return &MocktagRepository_Store_Call{Call: _e.mock.On("Store", ctx, entity)}
+}
+
+func (_c *MocktagRepository_Store_Call) Run(run func(ctx context.Context, entity *tag.Tag)) *MocktagRepository_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*tag.Tag))
+	})
+	return _c
+}
+
+func (_c *MocktagRepository_Store_Call) Return(_a0 error) *MocktagRepository_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MocktagRepository_Store_Call) RunAndReturn(run func(context.Context, *tag.Tag) error) *MocktagRepository_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMocktagRepository creates a new instance of MocktagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMocktagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MocktagRepository {
+	mock := &MocktagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
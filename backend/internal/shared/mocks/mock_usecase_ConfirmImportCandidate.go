@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseConfirmImportCandidate is an autogenerated mock type for the ConfirmImportCandidate type
+type MockusecaseConfirmImportCandidate struct {
+	mock.Mock
+}
+
+type MockusecaseConfirmImportCandidate_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseConfirmImportCandidate) EXPECT() *MockusecaseConfirmImportCandidate_Expecter {
+	return &MockusecaseConfirmImportCandidate_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseConfirmImportCandidate) Execute(ctx context.Context, p usecase.ConfirmImportCandidateParams) (*usecase.CreateExpenseResult, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *usecase.CreateExpenseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.ConfirmImportCandidateParams) (*usecase.CreateExpenseResult, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.ConfirmImportCandidateParams) *usecase.CreateExpenseResult); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CreateExpenseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.ConfirmImportCandidateParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseConfirmImportCandidate_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseConfirmImportCandidate_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.ConfirmImportCandidateParams
+func (_e *MockusecaseConfirmImportCandidate_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseConfirmImportCandidate_Execute_Call {
+	return &MockusecaseConfirmImportCandidate_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseConfirmImportCandidate_Execute_Call) Run(run func(ctx context.Context, p usecase.ConfirmImportCandidateParams)) *MockusecaseConfirmImportCandidate_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.ConfirmImportCandidateParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseConfirmImportCandidate_Execute_Call) Return(_a0 *usecase.CreateExpenseResult, _a1 error) *MockusecaseConfirmImportCandidate_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseConfirmImportCandidate_Execute_Call) RunAndReturn(run func(context.Context, usecase.ConfirmImportCandidateParams) (*usecase.CreateExpenseResult, error)) *MockusecaseConfirmImportCandidate_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseConfirmImportCandidate creates a new instance of MockusecaseConfirmImportCandidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseConfirmImportCandidate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseConfirmImportCandidate {
+	mock := &MockusecaseConfirmImportCandidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,10 +5,8 @@ package mocks
 import (
 	context "context"
 
-	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
-	mock "github.com/stretchr/testify/mock"
-
 	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MockusecaseCreateExpense is an autogenerated mock type for the CreateExpense type
@@ -25,23 +23,23 @@ func (_m *MockusecaseCreateExpense) EXPECT() *MockusecaseCreateExpense_Expecter
 }
 
 // Execute provides a mock function with given fields: ctx, p
-func (_m *MockusecaseCreateExpense) Execute(ctx context.Context, p usecase.CreateExpenseParams) (*expense.Expense, error) {
+func (_m *MockusecaseCreateExpense) Execute(ctx context.Context, p usecase.CreateExpenseParams) (*usecase.CreateExpenseResult, error) {
 	ret := _m.Called(ctx, p)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 *expense.Expense
+	var r0 *usecase.CreateExpenseResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateExpenseParams) (*expense.Expense, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateExpenseParams) (*usecase.CreateExpenseResult, error)); ok {
 		return rf(ctx, p)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateExpenseParams) *expense.Expense); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateExpenseParams) *usecase.CreateExpenseResult); ok {
 		r0 = rf(ctx, p)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*expense.Expense)
+			r0 = ret.Get(0).(*usecase.CreateExpenseResult)
 		}
 	}
 
@@ -73,12 +71,12 @@ func (_c *MockusecaseCreateExpense_Execute_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockusecaseCreateExpense_Execute_Call) Return(_a0 *expense.Expense, _a1 error) *MockusecaseCreateExpense_Execute_Call {
+func (_c *MockusecaseCreateExpense_Execute_Call) Return(_a0 *usecase.CreateExpenseResult, _a1 error) *MockusecaseCreateExpense_Execute_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockusecaseCreateExpense_Execute_Call) RunAndReturn(run func(context.Context, usecase.CreateExpenseParams) (*expense.Expense, error)) *MockusecaseCreateExpense_Execute_Call {
+func (_c *MockusecaseCreateExpense_Execute_Call) RunAndReturn(run func(context.Context, usecase.CreateExpenseParams) (*usecase.CreateExpenseResult, error)) *MockusecaseCreateExpense_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	settlement "github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/settlement/usecase"
+)
+
+// MockusecaseCreateSettlement is an autogenerated mock type for the CreateSettlement type
+type MockusecaseCreateSettlement struct {
+	mock.Mock
+}
+
+type MockusecaseCreateSettlement_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseCreateSettlement) EXPECT() *MockusecaseCreateSettlement_Expecter {
+	return &MockusecaseCreateSettlement_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseCreateSettlement) Execute(ctx context.Context, p usecase.CreateSettlementParams) (*settlement.Settlement, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *settlement.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateSettlementParams) (*settlement.Settlement, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateSettlementParams) *settlement.Settlement); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*settlement.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.CreateSettlementParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseCreateSettlement_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseCreateSettlement_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.CreateSettlementParams
+func (_e *MockusecaseCreateSettlement_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseCreateSettlement_Execute_Call {
+	return &MockusecaseCreateSettlement_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseCreateSettlement_Execute_Call) Run(run func(ctx context.Context, p usecase.CreateSettlementParams)) *MockusecaseCreateSettlement_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.CreateSettlementParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseCreateSettlement_Execute_Call) Return(_a0 *settlement.Settlement, _a1 error) *MockusecaseCreateSettlement_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseCreateSettlement_Execute_Call) RunAndReturn(run func(context.Context, usecase.CreateSettlementParams) (*settlement.Settlement, error)) *MockusecaseCreateSettlement_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseCreateSettlement creates a new instance of MockusecaseCreateSettlement. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseCreateSettlement(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseCreateSettlement {
+	mock := &MockusecaseCreateSettlement{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
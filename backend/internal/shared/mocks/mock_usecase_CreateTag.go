@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+)
+
+// MockusecaseCreateTag is an autogenerated mock type for the CreateTag type
+type MockusecaseCreateTag struct {
+	mock.Mock
+}
+
+type MockusecaseCreateTag_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseCreateTag) EXPECT() *MockusecaseCreateTag_Expecter {
+	return &MockusecaseCreateTag_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseCreateTag) Execute(ctx context.Context, p usecase.CreateTagParams) (*tag.Tag, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateTagParams) (*tag.Tag, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.CreateTagParams) *tag.Tag); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.CreateTagParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseCreateTag_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseCreateTag_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.CreateTagParams
+func (_e *MockusecaseCreateTag_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseCreateTag_Execute_Call {
+	return &MockusecaseCreateTag_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseCreateTag_Execute_Call) Run(run func(ctx context.Context, p usecase.CreateTagParams)) *MockusecaseCreateTag_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.CreateTagParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseCreateTag_Execute_Call) Return(_a0 *tag.Tag, _a1 error) *MockusecaseCreateTag_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseCreateTag_Execute_Call) RunAndReturn(run func(context.Context, usecase.CreateTagParams) (*tag.Tag, error)) *MockusecaseCreateTag_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseCreateTag creates a new instance of MockusecaseCreateTag. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseCreateTag(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseCreateTag {
+	mock := &MockusecaseCreateTag{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseDeleteAttachment is an autogenerated mock type for the DeleteAttachment type
+type MockusecaseDeleteAttachment struct {
+	mock.Mock
+}
+
+type MockusecaseDeleteAttachment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseDeleteAttachment) EXPECT() *MockusecaseDeleteAttachment_Expecter {
+	return &MockusecaseDeleteAttachment_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseDeleteAttachment) Execute(ctx context.Context, p usecase.DeleteAttachmentParams) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DeleteAttachmentParams) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockusecaseDeleteAttachment_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseDeleteAttachment_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.DeleteAttachmentParams
+func (_e *MockusecaseDeleteAttachment_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseDeleteAttachment_Execute_Call {
+	return &MockusecaseDeleteAttachment_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseDeleteAttachment_Execute_Call) Run(run func(ctx context.Context, p usecase.DeleteAttachmentParams)) *MockusecaseDeleteAttachment_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.DeleteAttachmentParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseDeleteAttachment_Execute_Call) Return(_a0 error) *MockusecaseDeleteAttachment_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockusecaseDeleteAttachment_Execute_Call) RunAndReturn(run func(context.Context, usecase.DeleteAttachmentParams) error) *MockusecaseDeleteAttachment_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseDeleteAttachment creates a new instance of MockusecaseDeleteAttachment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseDeleteAttachment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseDeleteAttachment {
+	mock := &MockusecaseDeleteAttachment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseDeleteBudget is an autogenerated mock type for the DeleteBudget type
+type MockusecaseDeleteBudget struct {
+	mock.Mock
+}
+
+type MockusecaseDeleteBudget_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseDeleteBudget) EXPECT() *MockusecaseDeleteBudget_Expecter {
+	return &MockusecaseDeleteBudget_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseDeleteBudget) Execute(ctx context.Context, p usecase.BudgetParams) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.BudgetParams) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockusecaseDeleteBudget_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseDeleteBudget_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.BudgetParams
+func (_e *MockusecaseDeleteBudget_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseDeleteBudget_Execute_Call {
+	return &MockusecaseDeleteBudget_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseDeleteBudget_Execute_Call) Run(run func(ctx context.Context, p usecase.BudgetParams)) *MockusecaseDeleteBudget_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.BudgetParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseDeleteBudget_Execute_Call) Return(_a0 error) *MockusecaseDeleteBudget_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockusecaseDeleteBudget_Execute_Call) RunAndReturn(run func(context.Context, usecase.BudgetParams) error) *MockusecaseDeleteBudget_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseDeleteBudget creates a new instance of MockusecaseDeleteBudget. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseDeleteBudget(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseDeleteBudget {
+	mock := &MockusecaseDeleteBudget{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 
 	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
 	mock "github.com/stretchr/testify/mock"
+
+	user "github.com/Beigelman/nossas-despesas/internal/modules/user"
 )
 
 // MockusecaseDeleteExpense is an autogenerated mock type for the DeleteExpense type
@@ -22,9 +24,9 @@ func (_m *MockusecaseDeleteExpense) EXPECT() *MockusecaseDeleteExpense_Expecter
 	return &MockusecaseDeleteExpense_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: ctx, expenseID
-func (_m *MockusecaseDeleteExpense) Execute(ctx context.Context, expenseID expense.ID) (*expense.Expense, error) {
-	ret := _m.Called(ctx, expenseID)
+// Execute provides a mock function with given fields: ctx, expenseID, deletedBy
+func (_m *MockusecaseDeleteExpense) Execute(ctx context.Context, expenseID expense.ID, deletedBy *user.ID) (*expense.Expense, error) {
+	ret := _m.Called(ctx, expenseID, deletedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -32,19 +34,19 @@ func (_m *MockusecaseDeleteExpense) Execute(ctx context.Context, expenseID expen
 
 	var r0 *expense.Expense
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, expense.ID) (*expense.Expense, error)); ok {
-		return rf(ctx, expenseID)
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ID, *user.ID) (*expense.Expense, error)); ok {
+		return rf(ctx, expenseID, deletedBy)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, expense.ID) *expense.Expense); ok {
-		r0 = rf(ctx, expenseID)
+	if rf, ok := ret.Get(0).(func(context.Context, expense.ID, *user.ID) *expense.Expense); ok {
+		r0 = rf(ctx, expenseID, deletedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*expense.Expense)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, expense.ID) error); ok {
-		r1 = rf(ctx, expenseID)
+	if rf, ok := ret.Get(1).(func(context.Context, expense.ID, *user.ID) error); ok {
+		r1 = rf(ctx, expenseID, deletedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +62,14 @@ type MockusecaseDeleteExpense_Execute_Call struct {
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
 //   - expenseID expense.ID
-func (_e *MockusecaseDeleteExpense_Expecter) Execute(ctx interface{}, expenseID interface{}) *MockusecaseDeleteExpense_Execute_Call {
-	return &MockusecaseDeleteExpense_Execute_Call{Call: _e.mock.On("Execute", ctx, expenseID)}
+//   - deletedBy *user.ID
+func (_e *MockusecaseDeleteExpense_Expecter) Execute(ctx interface{}, expenseID interface{}, deletedBy interface{}) *MockusecaseDeleteExpense_Execute_Call {
+	return &MockusecaseDeleteExpense_Execute_Call{Call: _e.mock.On("Execute", ctx, expenseID, deletedBy)}
 }
 
-func (_c *MockusecaseDeleteExpense_Execute_Call) Run(run func(ctx context.Context, expenseID expense.ID)) *MockusecaseDeleteExpense_Execute_Call {
+func (_c *MockusecaseDeleteExpense_Execute_Call) Run(run func(ctx context.Context, expenseID expense.ID, deletedBy *user.ID)) *MockusecaseDeleteExpense_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(expense.ID))
+		run(args[0].(context.Context), args[1].(expense.ID), args[2].(*user.ID))
 	})
 	return _c
 }
@@ -76,7 +79,7 @@ func (_c *MockusecaseDeleteExpense_Execute_Call) Return(_a0 *expense.Expense, _a
 	return _c
 }
 
-func (_c *MockusecaseDeleteExpense_Execute_Call) RunAndReturn(run func(context.Context, expense.ID) (*expense.Expense, error)) *MockusecaseDeleteExpense_Execute_Call {
+func (_c *MockusecaseDeleteExpense_Execute_Call) RunAndReturn(run func(context.Context, expense.ID, *user.ID) (*expense.Expense, error)) *MockusecaseDeleteExpense_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
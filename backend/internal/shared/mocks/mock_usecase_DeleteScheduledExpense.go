@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+)
+
+// MockusecaseDeleteScheduledExpense is an autogenerated mock type for the DeleteScheduledExpense type
+type MockusecaseDeleteScheduledExpense struct {
+	mock.Mock
+}
+
+type MockusecaseDeleteScheduledExpense_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseDeleteScheduledExpense) EXPECT() *MockusecaseDeleteScheduledExpense_Expecter {
+	return &MockusecaseDeleteScheduledExpense_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseDeleteScheduledExpense) Execute(ctx context.Context, p usecase.ScheduledExpenseParams) (*expense.ScheduledExpense, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *expense.ScheduledExpense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.ScheduledExpenseParams) (*expense.ScheduledExpense, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.ScheduledExpenseParams) *expense.ScheduledExpense); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.ScheduledExpense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.ScheduledExpenseParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseDeleteScheduledExpense_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseDeleteScheduledExpense_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.ScheduledExpenseParams
+func (_e *MockusecaseDeleteScheduledExpense_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseDeleteScheduledExpense_Execute_Call {
+	return &MockusecaseDeleteScheduledExpense_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseDeleteScheduledExpense_Execute_Call) Run(run func(ctx context.Context, p usecase.ScheduledExpenseParams)) *MockusecaseDeleteScheduledExpense_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.ScheduledExpenseParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseDeleteScheduledExpense_Execute_Call) Return(_a0 *expense.ScheduledExpense, _a1 error) *MockusecaseDeleteScheduledExpense_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseDeleteScheduledExpense_Execute_Call) RunAndReturn(run func(context.Context, usecase.ScheduledExpenseParams) (*expense.ScheduledExpense, error)) *MockusecaseDeleteScheduledExpense_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseDeleteScheduledExpense creates a new instance of MockusecaseDeleteScheduledExpense. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseDeleteScheduledExpense(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseDeleteScheduledExpense {
+	mock := &MockusecaseDeleteScheduledExpense{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
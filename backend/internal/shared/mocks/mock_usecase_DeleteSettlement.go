@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	settlement "github.com/Beigelman/nossas-despesas/internal/modules/settlement"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/settlement/usecase"
+)
+
+// MockusecaseDeleteSettlement is an autogenerated mock type for the DeleteSettlement type
+type MockusecaseDeleteSettlement struct {
+	mock.Mock
+}
+
+type MockusecaseDeleteSettlement_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseDeleteSettlement) EXPECT() *MockusecaseDeleteSettlement_Expecter {
+	return &MockusecaseDeleteSettlement_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseDeleteSettlement) Execute(ctx context.Context, p usecase.DeleteSettlementParams) (*settlement.Settlement, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *settlement.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DeleteSettlementParams) (*settlement.Settlement, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DeleteSettlementParams) *settlement.Settlement); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*settlement.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.DeleteSettlementParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseDeleteSettlement_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseDeleteSettlement_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.DeleteSettlementParams
+func (_e *MockusecaseDeleteSettlement_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseDeleteSettlement_Execute_Call {
+	return &MockusecaseDeleteSettlement_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseDeleteSettlement_Execute_Call) Run(run func(ctx context.Context, p usecase.DeleteSettlementParams)) *MockusecaseDeleteSettlement_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.DeleteSettlementParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseDeleteSettlement_Execute_Call) Return(_a0 *settlement.Settlement, _a1 error) *MockusecaseDeleteSettlement_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseDeleteSettlement_Execute_Call) RunAndReturn(run func(context.Context, usecase.DeleteSettlementParams) (*settlement.Settlement, error)) *MockusecaseDeleteSettlement_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseDeleteSettlement creates a new instance of MockusecaseDeleteSettlement. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseDeleteSettlement(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseDeleteSettlement {
+	mock := &MockusecaseDeleteSettlement{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
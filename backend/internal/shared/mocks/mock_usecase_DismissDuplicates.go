@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseDismissDuplicates is an autogenerated mock type for the DismissDuplicates type
+type MockusecaseDismissDuplicates struct {
+	mock.Mock
+}
+
+type MockusecaseDismissDuplicates_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseDismissDuplicates) EXPECT() *MockusecaseDismissDuplicates_Expecter {
+	return &MockusecaseDismissDuplicates_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseDismissDuplicates) Execute(ctx context.Context, p usecase.DismissDuplicatesParams) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DismissDuplicatesParams) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockusecaseDismissDuplicates_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseDismissDuplicates_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.DismissDuplicatesParams
+func (_e *MockusecaseDismissDuplicates_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseDismissDuplicates_Execute_Call {
+	return &MockusecaseDismissDuplicates_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseDismissDuplicates_Execute_Call) Run(run func(ctx context.Context, p usecase.DismissDuplicatesParams)) *MockusecaseDismissDuplicates_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.DismissDuplicatesParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseDismissDuplicates_Execute_Call) Return(_a0 error) *MockusecaseDismissDuplicates_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockusecaseDismissDuplicates_Execute_Call) RunAndReturn(run func(context.Context, usecase.DismissDuplicatesParams) error) *MockusecaseDismissDuplicates_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseDismissDuplicates creates a new instance of MockusecaseDismissDuplicates. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseDismissDuplicates(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseDismissDuplicates {
+	mock := &MockusecaseDismissDuplicates{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseDownloadAttachment is an autogenerated mock type for the DownloadAttachment type
+type MockusecaseDownloadAttachment struct {
+	mock.Mock
+}
+
+type MockusecaseDownloadAttachment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseDownloadAttachment) EXPECT() *MockusecaseDownloadAttachment_Expecter {
+	return &MockusecaseDownloadAttachment_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseDownloadAttachment) Execute(ctx context.Context, p usecase.DownloadAttachmentParams) (*usecase.DownloadAttachmentResult, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *usecase.DownloadAttachmentResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DownloadAttachmentParams) (*usecase.DownloadAttachmentResult, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DownloadAttachmentParams) *usecase.DownloadAttachmentResult); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.DownloadAttachmentResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.DownloadAttachmentParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseDownloadAttachment_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseDownloadAttachment_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.DownloadAttachmentParams
+func (_e *MockusecaseDownloadAttachment_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseDownloadAttachment_Execute_Call {
+	return &MockusecaseDownloadAttachment_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseDownloadAttachment_Execute_Call) Run(run func(ctx context.Context, p usecase.DownloadAttachmentParams)) *MockusecaseDownloadAttachment_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.DownloadAttachmentParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseDownloadAttachment_Execute_Call) Return(_a0 *usecase.DownloadAttachmentResult, _a1 error) *MockusecaseDownloadAttachment_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseDownloadAttachment_Execute_Call) RunAndReturn(run func(context.Context, usecase.DownloadAttachmentParams) (*usecase.DownloadAttachmentResult, error)) *MockusecaseDownloadAttachment_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseDownloadAttachment creates a new instance of MockusecaseDownloadAttachment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseDownloadAttachment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseDownloadAttachment {
+	mock := &MockusecaseDownloadAttachment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
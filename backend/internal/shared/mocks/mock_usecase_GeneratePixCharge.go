@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/group/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseGeneratePixCharge is an autogenerated mock type for the GeneratePixCharge type
+type MockusecaseGeneratePixCharge struct {
+	mock.Mock
+}
+
+type MockusecaseGeneratePixCharge_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseGeneratePixCharge) EXPECT() *MockusecaseGeneratePixCharge_Expecter {
+	return &MockusecaseGeneratePixCharge_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockusecaseGeneratePixCharge) Execute(ctx context.Context, input usecase.GeneratePixChargeInput) (*usecase.PixCharge, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *usecase.PixCharge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GeneratePixChargeInput) (*usecase.PixCharge, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GeneratePixChargeInput) *usecase.PixCharge); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PixCharge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.GeneratePixChargeInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseGeneratePixCharge_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseGeneratePixCharge_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecase.GeneratePixChargeInput
+func (_e *MockusecaseGeneratePixCharge_Expecter) Execute(ctx interface{}, input interface{}) *MockusecaseGeneratePixCharge_Execute_Call {
+	return &MockusecaseGeneratePixCharge_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockusecaseGeneratePixCharge_Execute_Call) Run(run func(ctx context.Context, input usecase.GeneratePixChargeInput)) *MockusecaseGeneratePixCharge_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.GeneratePixChargeInput))
+	})
+	return _c
+}
+
+func (_c *MockusecaseGeneratePixCharge_Execute_Call) Return(_a0 *usecase.PixCharge, _a1 error) *MockusecaseGeneratePixCharge_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseGeneratePixCharge_Execute_Call) RunAndReturn(run func(context.Context, usecase.GeneratePixChargeInput) (*usecase.PixCharge, error)) *MockusecaseGeneratePixCharge_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseGeneratePixCharge creates a new instance of MockusecaseGeneratePixCharge. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseGeneratePixCharge(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseGeneratePixCharge {
+	mock := &MockusecaseGeneratePixCharge{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	group "github.com/Beigelman/nossas-despesas/internal/modules/group"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseGetScheduledExpenses is an autogenerated mock type for the GetScheduledExpenses type
+type MockusecaseGetScheduledExpenses struct {
+	mock.Mock
+}
+
+type MockusecaseGetScheduledExpenses_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseGetScheduledExpenses) EXPECT() *MockusecaseGetScheduledExpenses_Expecter {
+	return &MockusecaseGetScheduledExpenses_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, groupID
+func (_m *MockusecaseGetScheduledExpenses) Execute(ctx context.Context, groupID group.ID) ([]expense.ScheduledExpense, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []expense.ScheduledExpense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) ([]expense.ScheduledExpense, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) []expense.ScheduledExpense); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.ScheduledExpense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseGetScheduledExpenses_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseGetScheduledExpenses_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+func (_e *MockusecaseGetScheduledExpenses_Expecter) Execute(ctx interface{}, groupID interface{}) *MockusecaseGetScheduledExpenses_Execute_Call {
+	return &MockusecaseGetScheduledExpenses_Execute_Call{Call: _e.mock.On("Execute", ctx, groupID)}
+}
+
+func (_c *MockusecaseGetScheduledExpenses_Execute_Call) Run(run func(ctx context.Context, groupID group.ID)) *MockusecaseGetScheduledExpenses_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID))
+	})
+	return _c
+}
+
+func (_c *MockusecaseGetScheduledExpenses_Execute_Call) Return(_a0 []expense.ScheduledExpense, _a1 error) *MockusecaseGetScheduledExpenses_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseGetScheduledExpenses_Execute_Call) RunAndReturn(run func(context.Context, group.ID) ([]expense.ScheduledExpense, error)) *MockusecaseGetScheduledExpenses_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseGetScheduledExpenses creates a new instance of MockusecaseGetScheduledExpenses. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseGetScheduledExpenses(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseGetScheduledExpenses {
+	mock := &MockusecaseGetScheduledExpenses{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseImportStatement is an autogenerated mock type for the ImportStatement type
+type MockusecaseImportStatement struct {
+	mock.Mock
+}
+
+type MockusecaseImportStatement_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseImportStatement) EXPECT() *MockusecaseImportStatement_Expecter {
+	return &MockusecaseImportStatement_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseImportStatement) Execute(ctx context.Context, p usecase.ImportStatementParams) (*usecase.ImportStatementResult, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *usecase.ImportStatementResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.ImportStatementParams) (*usecase.ImportStatementResult, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.ImportStatementParams) *usecase.ImportStatementResult); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ImportStatementResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.ImportStatementParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseImportStatement_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseImportStatement_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.ImportStatementParams
+func (_e *MockusecaseImportStatement_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseImportStatement_Execute_Call {
+	return &MockusecaseImportStatement_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseImportStatement_Execute_Call) Run(run func(ctx context.Context, p usecase.ImportStatementParams)) *MockusecaseImportStatement_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.ImportStatementParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseImportStatement_Execute_Call) Return(_a0 *usecase.ImportStatementResult, _a1 error) *MockusecaseImportStatement_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseImportStatement_Execute_Call) RunAndReturn(run func(context.Context, usecase.ImportStatementParams) (*usecase.ImportStatementResult, error)) *MockusecaseImportStatement_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseImportStatement creates a new instance of MockusecaseImportStatement. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseImportStatement(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseImportStatement {
+	mock := &MockusecaseImportStatement{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
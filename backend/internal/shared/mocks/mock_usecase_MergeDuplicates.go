@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+)
+
+// MockusecaseMergeDuplicates is an autogenerated mock type for the MergeDuplicates type
+type MockusecaseMergeDuplicates struct {
+	mock.Mock
+}
+
+type MockusecaseMergeDuplicates_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseMergeDuplicates) EXPECT() *MockusecaseMergeDuplicates_Expecter {
+	return &MockusecaseMergeDuplicates_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseMergeDuplicates) Execute(ctx context.Context, p usecase.MergeDuplicatesParams) (*expense.Expense, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *expense.Expense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.MergeDuplicatesParams) (*expense.Expense, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.MergeDuplicatesParams) *expense.Expense); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.Expense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.MergeDuplicatesParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseMergeDuplicates_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseMergeDuplicates_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.MergeDuplicatesParams
+func (_e *MockusecaseMergeDuplicates_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseMergeDuplicates_Execute_Call {
+	return &MockusecaseMergeDuplicates_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseMergeDuplicates_Execute_Call) Run(run func(ctx context.Context, p usecase.MergeDuplicatesParams)) *MockusecaseMergeDuplicates_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.MergeDuplicatesParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseMergeDuplicates_Execute_Call) Return(_a0 *expense.Expense, _a1 error) *MockusecaseMergeDuplicates_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseMergeDuplicates_Execute_Call) RunAndReturn(run func(context.Context, usecase.MergeDuplicatesParams) (*expense.Expense, error)) *MockusecaseMergeDuplicates_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseMergeDuplicates creates a new instance of MockusecaseMergeDuplicates. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseMergeDuplicates(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseMergeDuplicates {
+	mock := &MockusecaseMergeDuplicates{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
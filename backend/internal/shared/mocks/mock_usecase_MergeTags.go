@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+)
+
+// MockusecaseMergeTags is an autogenerated mock type for the MergeTags type
+type MockusecaseMergeTags struct {
+	mock.Mock
+}
+
+type MockusecaseMergeTags_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseMergeTags) EXPECT() *MockusecaseMergeTags_Expecter {
+	return &MockusecaseMergeTags_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseMergeTags) Execute(ctx context.Context, p usecase.MergeTagsParams) (*tag.Tag, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.MergeTagsParams) (*tag.Tag, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.MergeTagsParams) *tag.Tag); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.MergeTagsParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseMergeTags_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseMergeTags_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.MergeTagsParams
+func (_e *MockusecaseMergeTags_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseMergeTags_Execute_Call {
+	return &MockusecaseMergeTags_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseMergeTags_Execute_Call) Run(run func(ctx context.Context, p usecase.MergeTagsParams)) *MockusecaseMergeTags_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.MergeTagsParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseMergeTags_Execute_Call) Return(_a0 *tag.Tag, _a1 error) *MockusecaseMergeTags_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseMergeTags_Execute_Call) RunAndReturn(run func(context.Context, usecase.MergeTagsParams) (*tag.Tag, error)) *MockusecaseMergeTags_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseMergeTags creates a new instance of MockusecaseMergeTags. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseMergeTags(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseMergeTags {
+	mock := &MockusecaseMergeTags{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecaseNotifyBudgetAlert is an autogenerated mock type for the NotifyBudgetAlert type
+type MockusecaseNotifyBudgetAlert struct {
+	mock.Mock
+}
+
+type MockusecaseNotifyBudgetAlert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseNotifyBudgetAlert) EXPECT() *MockusecaseNotifyBudgetAlert_Expecter {
+	return &MockusecaseNotifyBudgetAlert_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockusecaseNotifyBudgetAlert) Execute(ctx context.Context, input usecase.NotifyBudgetAlertInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.NotifyBudgetAlertInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockusecaseNotifyBudgetAlert_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseNotifyBudgetAlert_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecase.NotifyBudgetAlertInput
+func (_e *MockusecaseNotifyBudgetAlert_Expecter) Execute(ctx interface{}, input interface{}) *MockusecaseNotifyBudgetAlert_Execute_Call {
+	return &MockusecaseNotifyBudgetAlert_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockusecaseNotifyBudgetAlert_Execute_Call) Run(run func(ctx context.Context, input usecase.NotifyBudgetAlertInput)) *MockusecaseNotifyBudgetAlert_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.NotifyBudgetAlertInput))
+	})
+	return _c
+}
+
+func (_c *MockusecaseNotifyBudgetAlert_Execute_Call) Return(_a0 error) *MockusecaseNotifyBudgetAlert_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockusecaseNotifyBudgetAlert_Execute_Call) RunAndReturn(run func(context.Context, usecase.NotifyBudgetAlertInput) error) *MockusecaseNotifyBudgetAlert_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseNotifyBudgetAlert creates a new instance of MockusecaseNotifyBudgetAlert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseNotifyBudgetAlert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseNotifyBudgetAlert {
+	mock := &MockusecaseNotifyBudgetAlert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
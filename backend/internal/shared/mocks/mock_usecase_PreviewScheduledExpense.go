@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	civil "cloud.google.com/go/civil"
+
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+)
+
+// MockusecasePreviewScheduledExpense is an autogenerated mock type for the PreviewScheduledExpense type
+type MockusecasePreviewScheduledExpense struct {
+	mock.Mock
+}
+
+type MockusecasePreviewScheduledExpense_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecasePreviewScheduledExpense) EXPECT() *MockusecasePreviewScheduledExpense_Expecter {
+	return &MockusecasePreviewScheduledExpense_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecasePreviewScheduledExpense) Execute(ctx context.Context, p usecase.PreviewScheduledExpenseParams) ([]civil.Date, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []civil.Date
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.PreviewScheduledExpenseParams) ([]civil.Date, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.PreviewScheduledExpenseParams) []civil.Date); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]civil.Date)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.PreviewScheduledExpenseParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecasePreviewScheduledExpense_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecasePreviewScheduledExpense_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.PreviewScheduledExpenseParams
+func (_e *MockusecasePreviewScheduledExpense_Expecter) Execute(ctx interface{}, p interface{}) *MockusecasePreviewScheduledExpense_Execute_Call {
+	return &MockusecasePreviewScheduledExpense_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecasePreviewScheduledExpense_Execute_Call) Run(run func(ctx context.Context, p usecase.PreviewScheduledExpenseParams)) *MockusecasePreviewScheduledExpense_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.PreviewScheduledExpenseParams))
+	})
+	return _c
+}
+
+func (_c *MockusecasePreviewScheduledExpense_Execute_Call) Return(_a0 []civil.Date, _a1 error) *MockusecasePreviewScheduledExpense_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecasePreviewScheduledExpense_Execute_Call) RunAndReturn(run func(context.Context, usecase.PreviewScheduledExpenseParams) ([]civil.Date, error)) *MockusecasePreviewScheduledExpense_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecasePreviewScheduledExpense creates a new instance of MockusecasePreviewScheduledExpense. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecasePreviewScheduledExpense(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecasePreviewScheduledExpense {
+	mock := &MockusecasePreviewScheduledExpense{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
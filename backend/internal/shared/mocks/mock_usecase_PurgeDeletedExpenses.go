@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockusecasePurgeDeletedExpenses is an autogenerated mock type for the PurgeDeletedExpenses type
+type MockusecasePurgeDeletedExpenses struct {
+	mock.Mock
+}
+
+type MockusecasePurgeDeletedExpenses_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecasePurgeDeletedExpenses) EXPECT() *MockusecasePurgeDeletedExpenses_Expecter {
+	return &MockusecasePurgeDeletedExpenses_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockusecasePurgeDeletedExpenses) Execute(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecasePurgeDeletedExpenses_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecasePurgeDeletedExpenses_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *MockusecasePurgeDeletedExpenses_Expecter) Execute(ctx interface{}, deletedBefore interface{}) *MockusecasePurgeDeletedExpenses_Execute_Call {
+	return &MockusecasePurgeDeletedExpenses_Execute_Call{Call: _e.mock.On("Execute", ctx, deletedBefore)}
+}
+
+func (_c *MockusecasePurgeDeletedExpenses_Execute_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *MockusecasePurgeDeletedExpenses_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockusecasePurgeDeletedExpenses_Execute_Call) Return(_a0 int, _a1 error) *MockusecasePurgeDeletedExpenses_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecasePurgeDeletedExpenses_Execute_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *MockusecasePurgeDeletedExpenses_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecasePurgeDeletedExpenses creates a new instance of MockusecasePurgeDeletedExpenses. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecasePurgeDeletedExpenses(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecasePurgeDeletedExpenses {
+	mock := &MockusecasePurgeDeletedExpenses{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/Beigelman/nossas-despesas/internal/modules/tag"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/tag/usecase"
+)
+
+// MockusecaseRenameTag is an autogenerated mock type for the RenameTag type
+type MockusecaseRenameTag struct {
+	mock.Mock
+}
+
+type MockusecaseRenameTag_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseRenameTag) EXPECT() *MockusecaseRenameTag_Expecter {
+	return &MockusecaseRenameTag_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseRenameTag) Execute(ctx context.Context, p usecase.RenameTagParams) (*tag.Tag, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.RenameTagParams) (*tag.Tag, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.RenameTagParams) *tag.Tag); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.RenameTagParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseRenameTag_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseRenameTag_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.RenameTagParams
+func (_e *MockusecaseRenameTag_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseRenameTag_Execute_Call {
+	return &MockusecaseRenameTag_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseRenameTag_Execute_Call) Run(run func(ctx context.Context, p usecase.RenameTagParams)) *MockusecaseRenameTag_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.RenameTagParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseRenameTag_Execute_Call) Return(_a0 *tag.Tag, _a1 error) *MockusecaseRenameTag_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseRenameTag_Execute_Call) RunAndReturn(run func(context.Context, usecase.RenameTagParams) (*tag.Tag, error)) *MockusecaseRenameTag_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseRenameTag creates a new instance of MockusecaseRenameTag. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseRenameTag(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseRenameTag {
+	mock := &MockusecaseRenameTag{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
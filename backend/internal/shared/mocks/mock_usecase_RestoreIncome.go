@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	income "github.com/Beigelman/nossas-despesas/internal/modules/income"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/income/usecase"
+)
+
+// MockusecaseRestoreIncome is an autogenerated mock type for the RestoreIncome type
+type MockusecaseRestoreIncome struct {
+	mock.Mock
+}
+
+type MockusecaseRestoreIncome_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseRestoreIncome) EXPECT() *MockusecaseRestoreIncome_Expecter {
+	return &MockusecaseRestoreIncome_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseRestoreIncome) Execute(ctx context.Context, p usecase.RestoreIncomeParams) (*income.Income, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *income.Income
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.RestoreIncomeParams) (*income.Income, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.RestoreIncomeParams) *income.Income); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*income.Income)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.RestoreIncomeParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseRestoreIncome_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseRestoreIncome_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.RestoreIncomeParams
+func (_e *MockusecaseRestoreIncome_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseRestoreIncome_Execute_Call {
+	return &MockusecaseRestoreIncome_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseRestoreIncome_Execute_Call) Run(run func(ctx context.Context, p usecase.RestoreIncomeParams)) *MockusecaseRestoreIncome_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.RestoreIncomeParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseRestoreIncome_Execute_Call) Return(_a0 *income.Income, _a1 error) *MockusecaseRestoreIncome_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseRestoreIncome_Execute_Call) RunAndReturn(run func(context.Context, usecase.RestoreIncomeParams) (*income.Income, error)) *MockusecaseRestoreIncome_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseRestoreIncome creates a new instance of MockusecaseRestoreIncome. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseRestoreIncome(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseRestoreIncome {
+	mock := &MockusecaseRestoreIncome{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
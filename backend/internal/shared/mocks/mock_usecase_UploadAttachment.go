@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	expense "github.com/Beigelman/nossas-despesas/internal/modules/expense"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/Beigelman/nossas-despesas/internal/modules/expense/usecase"
+)
+
+// MockusecaseUploadAttachment is an autogenerated mock type for the UploadAttachment type
+type MockusecaseUploadAttachment struct {
+	mock.Mock
+}
+
+type MockusecaseUploadAttachment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockusecaseUploadAttachment) EXPECT() *MockusecaseUploadAttachment_Expecter {
+	return &MockusecaseUploadAttachment_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *MockusecaseUploadAttachment) Execute(ctx context.Context, p usecase.UploadAttachmentParams) (*expense.Attachment, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *expense.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.UploadAttachmentParams) (*expense.Attachment, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.UploadAttachmentParams) *expense.Attachment); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.UploadAttachmentParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockusecaseUploadAttachment_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockusecaseUploadAttachment_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p usecase.UploadAttachmentParams
+func (_e *MockusecaseUploadAttachment_Expecter) Execute(ctx interface{}, p interface{}) *MockusecaseUploadAttachment_Execute_Call {
+	return &MockusecaseUploadAttachment_Execute_Call{Call: _e.mock.On("Execute", ctx, p)}
+}
+
+func (_c *MockusecaseUploadAttachment_Execute_Call) Run(run func(ctx context.Context, p usecase.UploadAttachmentParams)) *MockusecaseUploadAttachment_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.UploadAttachmentParams))
+	})
+	return _c
+}
+
+func (_c *MockusecaseUploadAttachment_Execute_Call) Return(_a0 *expense.Attachment, _a1 error) *MockusecaseUploadAttachment_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockusecaseUploadAttachment_Execute_Call) RunAndReturn(run func(context.Context, usecase.UploadAttachmentParams) (*expense.Attachment, error)) *MockusecaseUploadAttachment_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockusecaseUploadAttachment creates a new instance of MockusecaseUploadAttachment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockusecaseUploadAttachment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockusecaseUploadAttachment {
+	mock := &MockusecaseUploadAttachment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
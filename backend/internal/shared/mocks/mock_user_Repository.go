@@ -5,8 +5,10 @@ package mocks
 import (
 	context "context"
 
-	user "github.com/Beigelman/nossas-despesas/internal/modules/user"
+	group "github.com/Beigelman/nossas-despesas/internal/modules/group"
 	mock "github.com/stretchr/testify/mock"
+
+	user "github.com/Beigelman/nossas-despesas/internal/modules/user"
 )
 
 // MockuserRepository is an autogenerated mock type for the Repository type
@@ -81,6 +83,65 @@ func (_c *MockuserRepository_GetByEmail_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetByGroupID provides a mock function with given fields: ctx, groupID
+func (_m *MockuserRepository) GetByGroupID(ctx context.Context, groupID group.ID) ([]user.User, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGroupID")
+	}
+
+	var r0 []user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) ([]user.User, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ID) []user.User); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockuserRepository_GetByGroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGroupID'
+type MockuserRepository_GetByGroupID_Call struct {
+	*mock.Call
+}
+
+// GetByGroupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID group.ID
+func (_e *MockuserRepository_Expecter) GetByGroupID(ctx interface{}, groupID interface{}) *MockuserRepository_GetByGroupID_Call {
+	return &MockuserRepository_GetByGroupID_Call{Call: _e.mock.On("GetByGroupID", ctx, groupID)}
+}
+
+func (_c *MockuserRepository_GetByGroupID_Call) Run(run func(ctx context.Context, groupID group.ID)) *MockuserRepository_GetByGroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ID))
+	})
+	return _c
+}
+
+func (_c *MockuserRepository_GetByGroupID_Call) Return(_a0 []user.User, _a1 error) *MockuserRepository_GetByGroupID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockuserRepository_GetByGroupID_Call) RunAndReturn(run func(context.Context, group.ID) ([]user.User, error)) *MockuserRepository_GetByGroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *MockuserRepository) GetByID(ctx context.Context, id user.ID) (*user.User, error) {
 	ret := _m.Called(ctx, id)
@@ -5,16 +5,21 @@ import (
 	"log/slog"
 
 	backend "github.com/Beigelman/nossas-despesas"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/calendar"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/di"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/email"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/env"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/eon"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/jwt"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/outbox"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/predict"
 	"github.com/Beigelman/nossas-despesas/internal/pkg/pubsub"
+	"github.com/Beigelman/nossas-despesas/internal/pkg/storage"
 	"github.com/Beigelman/nossas-despesas/internal/shared/service"
 )
 
+const defaultRelayOutboxSchedule = "@every 2s"
+
 var Module = eon.NewModule("Shared Clients", func(ctx context.Context, c *di.Container, lc eon.LifeCycleManager, info eon.Info) {
 	di.Provide(c, func(cfg *backend.Config) service.TokenProvider {
 		return jwt.NewJWTProvider(cfg.JWTSecret)
@@ -31,9 +36,48 @@ var Module = eon.NewModule("Shared Clients", func(ctx context.Context, c *di.Con
 		return predict.NewClient(ctx, cfg.PredictURL, cfg.Env)
 	})
 
+	di.Provide(c, func(cfg *backend.Config) (service.FileStorage, error) {
+		if cfg.Storage.Driver == "s3" {
+			return storage.NewS3(storage.S3Config{
+				Endpoint:  cfg.Storage.S3Endpoint,
+				Region:    cfg.Storage.S3Region,
+				Bucket:    cfg.Storage.S3Bucket,
+				AccessKey: cfg.Storage.S3AccessKey,
+				SecretKey: cfg.Storage.S3SecretKey,
+			})
+		}
+		return storage.NewLocal(cfg.StorageLocalPath())
+	})
+
+	di.Provide(c, func(cfg *backend.Config) (calendar.Calendar, error) {
+		holidays, err := calendar.ParseDates(cfg.Calendar.Holidays)
+		if err != nil {
+			return nil, err
+		}
+		return calendar.NewBrazilian(holidays...), nil
+	})
+
 	di.Provide(c, service.NewGoogleTokenValidator)
 	di.Provide(c, pubsub.NewSqlPublisher)
 	di.Provide(c, pubsub.NewSqlSubscriber)
+	di.Provide(c, outbox.NewRelay)
+
+	// Forward the events written to the outbox to their topics
+	lc.OnBooted(eon.HookOrders.APPEND, func() error {
+		cfg := di.Resolve[*backend.Config](c)
+		schedule := cfg.Jobs.RelayOutbox
+		if schedule == "" {
+			schedule = defaultRelayOutboxSchedule
+		}
+
+		lc.Schedule(eon.Job{
+			Name:     "relay-outbox",
+			Schedule: schedule,
+			Locker:   di.Resolve[eon.Locker](c),
+			Run:      di.Resolve[*outbox.Relay](c).Run,
+		})
+		return nil
+	})
 
 	lc.OnDisposing(eon.HookOrders.APPEND, func() error {
 		if publisher := di.Resolve[pubsub.Publisher](c); publisher != nil {
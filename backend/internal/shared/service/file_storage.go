@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"io"
+)
+
+// FileStorage keeps files, such as the receipts attached to expenses, under slash separated keys.
+// Get fails with storage.ErrNotFound when nothing is kept under the key, while deleting a missing
+// key succeeds.
+type FileStorage interface {
+	Put(ctx context.Context, key string, content []byte, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -50,8 +50,10 @@ func extractExpense(line []string, id expense.ID) (*expense.Expense, error) {
 	}
 
 	splitRatio := expense.SplitRatio{
-		Payer:    payerRatio,
-		Receiver: receiverRatio,
+		Shares: []expense.Share{
+			{UserID: user.ID{Value: payer}, Percent: payerRatio},
+			{UserID: user.ID{Value: receiver}, Percent: receiverRatio},
+		},
 	}
 
 	var splitType expense.SplitType